@@ -126,8 +126,8 @@ var compareInitBytecodeWithOpTests = []struct {
 				Name:     "Safe_v130",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op:  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
-					Eth: common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
+					"op":  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
+					"eth": common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
 				},
 				DeploymentSalt: "0000000000000000000000000000000000000000000000000000000000000000",
 				Deployer:       common.Address{},
@@ -147,7 +147,7 @@ var compareInitBytecodeWithOpTests = []struct {
 				Name:     "Safe_v130",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op: common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
+					"op": common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
 				},
 				DeploymentSalt: "0000000000000000000000000000000000000000000000000000000000000000",
 				Deployer:       common.Address{},
@@ -167,8 +167,8 @@ var compareInitBytecodeWithOpTests = []struct {
 				Name:     "Create2Deployer",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op:  common.HexToAddress("0x13b0D85CcB8bf860b6b79AF3029fCA081AE9beF2"),
-					Eth: common.HexToAddress("0xF49600926c7109BD66Ab97a2c036bf696e58Dbc2"),
+					"op":  common.HexToAddress("0x13b0D85CcB8bf860b6b79AF3029fCA081AE9beF2"),
+					"eth": common.HexToAddress("0xF49600926c7109BD66Ab97a2c036bf696e58Dbc2"),
 				},
 				Deployer:     common.Address{},
 				ABI:          "",
@@ -195,8 +195,8 @@ var compareInitBytecodeWithOpTestsFailures = []struct {
 				Name:     "Safe_v130",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op:  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
-					Eth: common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
+					"op":  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
+					"eth": common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
 				},
 				DeploymentSalt: "0000000000000000000000000000000000000000000000000000000000000000",
 				Deployer:       common.Address{},
@@ -217,7 +217,7 @@ var compareInitBytecodeWithOpTestsFailures = []struct {
 				Name:     "Safe_v130",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Eth: common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
+					"eth": common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
 				},
 				DeploymentSalt: "0000000000000000000000000000000000000000000000000000000000000000",
 				Deployer:       common.Address{},
@@ -238,8 +238,8 @@ var compareInitBytecodeWithOpTestsFailures = []struct {
 				Name:     "MultiCall3",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op:  common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11"),
-					Eth: common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11"),
+					"op":  common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11"),
+					"eth": common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11"),
 				},
 				Deployer:     common.Address{},
 				ABI:          "",
@@ -259,8 +259,8 @@ var compareInitBytecodeWithOpTestsFailures = []struct {
 				Name:     "Safe_v130",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op:  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
-					Eth: common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
+					"op":  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
+					"eth": common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
 				},
 				DeploymentSalt: "0000000000000000000000000000000000000000000000000000000000000000",
 				Deployer:       common.Address{},
@@ -288,8 +288,8 @@ var compareDeployedBytecodeWithOpTests = []struct {
 				Name:     "Safe_v130",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op:  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
-					Eth: common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
+					"op":  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
+					"eth": common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
 				},
 				DeploymentSalt: "0000000000000000000000000000000000000000000000000000000000000000",
 				Deployer:       common.Address{},
@@ -309,7 +309,7 @@ var compareDeployedBytecodeWithOpTests = []struct {
 				Name:     "Safe_v130",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op: common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
+					"op": common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
 				},
 				DeploymentSalt: "0000000000000000000000000000000000000000000000000000000000000000",
 				Deployer:       common.Address{},
@@ -329,8 +329,8 @@ var compareDeployedBytecodeWithOpTests = []struct {
 				Name:     "Permit2",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op:  common.HexToAddress("0x000000000022D473030F116dDEE9F6B43aC78BA3"),
-					Eth: common.HexToAddress("0x000000000022D473030F116dDEE9F6B43aC78BA3"),
+					"op":  common.HexToAddress("0x000000000022D473030F116dDEE9F6B43aC78BA3"),
+					"eth": common.HexToAddress("0x000000000022D473030F116dDEE9F6B43aC78BA3"),
 				},
 				Deployer:     common.Address{},
 				ABI:          "",
@@ -357,8 +357,8 @@ var compareDeployedBytecodeWithOpTestsFailures = []struct {
 				Name:     "Safe_v130",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op:  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
-					Eth: common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
+					"op":  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
+					"eth": common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
 				},
 				DeploymentSalt: "0000000000000000000000000000000000000000000000000000000000000000",
 				Deployer:       common.Address{},
@@ -379,7 +379,7 @@ var compareDeployedBytecodeWithOpTestsFailures = []struct {
 				Name:     "Safe_v130",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Eth: common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
+					"eth": common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
 				},
 				DeploymentSalt: "0000000000000000000000000000000000000000000000000000000000000000",
 				Deployer:       common.Address{},
@@ -400,8 +400,8 @@ var compareDeployedBytecodeWithOpTestsFailures = []struct {
 				Name:     "Safe_v130",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op:  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
-					Eth: common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
+					"op":  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
+					"eth": common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
 				},
 				DeploymentSalt: "0000000000000000000000000000000000000000000000000000000000000000",
 				Deployer:       common.Address{},
@@ -422,8 +422,8 @@ var compareDeployedBytecodeWithOpTestsFailures = []struct {
 				Name:     "Safe_v130",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op:  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
-					Eth: common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
+					"op":  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
+					"eth": common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
 				},
 				DeploymentSalt: "0000000000000000000000000000000000000000000000000000000000000000",
 				Deployer:       common.Address{},
@@ -451,8 +451,8 @@ var compareDeployedBytecodeWithRpcTests = []struct {
 				Name:     "Safe_v130",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op:  common.Address{},
-					Eth: common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
+					"op":  common.Address{},
+					"eth": common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
 				},
 				DeploymentSalt: "0000000000000000000000000000000000000000000000000000000000000000",
 				Deployer:       common.Address{},
@@ -472,8 +472,8 @@ var compareDeployedBytecodeWithRpcTests = []struct {
 				Name:     "Safe_v130",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op:  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
-					Eth: common.Address{},
+					"op":  common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552"),
+					"eth": common.Address{},
 				},
 				DeploymentSalt: "0000000000000000000000000000000000000000000000000000000000000000",
 				Deployer:       common.Address{},
@@ -501,8 +501,8 @@ var compareDeployedBytecodeWithRpcTestsFailures = []struct {
 				Name:     "Safe_v130",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op:  common.Address{},
-					Eth: common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
+					"op":  common.Address{},
+					"eth": common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
 				},
 				DeploymentSalt: "0000000000000000000000000000000000000000000000000000000000000000",
 				Deployer:       common.Address{},
@@ -523,8 +523,8 @@ var compareDeployedBytecodeWithRpcTestsFailures = []struct {
 				Name:     "Safe_v130",
 				Verified: true,
 				Deployments: bindgen.Deployments{
-					Op:  common.Address{},
-					Eth: common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
+					"op":  common.Address{},
+					"eth": common.HexToAddress("0x69f4D1788e39c87893C980c06EdF4b7f686e2938"),
 				},
 				DeploymentSalt: "0000000000000000000000000000000000000000000000000000000000000000",
 				Deployer:       common.Address{},
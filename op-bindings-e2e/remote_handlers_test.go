@@ -1,6 +1,7 @@
 package bindgen
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"reflect"
@@ -15,16 +16,23 @@ import (
 var generator bindgen.BindGenGeneratorRemote = bindgen.BindGenGeneratorRemote{}
 
 func configureGenerator(t *testing.T) error {
-	generator.ContractDataClients.Eth = etherscan.NewEthereumClient(os.Getenv("ETHERSCAN_APIKEY_ETH"))
-	generator.ContractDataClients.Op = etherscan.NewOptimismClient(os.Getenv("ETHERSCAN_APIKEY_OP"))
+	generator.ContractDataClients = map[string]bindgen.ContractDataClient{
+		"eth": etherscan.NewEthereumClient(os.Getenv("ETHERSCAN_APIKEY_ETH"), nil),
+		"op":  etherscan.NewOptimismClient(os.Getenv("ETHERSCAN_APIKEY_OP"), nil),
+	}
 
-	var err error
-	if generator.RpcClients.Eth, err = ethclient.Dial(os.Getenv("RPC_URL_ETH")); err != nil {
+	ethClient, err := ethclient.Dial(os.Getenv("RPC_URL_ETH"))
+	if err != nil {
 		return fmt.Errorf("error initializing Ethereum client: %w", err)
 	}
-	if generator.RpcClients.Op, err = ethclient.Dial(os.Getenv("RPC_URL_OP")); err != nil {
+	opClient, err := ethclient.Dial(os.Getenv("RPC_URL_OP"))
+	if err != nil {
 		return fmt.Errorf("error initializing Optimism client: %w", err)
 	}
+	generator.RpcClients = map[string]*ethclient.Client{
+		"eth": ethClient,
+		"op":  opClient,
+	}
 
 	return nil
 }
@@ -36,7 +44,7 @@ func TestFetchContractData(t *testing.T) {
 
 	for _, tt := range fetchContractDataTests {
 		t.Run(tt.name, func(t *testing.T) {
-			contractData, err := generator.FetchContractData(tt.contractVerified, tt.chain, tt.deploymentAddress)
+			contractData, err := generator.FetchContractData(context.Background(), tt.contractVerified, tt.chain, tt.deploymentAddress)
 			if err != nil {
 				t.Error(err)
 			}
@@ -54,7 +62,7 @@ func TestFetchContractDataFailures(t *testing.T) {
 
 	for _, tt := range fetchContractDataTestsFailures {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := generator.FetchContractData(tt.contractVerified, tt.chain, tt.deploymentAddress)
+			_, err := generator.FetchContractData(context.Background(), tt.contractVerified, tt.chain, tt.deploymentAddress)
 			if err == nil {
 				t.Errorf("Expected error: %s but didn't receive it", tt.expectedError)
 				return
@@ -75,7 +83,7 @@ func TestCompareInitBytecodeWithOp(t *testing.T) {
 
 	for _, tt := range compareInitBytecodeWithOpTests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := generator.CompareInitBytecodeWithOp(&tt.contractMetadataEth, tt.initCodeShouldMatch)
+			err := generator.CompareInitBytecodeWithOp(context.Background(), &tt.contractMetadataEth, tt.initCodeShouldMatch)
 			if err != nil {
 				t.Error(err)
 			}
@@ -90,7 +98,7 @@ func TestCompareInitBytecodeWithOpFailures(t *testing.T) {
 
 	for _, tt := range compareInitBytecodeWithOpTestsFailures {
 		t.Run(tt.name, func(t *testing.T) {
-			err := generator.CompareInitBytecodeWithOp(&tt.contractMetadataEth, tt.initCodeShouldMatch)
+			err := generator.CompareInitBytecodeWithOp(context.Background(), &tt.contractMetadataEth, tt.initCodeShouldMatch)
 			if err == nil {
 				t.Errorf("Expected error: %s but didn't receive it", tt.expectedError)
 				return
@@ -111,7 +119,7 @@ func TestCompareDeployedBytecodeWithOp(t *testing.T) {
 
 	for _, tt := range compareDeployedBytecodeWithOpTests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := generator.CompareDeployedBytecodeWithOp(&tt.contractMetadataEth, tt.deployedCodeShouldMatch)
+			err := generator.CompareDeployedBytecodeWithOp(context.Background(), &tt.contractMetadataEth, tt.deployedCodeShouldMatch)
 			if err != nil {
 				t.Error(err)
 			}
@@ -126,7 +134,7 @@ func TestCompareDeployedBytecodeWithOpFailures(t *testing.T) {
 
 	for _, tt := range compareDeployedBytecodeWithOpTestsFailures {
 		t.Run(tt.name, func(t *testing.T) {
-			err := generator.CompareDeployedBytecodeWithOp(&tt.contractMetadataEth, tt.deployedCodeShouldMatch)
+			err := generator.CompareDeployedBytecodeWithOp(context.Background(), &tt.contractMetadataEth, tt.deployedCodeShouldMatch)
 			if err == nil {
 				t.Errorf("Expected error: %s but didn't receive it", tt.expectedError)
 				return
@@ -147,7 +155,7 @@ func TestCompareDeployedBytecodeWithRpc(t *testing.T) {
 
 	for _, tt := range compareDeployedBytecodeWithRpcTests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := generator.CompareDeployedBytecodeWithRpc(&tt.contractMetadataEth, tt.chain)
+			err := generator.CompareDeployedBytecodeWithRpc(context.Background(), &tt.contractMetadataEth, tt.chain)
 			if err != nil {
 				t.Error(err)
 			}
@@ -162,7 +170,7 @@ func TestCompareDeployedBytecodeWithRpcFailures(t *testing.T) {
 
 	for _, tt := range compareDeployedBytecodeWithRpcTestsFailures {
 		t.Run(tt.name, func(t *testing.T) {
-			err := generator.CompareDeployedBytecodeWithRpc(&tt.contractMetadataEth, tt.chain)
+			err := generator.CompareDeployedBytecodeWithRpc(context.Background(), &tt.contractMetadataEth, tt.chain)
 			if err == nil {
 				t.Errorf("Expected error: %s but didn't receive it", tt.expectedError)
 				return
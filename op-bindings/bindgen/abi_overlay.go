@@ -0,0 +1,123 @@
+package bindgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// readAbiOverlay reads the ABI overlay fragment for the given contract from the
+// overlay directory. The fragment is expected at `<overlayDir>/<contractName>.json`
+// and must be a JSON array of ABI entries.
+//
+// Parameters:
+// - logger: An instance of go-ethereum/log
+// - overlayDir: The directory containing per-contract ABI overlay fragments.
+// - contractName: The name of the contract to read the overlay for.
+//
+// Returns:
+// - The raw overlay fragment, or nil if no overlay exists for the contract.
+// - An error if the overlay file exists but cannot be read, nil otherwise.
+func readAbiOverlay(logger log.Logger, overlayDir, contractName string) (json.RawMessage, error) {
+	overlayPath := filepath.Join(overlayDir, contractName+".json")
+	overlay, err := os.ReadFile(overlayPath)
+	if errors.Is(err, os.ErrNotExist) {
+		logger.Debug("No ABI overlay found for contract", "contract", contractName, "path", overlayPath)
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading ABI overlay for %s at %s: %w", contractName, overlayPath, err)
+	}
+
+	logger.Debug("Using ABI overlay", "contract", contractName, "path", overlayPath)
+	return overlay, nil
+}
+
+// mergeAbiOverlay deep-merges the ABI entries of the overlay onto the base ABI.
+// Entries are matched by their type, name and input types. A matching entry is
+// deep-merged with the overlay's fields taking precedence, entries without a match
+// are appended. The merged ABI is validated before it is returned.
+//
+// Parameters:
+// - baseAbi: The ABI as found in the contract's artifact.
+// - overlay: A JSON array of ABI entries to merge onto the base ABI.
+//
+// Returns:
+// - The merged ABI.
+// - An error if either input can't be parsed or the merged ABI is malformed, nil otherwise.
+func mergeAbiOverlay(baseAbi, overlay json.RawMessage) (json.RawMessage, error) {
+	var baseEntries []map[string]any
+	if err := json.Unmarshal(baseAbi, &baseEntries); err != nil {
+		return nil, fmt.Errorf("error parsing base ABI: %w", err)
+	}
+	var overlayEntries []map[string]any
+	if err := json.Unmarshal(overlay, &overlayEntries); err != nil {
+		return nil, fmt.Errorf("error parsing ABI overlay: %w", err)
+	}
+
+	indexes := make(map[string]int, len(baseEntries))
+	for i, entry := range baseEntries {
+		indexes[abiEntryKey(entry)] = i
+	}
+	for _, entry := range overlayEntries {
+		key := abiEntryKey(entry)
+		if i, ok := indexes[key]; ok {
+			baseEntries[i] = deepMerge(baseEntries[i], entry)
+			continue
+		}
+		indexes[key] = len(baseEntries)
+		baseEntries = append(baseEntries, entry)
+	}
+
+	merged, err := json.Marshal(baseEntries)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling merged ABI: %w", err)
+	}
+	if _, err := abi.JSON(bytes.NewReader(merged)); err != nil {
+		return nil, fmt.Errorf("merged ABI is malformed: %w", err)
+	}
+	return merged, nil
+}
+
+// abiEntryKey identifies an ABI entry by its type, name and input types,
+// e.g. `event:Transfer(address,address,uint256)`.
+func abiEntryKey(entry map[string]any) string {
+	entryType, _ := entry["type"].(string)
+	if entryType == "" {
+		// solc defaults entries without a type to functions
+		entryType = "function"
+	}
+	name, _ := entry["name"].(string)
+
+	var inputTypes []string
+	if inputs, ok := entry["inputs"].([]any); ok {
+		for _, input := range inputs {
+			if in, ok := input.(map[string]any); ok {
+				inputType, _ := in["type"].(string)
+				inputTypes = append(inputTypes, inputType)
+			}
+		}
+	}
+	return fmt.Sprintf("%s:%s(%s)", entryType, name, strings.Join(inputTypes, ","))
+}
+
+// deepMerge merges src onto dst, recursing into nested objects. Values from src
+// take precedence, arrays and scalar values are replaced rather than merged.
+func deepMerge(dst, src map[string]any) map[string]any {
+	for k, srcVal := range src {
+		srcMap, srcIsMap := srcVal.(map[string]any)
+		dstMap, dstIsMap := dst[k].(map[string]any)
+		if srcIsMap && dstIsMap {
+			dst[k] = deepMerge(dstMap, srcMap)
+		} else {
+			dst[k] = srcVal
+		}
+	}
+	return dst
+}
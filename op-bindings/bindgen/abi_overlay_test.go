@@ -0,0 +1,70 @@
+package bindgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+const overlayTestBaseAbi = `[
+	{"type":"function","name":"deposit","inputs":[{"name":"amount","type":"uint256","internalType":"uint256"}],"outputs":[],"stateMutability":"nonpayable"},
+	{"type":"event","name":"Deposited","inputs":[{"name":"amount","type":"uint256","indexed":false,"internalType":"uint256"}],"anonymous":false}
+]`
+
+func TestMergeAbiOverlay(t *testing.T) {
+	t.Run("AddsEvent", func(t *testing.T) {
+		overlay := `[{"type":"event","name":"AssemblyEmitted","inputs":[{"name":"who","type":"address","indexed":true}],"anonymous":false}]`
+		merged, err := mergeAbiOverlay(json.RawMessage(overlayTestBaseAbi), json.RawMessage(overlay))
+		require.NoError(t, err)
+
+		parsed, err := abi.JSON(bytes.NewReader(merged))
+		require.NoError(t, err)
+		require.Contains(t, parsed.Events, "AssemblyEmitted")
+		require.Contains(t, parsed.Events, "Deposited")
+		require.Contains(t, parsed.Methods, "deposit")
+	})
+
+	t.Run("MergesMatchingEntry", func(t *testing.T) {
+		overlay := `[{"type":"function","name":"deposit","inputs":[{"name":"amount","type":"uint256"}],"stateMutability":"payable"}]`
+		merged, err := mergeAbiOverlay(json.RawMessage(overlayTestBaseAbi), json.RawMessage(overlay))
+		require.NoError(t, err)
+
+		parsed, err := abi.JSON(bytes.NewReader(merged))
+		require.NoError(t, err)
+		require.Len(t, parsed.Methods, 1)
+		require.Equal(t, "payable", parsed.Methods["deposit"].StateMutability)
+	})
+
+	t.Run("RejectsMalformedResult", func(t *testing.T) {
+		overlay := `[{"type":"event","name":"Broken","inputs":[{"name":"x","type":"notatype"}]}]`
+		_, err := mergeAbiOverlay(json.RawMessage(overlayTestBaseAbi), json.RawMessage(overlay))
+		require.ErrorContains(t, err, "merged ABI is malformed")
+	})
+
+	t.Run("RejectsNonArrayOverlay", func(t *testing.T) {
+		_, err := mergeAbiOverlay(json.RawMessage(overlayTestBaseAbi), json.RawMessage(`{"type":"event"}`))
+		require.ErrorContains(t, err, "error parsing ABI overlay")
+	})
+}
+
+func TestReadAbiOverlay(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelDebug)
+	dir := t.TempDir()
+	overlay := []byte(`[{"type":"event","name":"AssemblyEmitted","inputs":[]}]`)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Example.json"), overlay, 0o600))
+
+	got, err := readAbiOverlay(logger, dir, "Example")
+	require.NoError(t, err)
+	require.Equal(t, overlay, []byte(got))
+
+	got, err = readAbiOverlay(logger, dir, "Missing")
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
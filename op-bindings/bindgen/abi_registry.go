@@ -0,0 +1,105 @@
+package bindgen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"text/template"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// AbiRegistry collects the contracts of the generated bindings, to generate files registering their ABIs by
+// contract name, so ABIs can be resolved by name at runtime. Like the Manifest, it may be shared by multiple
+// generators. A file is generated per source, local and remote, since the sources may be generated separately.
+type AbiRegistry struct {
+	mu        sync.Mutex
+	contracts map[string]map[string]struct{}
+}
+
+func NewAbiRegistry() *AbiRegistry {
+	return &AbiRegistry{contracts: make(map[string]map[string]struct{})}
+}
+
+// record adds the binding of a contract from the given source to the registry, if there is one.
+func (r *AbiRegistry) record(source string, name string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.contracts[source] == nil {
+		r.contracts[source] = make(map[string]struct{})
+	}
+	r.contracts[source][name] = struct{}{}
+}
+
+// abiRegistryFileName returns the name of the file registering the ABIs of the contracts of the given source.
+func abiRegistryFileName(source string) string {
+	return "abi_registry_" + source + ".go"
+}
+
+type abiRegistryEntry struct {
+	Name   string
+	GoName string
+}
+
+// abiRegistryTemplate registers the metadata of the bindings, which lazily parses their ABIs, by contract name.
+var abiRegistryTemplate = template.Must(template.New("abiRegistry").Parse(`// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package {{.Package}}
+
+func init() {
+{{- range .Contracts}}
+	abis["{{.Name}}"] = {{.GoName}}MetaData
+{{- end}}
+}
+`))
+
+// abiRegistryEntryPattern matches the contract names registered in a generated ABI registry file.
+var abiRegistryEntryPattern = regexp.MustCompile(`abis\["([^"]+)"\]`)
+
+// Write writes a file registering the ABIs of the recorded contracts of each source to dir,
+// in the given bindings package, with the contracts sorted by name.
+// If keepExisting is set, the contracts registered in the existing files are kept registered,
+// for runs that only generate some of the contracts.
+func (r *AbiRegistry) Write(dir string, pkg string, spdxLicense string, keepExisting bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for source, names := range r.contracts {
+		registryPath := filepath.Join(dir, abiRegistryFileName(source))
+		if keepExisting {
+			existing, err := os.ReadFile(registryPath)
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("error reading existing %s ABI registry %s: %w", source, registryPath, err)
+			}
+			for _, match := range abiRegistryEntryPattern.FindAllSubmatch(existing, -1) {
+				names[string(match[1])] = struct{}{}
+			}
+		}
+		contracts := make([]abiRegistryEntry, 0, len(names))
+		for name := range names {
+			// abigen names the metadata of the binding after the camel-cased contract name
+			contracts = append(contracts, abiRegistryEntry{Name: name, GoName: abi.ToCamelCase(name)})
+		}
+		sort.Slice(contracts, func(i, j int) bool { return contracts[i].Name < contracts[j].Name })
+
+		var buf bytes.Buffer
+		if err := abiRegistryTemplate.Execute(&buf, struct {
+			Package   string
+			Contracts []abiRegistryEntry
+		}{pkg, contracts}); err != nil {
+			return fmt.Errorf("error generating %s ABI registry: %w", source, err)
+		}
+		if err := writeGoSource(registryPath, buf.Bytes(), spdxLicense); err != nil {
+			return fmt.Errorf("error writing %s ABI registry to %s: %w", source, registryPath, err)
+		}
+	}
+	return nil
+}
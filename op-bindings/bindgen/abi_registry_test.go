@@ -0,0 +1,48 @@
+package bindgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAbiRegistry(t *testing.T) {
+	registry := NewAbiRegistry()
+	registry.record(ManifestSourceLocal, "SystemConfig")
+	registry.record(ManifestSourceLocal, "L1Block")
+	registry.record(ManifestSourceRemote, "Safe_v130")
+
+	dir := t.TempDir()
+	require.NoError(t, registry.Write(dir, "bindings", "MIT", false))
+
+	local, err := os.ReadFile(filepath.Join(dir, "abi_registry_local.go"))
+	require.NoError(t, err)
+	require.Equal(t, `// SPDX-License-Identifier: MIT
+
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+func init() {
+	abis["L1Block"] = L1BlockMetaData
+	abis["SystemConfig"] = SystemConfigMetaData
+}
+`, string(local))
+
+	remote, err := os.ReadFile(filepath.Join(dir, "abi_registry_remote.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(remote), `abis["Safe_v130"] = SafeV130MetaData`)
+
+	// a run generating only some of the contracts keeps the other contracts registered
+	registry = NewAbiRegistry()
+	registry.record(ManifestSourceLocal, "ProxyAdmin")
+	require.NoError(t, registry.Write(dir, "bindings", "", true))
+	local, err = os.ReadFile(filepath.Join(dir, "abi_registry_local.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(local), `abis["L1Block"] = L1BlockMetaData
+	abis["ProxyAdmin"] = ProxyAdminMetaData
+	abis["SystemConfig"] = SystemConfigMetaData`)
+}
@@ -0,0 +1,40 @@
+package bindgen
+
+import (
+	"sort"
+	"sync"
+)
+
+// Changes records the contracts whose output files changed, i.e. were created, removed or rewritten with
+// different content, so reruns can tell which bindings actually changed. Like the Manifest, it may be shared
+// by multiple generators.
+type Changes struct {
+	mu        sync.Mutex
+	contracts map[string]struct{}
+}
+
+func NewChanges() *Changes {
+	return &Changes{contracts: make(map[string]struct{})}
+}
+
+// record records a changed contract, if there are changes to record.
+func (c *Changes) record(contractName string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.contracts[contractName] = struct{}{}
+}
+
+// Contracts returns the names of the changed contracts, sorted.
+func (c *Changes) Contracts() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	contracts := make([]string, 0, len(c.contracts))
+	for name := range c.contracts {
+		contracts = append(contracts, name)
+	}
+	sort.Strings(contracts)
+	return contracts
+}
@@ -0,0 +1,82 @@
+package bindgen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// CheckedDir pairs an output directory of the generator with the directory its output is generated into instead,
+// when checking whether the existing output is up to date.
+type CheckedDir struct {
+	Out       string
+	Generated string
+}
+
+// RedirectOutputs points every output of the generator into checkDir, so the existing output is left untouched.
+// The returned directories are compared with DiffOutputs once the bindings are generated.
+func (generator *BindGenGeneratorBase) RedirectOutputs(checkDir string) ([]CheckedDir, error) {
+	bindingsOut, err := generator.bindingsOutDir()
+	if err != nil {
+		return nil, err
+	}
+	dirs := []CheckedDir{
+		{Out: bindingsOut, Generated: filepath.Join(checkDir, "bindings")},
+		{Out: generator.MetadataOut, Generated: filepath.Join(checkDir, "metadata")},
+	}
+	generator.BindingsOut = dirs[0].Generated
+	generator.MetadataOut = dirs[1].Generated
+	if generator.TsOut != "" {
+		dirs = append(dirs, CheckedDir{Out: generator.TsOut, Generated: filepath.Join(checkDir, "ts")})
+		generator.TsOut = dirs[2].Generated
+	}
+	for _, dir := range dirs {
+		if err := os.MkdirAll(dir.Generated, 0o755); err != nil {
+			return nil, fmt.Errorf("error creating check output directory %s: %w", dir.Generated, err)
+		}
+	}
+	return dirs, nil
+}
+
+// DiffOutputs returns the paths of the existing output files which are missing or differ from the generated files,
+// sorted. Existing files which are not generated are ignored, since output directories may contain other files.
+func DiffOutputs(dirs []CheckedDir) ([]string, error) {
+	var changed []string
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir.Generated, func(generatedPath string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(dir.Generated, generatedPath)
+			if err != nil {
+				return err
+			}
+			outPath := filepath.Join(dir.Out, rel)
+
+			generated, err := os.ReadFile(generatedPath)
+			if err != nil {
+				return fmt.Errorf("error reading generated file %s: %w", generatedPath, err)
+			}
+			existing, err := os.ReadFile(outPath)
+			if errors.Is(err, fs.ErrNotExist) {
+				changed = append(changed, outPath)
+				return nil
+			} else if err != nil {
+				return fmt.Errorf("error reading existing file %s: %w", outPath, err)
+			}
+			if !bytes.Equal(existing, generated) {
+				changed = append(changed, outPath)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error comparing generated output with %s: %w", dir.Out, err)
+		}
+	}
+	sort.Strings(changed)
+	return changed, nil
+}
@@ -0,0 +1,46 @@
+package bindgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckOutputs(t *testing.T) {
+	outDir := t.TempDir()
+	generator := BindGenGeneratorBase{
+		BindingsOut: filepath.Join(outDir, "bindings"),
+		MetadataOut: filepath.Join(outDir, "metadata"),
+	}
+	write := func(dir, name, content string) {
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	write(generator.BindingsOut, "l1block.go", "bindings")
+	write(generator.BindingsOut, "handwritten.go", "not generated")
+	write(generator.MetadataOut, "l1block_more.go", "stale metadata")
+
+	dirs, err := generator.RedirectOutputs(t.TempDir())
+	require.NoError(t, err)
+	require.Len(t, dirs, 2)
+	require.Equal(t, dirs[0].Generated, generator.BindingsOut)
+	require.Equal(t, dirs[1].Generated, generator.MetadataOut)
+
+	write(generator.BindingsOut, "l1block.go", "bindings")
+	write(generator.BindingsOut, "gaspriceoracle.go", "new bindings")
+	write(generator.MetadataOut, "l1block_more.go", "metadata")
+
+	changed, err := DiffOutputs(dirs)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(outDir, "bindings", "gaspriceoracle.go"),
+		filepath.Join(outDir, "metadata", "l1block_more.go"),
+	}, changed)
+
+	// the existing output is left untouched
+	existing, err := os.ReadFile(filepath.Join(outDir, "metadata", "l1block_more.go"))
+	require.NoError(t, err)
+	require.Equal(t, "stale metadata", string(existing))
+}
@@ -0,0 +1,59 @@
+package bindgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ContractError is the failure to generate the bindings of a single contract.
+type ContractError struct {
+	Contract string
+	// Chains are the chains the contract is sourced from, empty for local contracts
+	Chains []string
+	Err    error
+}
+
+func (e *ContractError) Error() string {
+	if len(e.Chains) == 0 {
+		return fmt.Sprintf("%s: %v", e.Contract, e.Err)
+	}
+	return fmt.Sprintf("%s (chain %s): %v", e.Contract, strings.Join(e.Chains, ", "), e.Err)
+}
+
+func (e *ContractError) Unwrap() error {
+	return e.Err
+}
+
+// newRemoteContractError wraps the failure of a remote contract with the chains it is sourced from,
+// which is its chain if it has one, otherwise every chain it is deployed on.
+func newRemoteContractError(contract RemoteContract, err error) *ContractError {
+	var chains []string
+	if contract.Chain != "" {
+		chains = []string{contract.Chain}
+	} else {
+		for chain := range contract.Deployments {
+			chains = append(chains, chain)
+		}
+		sort.Strings(chains)
+	}
+	return &ContractError{Contract: contract.Name, Chains: chains, Err: err}
+}
+
+// ContractErrors returns the failures of individual contracts within err, which may join multiple errors.
+func ContractErrors(err error) []*ContractError {
+	switch err := err.(type) {
+	case *ContractError:
+		return []*ContractError{err}
+	case interface{ Unwrap() []error }:
+		var out []*ContractError
+		for _, err := range err.Unwrap() {
+			out = append(out, ContractErrors(err)...)
+		}
+		return out
+	case interface{ Unwrap() error }:
+		return ContractErrors(err.Unwrap())
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,28 @@
+package bindgen
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractErrors(t *testing.T) {
+	local := &ContractError{Contract: "L1Block", Err: errors.New("artifact not found")}
+	require.EqualError(t, local, "L1Block: artifact not found")
+
+	remote := newRemoteContractError(RemoteContract{
+		Name:        "MultiCall3",
+		Deployments: Deployments{"op": common.Address{1}, "eth": common.Address{1}},
+	}, errors.New("not verified"))
+	require.EqualError(t, remote, "MultiCall3 (chain eth, op): not verified")
+
+	err := errors.Join(
+		fmt.Errorf("error generating local bindings: %w", errors.Join(local)),
+		fmt.Errorf("error generating remote bindings: %w", errors.Join(nil, remote)),
+	)
+	require.Equal(t, []*ContractError{local, remote}, ContractErrors(err))
+	require.Empty(t, ContractErrors(errors.New("no chains configured")))
+}
@@ -0,0 +1,108 @@
+package bindgen
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// eventTopic describes a generated variable, holding the topic0 of an event of a contract.
+type eventTopic struct {
+	// Signature is the canonical signature of the event, whose hash is the topic.
+	Signature string
+	// GoName is the name of the generated variable.
+	GoName string
+	// Topic is the hex encoded topic0 of the event.
+	Topic string
+}
+
+// eventTopicsFileName returns the name of the file holding the event topics of a contract.
+func eventTopicsFileName(contractName string) string {
+	return strings.ToLower(contractName) + "_topics.go"
+}
+
+// eventTopics returns the topic0 of every event of the given JSON ABI, sorted by name.
+// Events are named like the event types abigen generates, which are prefixed with the contract name
+// and numbered if overloaded. Anonymous events are skipped, since they do not have a topic0.
+func eventTopics(contractName string, rawAbi []byte) ([]eventTopic, error) {
+	parsed, err := abi.JSON(bytes.NewReader(rawAbi))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s's ABI: %w", contractName, err)
+	}
+	var topics []eventTopic
+	for _, event := range parsed.Events {
+		if event.Anonymous {
+			continue
+		}
+		topics = append(topics, eventTopic{
+			Signature: event.Sig,
+			GoName:    abi.ToCamelCase(contractName) + abi.ToCamelCase(event.Name) + "EventTopic",
+			Topic:     event.ID.Hex(),
+		})
+	}
+	sort.Slice(topics, func(i, j int) bool { return topics[i].GoName < topics[j].GoName })
+	return topics, nil
+}
+
+var eventTopicsTemplate = template.Must(template.New("eventTopics").Parse(`// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package {{.Package}}
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+{{- range .Topics}}
+	// {{.GoName}} is the topic0 of {{.Signature}}
+	{{.GoName}} = common.HexToHash("{{.Topic}}")
+{{- end}}
+)
+`))
+
+// writeEventTopics writes a file next to the binding of a contract, declaring the topic0 of each of its events,
+// so consumers filtering logs do not need to hash the event signatures themselves.
+// No file is written for contracts without events.
+//
+// Parameters:
+// - logger: An instance of go-ethereum/log
+// - bindingsOut: The directory the Go bindings are written to.
+// - goPackageName: The name of the Go package of the bindings.
+// - contractName: The name of the contract, used for the file name and to prefix the variables.
+// - rawAbi: The JSON ABI of the contract.
+// - spdxLicense: An optional SPDX license identifier to inject at the top of the file.
+//
+// Returns:
+// - An error if the ABI is malformed or writing the file fails, nil otherwise.
+func writeEventTopics(logger log.Logger, bindingsOut, goPackageName, contractName string, rawAbi []byte, spdxLicense string) error {
+	topics, err := eventTopics(contractName, rawAbi)
+	if err != nil {
+		return err
+	}
+	if len(topics) == 0 {
+		logger.Debug("Contract has no events, skipping event topics", "contract", contractName)
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := eventTopicsTemplate.Execute(&buf, struct {
+		Package string
+		Topics  []eventTopic
+	}{goPackageName, topics}); err != nil {
+		return fmt.Errorf("error generating %s's event topics: %w", contractName, err)
+	}
+	topicsFilePath := filepath.Join(bindingsOut, eventTopicsFileName(contractName))
+	if err := writeGoSource(topicsFilePath, buf.Bytes(), spdxLicense); err != nil {
+		return fmt.Errorf("error writing %s's event topics at %s: %w", contractName, topicsFilePath, err)
+	}
+
+	logger.Debug("Successfully wrote event topics", "contract", contractName, "path", topicsFilePath)
+	return nil
+}
@@ -0,0 +1,62 @@
+package bindgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteEventTopics(t *testing.T) {
+	logger := log.NewLogger(log.DiscardHandler())
+
+	t.Run("WritesTopics", func(t *testing.T) {
+		abi := []byte(`[
+			{"type":"event","name":"Initiated","inputs":[{"name":"callHash","type":"bytes32","indexed":true}],"anonymous":false},
+			{"type":"event","name":"Forwarded","inputs":[{"name":"callHash","type":"bytes32","indexed":true},{"name":"data","type":"bytes","indexed":false}],"anonymous":false},
+			{"type":"event","name":"Forwarded","inputs":[],"anonymous":false},
+			{"type":"event","name":"Hidden","inputs":[],"anonymous":true},
+			{"type":"function","name":"number","inputs":[],"outputs":[{"name":"","type":"uint64"}],"stateMutability":"view"}
+		]`)
+		dir := t.TempDir()
+		require.NoError(t, writeEventTopics(logger, dir, "bindings", "DelayedVetoable", abi, "MIT"))
+
+		content, err := os.ReadFile(filepath.Join(dir, "delayedvetoable_topics.go"))
+		require.NoError(t, err)
+		require.Equal(t, `// SPDX-License-Identifier: MIT
+
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// DelayedVetoableForwarded0EventTopic is the topic0 of Forwarded()
+	DelayedVetoableForwarded0EventTopic = common.HexToHash("`+crypto.Keccak256Hash([]byte("Forwarded()")).Hex()+`")
+	// DelayedVetoableForwardedEventTopic is the topic0 of Forwarded(bytes32,bytes)
+	DelayedVetoableForwardedEventTopic = common.HexToHash("`+crypto.Keccak256Hash([]byte("Forwarded(bytes32,bytes)")).Hex()+`")
+	// DelayedVetoableInitiatedEventTopic is the topic0 of Initiated(bytes32)
+	DelayedVetoableInitiatedEventTopic = common.HexToHash("`+crypto.Keccak256Hash([]byte("Initiated(bytes32)")).Hex()+`")
+)
+`, string(content))
+	})
+
+	t.Run("NoEvents", func(t *testing.T) {
+		abi := []byte(`[{"type":"function","name":"number","inputs":[],"outputs":[{"name":"","type":"uint64"}],"stateMutability":"view"}]`)
+		dir := t.TempDir()
+		require.NoError(t, writeEventTopics(logger, dir, "bindings", "L1Block", abi, ""))
+		require.NoFileExists(t, filepath.Join(dir, "l1block_topics.go"))
+	})
+
+	t.Run("MalformedAbi", func(t *testing.T) {
+		err := writeEventTopics(logger, t.TempDir(), "bindings", "L1Block", []byte(`[{`), "")
+		require.ErrorContains(t, err, "error parsing L1Block's ABI")
+	})
+}
@@ -1,6 +1,8 @@
 package bindgen
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,15 +12,58 @@ import (
 	"regexp"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/ast"
 	"github.com/ethereum-optimism/optimism/op-bindings/foundry"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type BindGenGeneratorLocal struct {
 	BindGenGeneratorBase
 	SourceMapsList     string
 	ForgeArtifactsPath string
+	AbiOverlayPath     string
+	// ImmutableGetters enables generating typed getters which decode immutables from deployed bytecode.
+	ImmutableGetters bool
+	// StorageSlots enables generating functions which compute the storage slots of the variables of storage layouts.
+	StorageSlots bool
+	// Libraries are the addresses of the external libraries linked into the bytecode of contracts,
+	// keyed by library name, or by <source>:<name> for libraries of the same name.
+	Libraries map[string]common.Address
+	// SourceMapsOptional allows contracts of the SourceMapsList to have no source map in their forge artifact,
+	// in which case they are generated without one. Otherwise such contracts fail with ErrMissingSourceMap.
+	SourceMapsOptional bool
+}
+
+// ErrMissingSourceMap is returned when a contract of the source-maps list has no deployed source map in its forge artifact.
+var ErrMissingSourceMap = errors.New("missing source map")
+
+// LocalContract is a contract with locally available Forge artifacts. In the contracts list it is
+// either given by name, or as an object to configure it.
+type LocalContract struct {
+	Name string `json:"name"`
+	// AbiOnly generates just the ABI-backed binding, without bytecode, immutables or a storage layout,
+	// for contracts which are only ever called, never deployed.
+	AbiOnly bool `json:"abiOnly"`
+}
+
+func (c *LocalContract) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*c = LocalContract{Name: name}
+		return nil
+	}
+	type localContract LocalContract
+	var contract localContract
+	if err := json.Unmarshal(data, &contract); err != nil {
+		return fmt.Errorf("local contract must be a name or an object: %w", err)
+	}
+	if contract.Name == "" {
+		return errors.New("local contract is missing a name")
+	}
+	*c = LocalContract(contract)
+	return nil
 }
 
 type localContractMetadata struct {
@@ -28,9 +73,16 @@ type localContractMetadata struct {
 	Package                string
 	DeployedSourceMap      string
 	HasImmutableReferences bool
+	ImmutableReferences    string
+	ImmutableGetters       []immutableGetter
+	StorageSlots           []storageSlotGetter
+	StdImports             []string
+	Imports                []string
 }
 
-func (generator *BindGenGeneratorLocal) GenerateBindings() error {
+// GenerateBindings generates the bindings of the local and proxy contracts.
+// Cancelling ctx stops generating the remaining contracts.
+func (generator *BindGenGeneratorLocal) GenerateBindings(ctx context.Context) error {
 	contracts, err := readContractList(generator.Logger, generator.ContractsListPath)
 	if err != nil {
 		return fmt.Errorf("error reading contract list %s: %w", generator.ContractsListPath, err)
@@ -38,11 +90,21 @@ func (generator *BindGenGeneratorLocal) GenerateBindings() error {
 	if len(contracts.Local) == 0 {
 		return fmt.Errorf("no contracts parsed from given contract list: %s", generator.ContractsListPath)
 	}
+	if contracts, err = generator.filterContracts(contracts); err != nil {
+		return err
+	}
+
+	if err := generator.processContracts(ctx, contracts.Local); err != nil {
+		return err
+	}
 
-	return generator.processContracts(contracts.Local)
+	if len(contracts.Proxies) == 0 {
+		return nil
+	}
+	return generator.processProxyContracts(ctx, contracts.Proxies)
 }
 
-func (generator *BindGenGeneratorLocal) processContracts(contracts []string) error {
+func (generator *BindGenGeneratorLocal) processContracts(ctx context.Context, contracts []LocalContract) error {
 	tempArtifactsDir, err := mkTempArtifactsDir(generator.Logger)
 	if err != nil {
 		return err
@@ -67,54 +129,163 @@ func (generator *BindGenGeneratorLocal) processContracts(contracts []string) err
 		return err
 	}
 
-	contractMetadataFileTemplate := template.Must(template.New("localContractMetadata").Parse(localContractMetadataTemplate))
+	var immutableDecls map[string]immutableDeclaration
+	if generator.ImmutableGetters {
+		if immutableDecls, err = readImmutableDeclarations(contractArtifactPaths); err != nil {
+			return err
+		}
+	}
 
-	for _, contractName := range contracts {
-		generator.Logger.Info("Generating bindings and metadata for local contract", "contract", contractName)
+	contractMetadataFileTemplate := template.Must(template.New("localContractMetadata").Parse(localContractMetadataTemplate))
 
-		forgeArtifact, err := generator.readForgeArtifact(contractName, contractArtifactPaths)
-		if err != nil {
+	var failures []error
+	for _, contract := range contracts {
+		if err := ctx.Err(); err != nil {
 			return err
 		}
-
-		abiFilePath, bytecodeFilePath, err := writeContractArtifacts(generator.Logger, tempArtifactsDir, contractName, forgeArtifact.Abi, []byte(forgeArtifact.Bytecode.Object.String()))
-		if err != nil {
+		start := time.Now()
+		before, err := generator.hashOutputs(contract.Name, !contract.AbiOnly)
+		if err == nil {
+			err = generator.processContract(contract, tempArtifactsDir, sourceMapsSet, contractArtifactPaths, immutableDecls, contractMetadataFileTemplate)
+		}
+		if err == nil {
+			err = generator.reportContract(ReportContract{Name: contract.Name, Source: ManifestSourceLocal}, !contract.AbiOnly, before, time.Since(start))
+		}
+		if err == nil {
+			continue
+		}
+		err = &ContractError{Contract: contract.Name, Err: err}
+		if !generator.ContinueOnError {
 			return err
 		}
+		generator.Logger.Error("Failed to generate bindings, continuing with the remaining contracts", "contract", contract.Name, "err", err)
+		failures = append(failures, err)
+	}
 
-		err = genContractBindings(generator.Logger, generator.MonorepoBasePath, abiFilePath, bytecodeFilePath, generator.BindingsPackageName, contractName)
-		if err != nil {
+	return errors.Join(failures...)
+}
+
+// writeBindings writes the bindings of a contract, and its event topics and TypeScript ABI if enabled.
+// The bindings are generated without bytecode if none is given.
+func (generator *BindGenGeneratorLocal) writeBindings(contractName, tempArtifactsDir string, abi, bytecode []byte) error {
+	abiFilePath, bytecodeFilePath, err := writeContractArtifacts(generator.Logger, tempArtifactsDir, contractName, abi, bytecode)
+	if err != nil {
+		return err
+	}
+
+	bindingsOut, err := generator.bindingsOutDir()
+	if err != nil {
+		return err
+	}
+	err = genContractBindings(generator.Logger, generator.MonorepoBasePath, abiFilePath, bytecodeFilePath, bindingsOut, generator.BindingsPackageName, contractName, generator.SpdxLicense)
+	if err != nil {
+		return err
+	}
+
+	if err := writeTsAbi(generator.Logger, generator.TsOut, contractName, abi, generator.SpdxLicense); err != nil {
+		return err
+	}
+
+	if generator.EventTopics {
+		if err := writeEventTopics(generator.Logger, bindingsOut, generator.BindingsPackageName, contractName, abi, generator.SpdxLicense); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		deployedSourceMap, canonicalStorageStr, err := generator.canonicalizeStorageLayout(forgeArtifact, sourceMapsSet, contractName)
-		if err != nil {
+// processContract generates the bindings and metadata of a single local contract,
+// or only its metadata with MetadataOnly.
+func (generator *BindGenGeneratorLocal) processContract(contract LocalContract, tempArtifactsDir string, sourceMapsSet map[string]struct{}, contractArtifactPaths map[string]string, immutableDecls map[string]immutableDeclaration, contractMetadataFileTemplate *template.Template) error {
+	contractName := contract.Name
+	if generator.MetadataOnly {
+		generator.Logger.Info("Generating metadata for local contract", "contract", contractName, "abiOnly", contract.AbiOnly)
+	} else {
+		generator.Logger.Info("Generating bindings and metadata for local contract", "contract", contractName, "abiOnly", contract.AbiOnly)
+	}
+
+	forgeArtifact, err := generator.readForgeArtifact(contractName, contractArtifactPaths, contract.AbiOnly)
+	if err != nil {
+		return err
+	}
+
+	if err := generator.applyAbiOverlay(contractName, &forgeArtifact); err != nil {
+		return err
+	}
+
+	var bytecode []byte
+	if !contract.AbiOnly {
+		bytecode = []byte(forgeArtifact.Bytecode.Object.String())
+	}
+	if !generator.MetadataOnly {
+		if err := generator.writeBindings(contractName, tempArtifactsDir, forgeArtifact.Abi, bytecode); err != nil {
 			return err
 		}
+	}
 
-		re := regexp.MustCompile(`\s+`)
-		immutableRefs, err := json.Marshal(re.ReplaceAllString(string(forgeArtifact.DeployedBytecode.ImmutableReferences), ""))
-		if err != nil {
-			return fmt.Errorf("error marshaling immutable references: %w", err)
-		}
+	var deployedBin string
+	if !contract.AbiOnly {
+		deployedBin = forgeArtifact.DeployedBytecode.Object.String()
+	}
+	if err := generator.Manifest.record(ManifestEntry{Name: contractName, Source: ManifestSourceLocal}, forgeArtifact.Abi, deployedBin); err != nil {
+		return err
+	}
+	generator.AbiRegistry.record(ManifestSourceLocal, contractName)
+
+	if contract.AbiOnly {
+		return nil
+	}
+
+	deployedSourceMap, canonicalStorageStr, err := generator.canonicalizeStorageLayout(forgeArtifact, sourceMapsSet, contractName)
+	if err != nil {
+		return err
+	}
 
-		hasImmutables := string(immutableRefs) != `""`
+	rawImmutableRefs, err := forgeArtifact.ImmutableReferences()
+	if err != nil {
+		return fmt.Errorf("error reading %s's immutable references: %w", contractName, err)
+	}
+	re := regexp.MustCompile(`\s+`)
+	immutableRefs, err := json.Marshal(re.ReplaceAllString(string(rawImmutableRefs), ""))
+	if err != nil {
+		return fmt.Errorf("error marshaling immutable references: %w", err)
+	}
+
+	hasImmutables := string(immutableRefs) != `""`
+
+	contractMetaData := localContractMetadata{
+		Name:                   contractName,
+		StorageLayout:          canonicalStorageStr,
+		DeployedBin:            deployedBin,
+		Package:                generator.BindingsPackageName,
+		DeployedSourceMap:      deployedSourceMap,
+		HasImmutableReferences: hasImmutables,
+	}
+	if hasImmutables {
+		contractMetaData.ImmutableReferences = string(immutableRefs)
+	}
 
-		contractMetaData := localContractMetadata{
-			Name:                   contractName,
-			StorageLayout:          canonicalStorageStr,
-			DeployedBin:            forgeArtifact.DeployedBytecode.Object.String(),
-			Package:                generator.BindingsPackageName,
-			DeployedSourceMap:      deployedSourceMap,
-			HasImmutableReferences: hasImmutables,
+	if generator.ImmutableGetters && hasImmutables {
+		getters, skipped, err := immutableGetters(rawImmutableRefs, immutableDecls)
+		if err != nil {
+			return fmt.Errorf("error generating immutable getters for %s: %w", contractName, err)
 		}
+		if len(skipped) > 0 {
+			generator.Logger.Warn("Skipping immutable getters of unsupported types", "contract", contractName, "immutables", skipped)
+		}
+		contractMetaData.ImmutableGetters = getters
+	}
 
-		if err := generator.writeContractMetadata(contractMetaData, contractName, contractMetadataFileTemplate); err != nil {
-			return err
+	if generator.StorageSlots {
+		slots, skipped := storageSlotGetters(forgeArtifact.StorageLayout)
+		if len(skipped) > 0 {
+			generator.Logger.Warn("Skipping storage slots of unsupported mapping keys or ambiguous names", "contract", contractName, "variables", skipped)
 		}
+		contractMetaData.StorageSlots = slots
 	}
+	contractMetaData.StdImports, contractMetaData.Imports = metadataImports(contractMetaData.ImmutableGetters, contractMetaData.StorageSlots)
 
-	return nil
+	return generator.writeContractMetadata(contractMetaData, contractName, contractMetadataFileTemplate)
 }
 
 func (generator *BindGenGeneratorLocal) getContractArtifactPaths() (map[string]string, error) {
@@ -152,7 +323,9 @@ func (generator *BindGenGeneratorLocal) getContractArtifactPaths() (map[string]s
 	return artifactPaths, nil
 }
 
-func (generator *BindGenGeneratorLocal) readForgeArtifact(contractName string, contractArtifactPaths map[string]string) (foundry.Artifact, error) {
+// readForgeArtifact reads the forge artifact of a contract, with its external libraries linked.
+// If abiOnly is set, the bytecode is not used, so libraries are allowed to be unlinked.
+func (generator *BindGenGeneratorLocal) readForgeArtifact(contractName string, contractArtifactPaths map[string]string, abiOnly bool) (foundry.Artifact, error) {
 	var forgeArtifact foundry.Artifact
 
 	contractArtifactPath := path.Join(generator.ForgeArtifactsPath, contractName+".sol", contractName+".json")
@@ -167,6 +340,10 @@ func (generator *BindGenGeneratorLocal) readForgeArtifact(contractName string, c
 	}
 
 	generator.Logger.Debug("Using forge-artifact", "path", contractArtifactPath)
+	forgeArtifactRaw, err = linkLibraries(forgeArtifactRaw, generator.Libraries, abiOnly)
+	if err != nil {
+		return forgeArtifact, fmt.Errorf("failed to link forge artifact of %q: %w", contractName, err)
+	}
 	if err := json.Unmarshal(forgeArtifactRaw, &forgeArtifact); err != nil {
 		return forgeArtifact, fmt.Errorf("failed to parse forge artifact of %q: %w", contractName, err)
 	}
@@ -174,6 +351,22 @@ func (generator *BindGenGeneratorLocal) readForgeArtifact(contractName string, c
 	return forgeArtifact, nil
 }
 
+func (generator *BindGenGeneratorLocal) applyAbiOverlay(contractName string, forgeArtifact *foundry.Artifact) error {
+	if generator.AbiOverlayPath == "" {
+		return nil
+	}
+	overlay, err := readAbiOverlay(generator.Logger, generator.AbiOverlayPath, contractName)
+	if err != nil {
+		return err
+	}
+	if overlay != nil {
+		if forgeArtifact.Abi, err = mergeAbiOverlay(forgeArtifact.Abi, overlay); err != nil {
+			return fmt.Errorf("error applying ABI overlay for %s: %w", contractName, err)
+		}
+	}
+	return nil
+}
+
 func (generator *BindGenGeneratorLocal) canonicalizeStorageLayout(forgeArtifact foundry.Artifact, sourceMapsSet map[string]struct{}, contractName string) (string, string, error) {
 	artifactStorageStruct := forgeArtifact.StorageLayout
 	canonicalStorageStruct := ast.CanonicalizeASTIDs(&artifactStorageStruct, generator.MonorepoBasePath)
@@ -185,25 +378,25 @@ func (generator *BindGenGeneratorLocal) canonicalizeStorageLayout(forgeArtifact
 
 	deployedSourceMap := ""
 	if _, ok := sourceMapsSet[contractName]; ok {
-		deployedSourceMap = forgeArtifact.DeployedBytecode.SourceMap
+		deployedSourceMap = forgeArtifact.DeployedSourceMap()
+		if deployedSourceMap == "" {
+			if !generator.SourceMapsOptional {
+				return "", "", fmt.Errorf("%w: %s is in the source-maps list, but its forge artifact has no deployed source map", ErrMissingSourceMap, contractName)
+			}
+			generator.Logger.Warn("Contract is in the source-maps list, but its forge artifact has no deployed source map", "contract", contractName)
+		}
 	}
 
 	return deployedSourceMap, canonicalStorageStr, nil
 }
 
-func (generator *BindGenGeneratorLocal) writeContractMetadata(contractMetaData localContractMetadata, contractName string, fileTemplate *template.Template) error {
+func (generator *BindGenGeneratorLocal) writeContractMetadata(contractMetaData any, contractName string, fileTemplate *template.Template) error {
 	metadataFilePath := filepath.Join(generator.MetadataOut, strings.ToLower(contractName)+"_more.go")
-	metadataFile, err := os.OpenFile(
-		metadataFilePath,
-		os.O_RDWR|os.O_CREATE|os.O_TRUNC,
-		0o600,
-	)
-	if err != nil {
-		return fmt.Errorf("error opening %s's metadata file at %s: %w", contractName, metadataFilePath, err)
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, contractMetaData); err != nil {
+		return fmt.Errorf("error generating %s's contract metadata: %w", contractName, err)
 	}
-	defer metadataFile.Close()
-
-	if err := fileTemplate.Execute(metadataFile, contractMetaData); err != nil {
+	if err := writeGoSource(metadataFilePath, buf.Bytes(), generator.SpdxLicense); err != nil {
 		return fmt.Errorf("error writing %s's contract metadata at %s: %w", contractName, metadataFilePath, err)
 	}
 
@@ -221,6 +414,10 @@ func (generator *BindGenGeneratorLocal) writeContractMetadata(contractMetaData l
 // - StorageLayout: Canonicalized storage layout of the contract as a JSON string.
 // - DeployedBin: The deployed bytecode of the contract.
 // - DeployedSourceMap (optional): The source map of the deployed contract.
+// - ImmutableReferences (optional): The quoted JSON immutable references, validated against the deployed bytecode at init.
+// - ImmutableGetters (optional): Typed getters decoding the contract's immutables from deployed bytecode.
+// - StorageSlots (optional): Functions computing the storage slots of the contract's storage variables.
+// - StdImports, Imports (optional): The imports required by the immutable getters and storage slot functions.
 var localContractMetadataTemplate = `// Code generated - DO NOT EDIT.
 // This file is a generated binding and any manual changes will be lost.
 
@@ -228,8 +425,14 @@ package {{.Package}}
 
 import (
 	"encoding/json"
+{{- range .StdImports}}
+	"{{.}}"
+{{- end}}
 
 	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
 )
 
 const {{.Name}}StorageLayoutJSON = "{{.StorageLayout}}"
@@ -237,7 +440,9 @@ const {{.Name}}StorageLayoutJSON = "{{.StorageLayout}}"
 var {{.Name}}StorageLayout = new(solc.StorageLayout)
 
 var {{.Name}}DeployedBin = "{{.DeployedBin}}"
-{{if .DeployedSourceMap}}
+{{if .ImmutableReferences}}
+const {{.Name}}ImmutableReferencesJSON = {{.ImmutableReferences}}
+{{end}}{{if .DeployedSourceMap}}
 var {{.Name}}DeployedSourceMap = "{{.DeployedSourceMap}}"
 {{end}}
 
@@ -249,5 +454,63 @@ func init() {
 	layouts["{{.Name}}"] = {{.Name}}StorageLayout
 	deployedBytecodes["{{.Name}}"] = {{.Name}}DeployedBin
 	immutableReferences["{{.Name}}"] = {{.HasImmutableReferences}}
+{{- if .ImmutableReferences}}
+	registerImmutableReferences("{{.Name}}", {{.Name}}DeployedBin, {{.Name}}ImmutableReferencesJSON)
+{{- end}}
 }
+{{- if .ImmutableGetters}}
+
+// {{.Name}}Immutables decodes the immutables of {{.Name}} from its deployed bytecode.
+type {{.Name}}Immutables struct {
+	deployedBin []byte
+}
+
+// New{{.Name}}Immutables returns a decoder of the immutables stored in the given deployed bytecode of {{.Name}}.
+func New{{.Name}}Immutables(deployedBin []byte) *{{.Name}}Immutables {
+	return &{{.Name}}Immutables{deployedBin: deployedBin}
+}
+{{- range .ImmutableGetters}}
+
+// {{.GoName}} decodes the {{.Name}} immutable.
+func (i *{{$.Name}}Immutables) {{.GoName}}() ({{.GoType}}, error) {
+	return {{.Decoder}}(i.deployedBin, "{{.Name}}", []uint{ {{- .Offsets -}} })
+}
+{{- end}}
+{{- end}}
+{{- range .StorageSlots}}
+
+// {{$.Name}}{{.GoName}}Slot returns the storage slot of {{if .Keys}}the value at the given key{{if gt (len .Keys) 1}}s{{end}} of {{end}}{{.Label}}
+{{- if .Offset}}, which is stored at byte offset {{.Offset}} of the slot{{end}}.
+func {{$.Name}}{{.GoName}}Slot({{.Params}}) common.Hash {
+	slot := storageSlot({{.Slot}})
+{{- range .Keys}}
+	slot = mappingSlot({{.}}, slot)
+{{- end}}
+	return slot
+}
+{{- end}}
 `
+
+// metadataImports returns the standard library and third-party imports required by the given immutable getters
+// and storage slot functions.
+func metadataImports(getters []immutableGetter, slots []storageSlotGetter) (stdImports []string, imports []string) {
+	needsBig, needsCommon := false, len(slots) > 0
+	for _, getter := range getters {
+		switch getter.GoType {
+		case "*big.Int":
+			needsBig = true
+		case "common.Address":
+			needsCommon = true
+		}
+	}
+	for _, slot := range slots {
+		needsBig = needsBig || strings.Contains(slot.Params, "*big.Int")
+	}
+	if needsBig {
+		stdImports = append(stdImports, "math/big")
+	}
+	if needsCommon {
+		imports = append(imports, "github.com/ethereum/go-ethereum/common")
+	}
+	return stdImports, imports
+}
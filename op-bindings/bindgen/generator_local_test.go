@@ -0,0 +1,83 @@
+package bindgen
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/foundry"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalizeStorageLayoutSourceMaps(t *testing.T) {
+	sourceMapsSet := map[string]struct{}{"MIPS": {}}
+	withSourceMap := foundry.Artifact{DeployedBytecode: foundry.DeployedBytecode{SourceMap: "80:120:0:-:0;;;;"}}
+	generator := BindGenGeneratorLocal{BindGenGeneratorBase: BindGenGeneratorBase{Logger: testlog.Logger(t, log.LevelInfo)}}
+
+	sourceMap, _, err := generator.canonicalizeStorageLayout(withSourceMap, sourceMapsSet, "MIPS")
+	require.NoError(t, err)
+	require.Equal(t, "80:120:0:-:0;;;;", sourceMap)
+
+	// contracts which are not in the source-maps list do not need a source map
+	sourceMap, _, err = generator.canonicalizeStorageLayout(foundry.Artifact{}, sourceMapsSet, "PreimageOracle")
+	require.NoError(t, err)
+	require.Empty(t, sourceMap)
+
+	_, _, err = generator.canonicalizeStorageLayout(foundry.Artifact{}, sourceMapsSet, "MIPS")
+	require.ErrorIs(t, err, ErrMissingSourceMap)
+	require.ErrorContains(t, err, "MIPS")
+
+	generator.SourceMapsOptional = true
+	sourceMap, _, err = generator.canonicalizeStorageLayout(foundry.Artifact{}, sourceMapsSet, "MIPS")
+	require.NoError(t, err)
+	require.Empty(t, sourceMap)
+}
+
+func TestProcessContractsMetadataOnly(t *testing.T) {
+	artifactsDir := t.TempDir()
+	writeTestForgeArtifact(t, artifactsDir, "L1Block", `{
+		"abi": [{"type":"function","name":"number","inputs":[],"outputs":[{"name":"","type":"uint64"}],"stateMutability":"view"}],
+		"storageLayout": {"storage": [{"astId": 1, "contract": "src/L2/L1Block.sol:L1Block", "label": "number", "offset": 0, "slot": "0", "type": "t_uint64"}],
+			"types": {"t_uint64": {"encoding": "inplace", "label": "uint64", "numberOfBytes": "8"}}},
+		"bytecode": {"object": "0x6080"},
+		"deployedBytecode": {"object": "0x6001"}
+	}`)
+	bindingsDir, metadataDir := t.TempDir(), t.TempDir()
+	changes := NewChanges()
+	generator := BindGenGeneratorLocal{
+		BindGenGeneratorBase: BindGenGeneratorBase{
+			MetadataOut:         metadataDir,
+			BindingsPackageName: "bindings",
+			BindingsOut:         bindingsDir,
+			EventTopics:         true,
+			MetadataOnly:        true,
+			Changes:             changes,
+			Logger:              testlog.Logger(t, log.LevelInfo),
+		},
+		ForgeArtifactsPath: artifactsDir,
+	}
+
+	// abigen is not run, so the metadata is regenerated without it
+	require.NoError(t, generator.processContracts(context.Background(), []LocalContract{{Name: "L1Block"}}))
+	metadata, err := os.ReadFile(filepath.Join(metadataDir, "l1block_more.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(metadata), `var L1BlockDeployedBin = "0x6001"`)
+	bindings, err := os.ReadDir(bindingsDir)
+	require.NoError(t, err)
+	require.Empty(t, bindings)
+	require.Equal(t, []string{"L1Block"}, changes.Contracts())
+
+	// regenerating identical metadata is no change
+	changes = NewChanges()
+	generator.Changes = changes
+	require.NoError(t, generator.processContracts(context.Background(), []LocalContract{{Name: "L1Block"}}))
+	require.Empty(t, changes.Contracts())
+
+	// the remaining contracts are not generated once cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, generator.processContracts(ctx, []LocalContract{{Name: "L1Block"}}), context.Canceled)
+}
@@ -0,0 +1,174 @@
+package bindgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/template"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ProxyContract describes a proxy whose binding should target the proxy's address,
+// while using the ABI and storage layout of the implementation it delegates to.
+type ProxyContract struct {
+	Name           string         `json:"name"`
+	Implementation string         `json:"implementation"`
+	Address        common.Address `json:"address"`
+}
+
+type proxyContractMetadata struct {
+	Name           string
+	Implementation string
+	Address        common.Address
+	StorageLayout  string
+	Package        string
+}
+
+func (proxy ProxyContract) validate() error {
+	if proxy.Name == "" {
+		return fmt.Errorf("proxy contract is missing a name")
+	}
+	if proxy.Implementation == "" {
+		return fmt.Errorf("proxy contract %s is missing an implementation", proxy.Name)
+	}
+	if proxy.Address == (common.Address{}) {
+		return fmt.Errorf("proxy contract %s is missing an address", proxy.Name)
+	}
+	return nil
+}
+
+func (generator *BindGenGeneratorLocal) processProxyContracts(ctx context.Context, proxies []ProxyContract) error {
+	for _, proxy := range proxies {
+		if err := proxy.validate(); err != nil {
+			return err
+		}
+	}
+
+	tempArtifactsDir, err := mkTempArtifactsDir(generator.Logger)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := os.RemoveAll(tempArtifactsDir)
+		if err != nil {
+			generator.Logger.Error("Error removing temporary artifact directory", "path", tempArtifactsDir, "err", err.Error())
+		} else {
+			generator.Logger.Debug("Successfully removed temporary artifact directory")
+		}
+	}()
+
+	contractArtifactPaths, err := generator.getContractArtifactPaths()
+	if err != nil {
+		return err
+	}
+
+	proxyMetadataFileTemplate := template.Must(template.New("proxyContractMetadata").Parse(proxyContractMetadataTemplate))
+
+	var failures []error
+	for _, proxy := range proxies {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		start := time.Now()
+		before, err := generator.hashOutputs(proxy.Name, true)
+		if err == nil {
+			err = generator.processProxyContract(proxy, tempArtifactsDir, contractArtifactPaths, proxyMetadataFileTemplate)
+		}
+		if err == nil {
+			err = generator.reportContract(ReportContract{Name: proxy.Name, Source: ManifestSourceLocal}, true, before, time.Since(start))
+		}
+		if err == nil {
+			continue
+		}
+		err = &ContractError{Contract: proxy.Name, Err: err}
+		if !generator.ContinueOnError {
+			return err
+		}
+		generator.Logger.Error("Failed to generate proxy bindings, continuing with the remaining proxies", "proxy", proxy.Name, "err", err)
+		failures = append(failures, err)
+	}
+
+	return errors.Join(failures...)
+}
+
+// processProxyContract generates the bindings and metadata of a single proxy, or only its metadata with MetadataOnly.
+func (generator *BindGenGeneratorLocal) processProxyContract(proxy ProxyContract, tempArtifactsDir string, contractArtifactPaths map[string]string, proxyMetadataFileTemplate *template.Template) error {
+	generator.Logger.Info("Generating proxy-aware bindings and metadata", "proxy", proxy.Name, "implementation", proxy.Implementation)
+
+	forgeArtifact, err := generator.readForgeArtifact(proxy.Implementation, contractArtifactPaths, true)
+	if err != nil {
+		return err
+	}
+	if err := generator.applyAbiOverlay(proxy.Implementation, &forgeArtifact); err != nil {
+		return err
+	}
+
+	// The proxy binding is never used to deploy the implementation, so no bytecode is provided
+	if !generator.MetadataOnly {
+		if err := generator.writeBindings(proxy.Name, tempArtifactsDir, forgeArtifact.Abi, nil); err != nil {
+			return err
+		}
+	}
+
+	address := proxy.Address
+	if err := generator.Manifest.record(ManifestEntry{Name: proxy.Name, Source: ManifestSourceLocal, Address: &address}, forgeArtifact.Abi, ""); err != nil {
+		return err
+	}
+	generator.AbiRegistry.record(ManifestSourceLocal, proxy.Name)
+
+	_, canonicalStorageStr, err := generator.canonicalizeStorageLayout(forgeArtifact, nil, proxy.Implementation)
+	if err != nil {
+		return err
+	}
+
+	contractMetaData := proxyContractMetadata{
+		Name:           proxy.Name,
+		Implementation: proxy.Implementation,
+		Address:        proxy.Address,
+		StorageLayout:  canonicalStorageStr,
+		Package:        generator.BindingsPackageName,
+	}
+
+	return generator.writeContractMetadata(contractMetaData, proxy.Name, proxyMetadataFileTemplate)
+}
+
+// proxyContractMetadataTemplate is a Go text template for generating the metadata
+// of a proxy contract. The storage layout is that of the implementation, since it
+// is the implementation's code which operates on the proxy's storage.
+//
+// The template expects the following fields to be provided:
+// - Package: The name of the Go package for the generated bindings.
+// - Name: The name of the proxy.
+// - Implementation: The name of the implementation contract.
+// - Address: The address of the proxy.
+// - StorageLayout: Canonicalized storage layout of the implementation as a JSON string.
+var proxyContractMetadataTemplate = `// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package {{.Package}}
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const {{.Name}}StorageLayoutJSON = "{{.StorageLayout}}"
+
+var {{.Name}}StorageLayout = new(solc.StorageLayout)
+
+// {{.Name}}Address is the address of the proxy, which delegates to {{.Implementation}}.
+var {{.Name}}Address = common.HexToAddress("{{.Address}}")
+
+func init() {
+	if err := json.Unmarshal([]byte({{.Name}}StorageLayoutJSON), {{.Name}}StorageLayout); err != nil {
+		panic(err)
+	}
+
+	layouts["{{.Name}}"] = {{.Name}}StorageLayout
+}
+`
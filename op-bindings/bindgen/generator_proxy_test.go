@@ -0,0 +1,39 @@
+package bindgen
+
+import (
+	"bytes"
+	"go/format"
+	"testing"
+	"text/template"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProxyContractValidate(t *testing.T) {
+	address := common.HexToAddress("0xbEb5Fc579115071764c7423A4f12eDde41f106Ed")
+
+	require.NoError(t, ProxyContract{Name: "PortalProxy", Implementation: "OptimismPortal", Address: address}.validate())
+	require.ErrorContains(t, ProxyContract{Implementation: "OptimismPortal", Address: address}.validate(), "missing a name")
+	require.ErrorContains(t, ProxyContract{Name: "PortalProxy", Address: address}.validate(), "missing an implementation")
+	require.ErrorContains(t, ProxyContract{Name: "PortalProxy", Implementation: "OptimismPortal"}.validate(), "missing an address")
+}
+
+func TestProxyContractMetadataTemplate(t *testing.T) {
+	tmpl := template.Must(template.New("proxyContractMetadata").Parse(proxyContractMetadataTemplate))
+	address := common.HexToAddress("0xbEb5Fc579115071764c7423A4f12eDde41f106Ed")
+
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, proxyContractMetadata{
+		Name:           "PortalProxy",
+		Implementation: "OptimismPortal",
+		Address:        address,
+		StorageLayout:  `{\"storage\":[],\"types\":{}}`,
+		Package:        "bindings",
+	}))
+
+	_, err := format.Source(buf.Bytes())
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), `var PortalProxyAddress = common.HexToAddress("`+address.Hex()+`")`)
+	require.Contains(t, buf.String(), `layouts["PortalProxy"] = PortalProxyStorageLayout`)
+}
@@ -2,8 +2,14 @@ package bindgen
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
 	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/etherscan"
 	"github.com/ethereum/go-ethereum/common"
@@ -12,32 +18,50 @@ import (
 
 type BindGenGeneratorRemote struct {
 	BindGenGeneratorBase
-	ContractDataClients struct {
-		Eth contractDataClient
-		Op  contractDataClient
-	}
-	RpcClients struct {
-		Eth *ethclient.Client
-		Op  *ethclient.Client
-	}
+	// ContractDataClients and RpcClients are keyed by chain name,
+	// which is how chains are referenced in the contracts list, e.g. "eth" and "op".
+	ContractDataClients map[string]ContractDataClient
+	RpcClients          map[string]*ethclient.Client
+	// Concurrency is the maximum number of contracts to fetch data for at once.
+	Concurrency int
+	// VerifyBytecode enables verifying the fetched deployed bytecode against the code on every chain
+	// the contract is deployed on, modulo immutables.
+	VerifyBytecode bool
+	// VerifyBytecodeStripMetadata strips the CBOR metadata solc appends to bytecode before verifying it,
+	// so bytecode compiled with different metadata is considered the same.
+	VerifyBytecodeStripMetadata bool
+	// EtherscanStats is optional, it collects the requests of the etherscan clients, to log a summary of them after the run.
+	EtherscanStats   *etherscan.RequestStats
 	tempArtifactsDir string
 }
 
-type contractDataClient interface {
+// ContractDataClient sources verified contract data, such as from Etherscan or Sourcify.
+type ContractDataClient interface {
 	FetchAbi(ctx context.Context, address string) (string, error)
 	FetchDeployedBytecode(ctx context.Context, address string) (string, error)
 	FetchDeploymentTxHash(ctx context.Context, address string) (string, error)
 	FetchDeploymentTx(ctx context.Context, txHash string) (etherscan.Transaction, error)
 }
 
-type Deployments struct {
-	Eth common.Address `json:"eth"`
-	Op  common.Address `json:"op"`
-}
+// Deployments maps a chain name to the address a contract is deployed at on that chain.
+type Deployments map[string]common.Address
 
 type RemoteContract struct {
-	Name           string         `json:"name"`
-	Verified       bool           `json:"verified"`
+	Name     string `json:"name"`
+	Verified bool   `json:"verified"`
+	// Chain is the name of the chain to source contract data from, for contracts
+	// without a dedicated handler.
+	Chain string `json:"chain"`
+	// ResolveProxy marks the contract as an EIP-1967 proxy or an EIP-1167 minimal proxy, whose implementation is
+	// sourced instead, so its binding exposes the implementation's ABI.
+	ResolveProxy bool `json:"resolveProxy"`
+	// AtBlock optionally pins the contract to its state at this block number of Chain, for bindings of a
+	// historical version of an upgraded contract: its proxy is resolved, and its deployed bytecode read, at
+	// that block, which requires an archive node for old blocks. The latest block is used if unset.
+	AtBlock *uint64 `json:"atBlock"`
+	// AbiOnly generates just the ABI-backed binding, without fetching the bytecode or deployment
+	// transaction, and without a metadata file, for contracts which are only ever called.
+	AbiOnly        bool           `json:"abiOnly"`
 	Deployments    Deployments    `json:"deployments"`
 	DeploymentSalt string         `json:"deploymentSalt"`
 	Deployer       common.Address `json:"deployer"`
@@ -50,9 +74,25 @@ type RemoteContractMetadata struct {
 	Package     string
 	InitBin     string
 	DeployedBin string
+
+	// implementation is the resolved implementation address of a ResolveProxy contract
+	implementation common.Address
+}
+
+// blockNumber returns the block number to read the on-chain state of the contract at, nil for the latest block.
+func (c *RemoteContract) blockNumber() *big.Int {
+	if c.AtBlock == nil {
+		return nil
+	}
+	return new(big.Int).SetUint64(*c.AtBlock)
 }
 
-func (generator *BindGenGeneratorRemote) GenerateBindings() error {
+// GenerateBindings fetches the data of the remote contracts and generates their bindings.
+// Cancelling ctx aborts the in-flight requests to the contract data sources and RPCs.
+func (generator *BindGenGeneratorRemote) GenerateBindings(ctx context.Context) error {
+	if generator.MetadataOnly {
+		return errors.New("generating only the metadata of contracts is not supported for remote contracts")
+	}
 	contracts, err := readContractList(generator.Logger, generator.ContractsListPath)
 	if err != nil {
 		return fmt.Errorf("error reading contract list %s: %w", generator.ContractsListPath, err)
@@ -60,11 +100,14 @@ func (generator *BindGenGeneratorRemote) GenerateBindings() error {
 	if len(contracts.Remote) == 0 {
 		return fmt.Errorf("no contracts parsed from given contract list: %s", generator.ContractsListPath)
 	}
+	if contracts, err = generator.filterContracts(contracts); err != nil {
+		return err
+	}
 
-	return generator.processContracts(contracts.Remote)
+	return generator.processContracts(ctx, contracts.Remote)
 }
 
-func (generator *BindGenGeneratorRemote) processContracts(contracts []RemoteContract) error {
+func (generator *BindGenGeneratorRemote) processContracts(ctx context.Context, contracts []RemoteContract) error {
 	var err error
 	generator.tempArtifactsDir, err = mkTempArtifactsDir(generator.Logger)
 	if err != nil {
@@ -78,52 +121,199 @@ func (generator *BindGenGeneratorRemote) processContracts(contracts []RemoteCont
 			generator.Logger.Debug("Successfully removed temporary artifact directory")
 		}
 	}()
+	defer generator.logEtherscanStats()
 
-	for _, contract := range contracts {
-		generator.Logger.Info("Generating bindings and metadata for remote contract", "contract", contract.Name)
-
-		contractMetadata := RemoteContractMetadata{
-			RemoteContract: RemoteContract{
-				Name:           contract.Name,
-				Deployments:    contract.Deployments,
-				DeploymentSalt: contract.DeploymentSalt,
-				ABI:            contract.ABI,
-				Verified:       contract.Verified,
-			},
-			Package: generator.BindingsPackageName,
+	fetched, fetchErr := generator.fetchContracts(ctx, contracts)
+	if fetchErr != nil && !generator.ContinueOnError {
+		return fetchErr
+	}
+	failures := []error{fetchErr}
+
+	// Outputs are written in order of contract name, regardless of the order contracts were fetched in
+	sort.Slice(fetched, func(i, j int) bool {
+		return fetched[i].metadata.Name < fetched[j].metadata.Name
+	})
+	for _, contract := range fetched {
+		start := time.Now()
+		before, err := generator.hashOutputs(contract.metadata.Name, !contract.metadata.AbiOnly)
+		if err == nil {
+			err = generator.writeAllOutputs(&contract.metadata, contract.template)
+		}
+		if err == nil {
+			entry := ReportContract{Name: contract.metadata.Name, Source: ManifestSourceRemote, Chain: contract.metadata.Chain}
+			err = generator.reportContract(entry, !contract.metadata.AbiOnly, before, contract.fetchDuration+time.Since(start))
 		}
+		if err != nil {
+			err = newRemoteContractError(contract.metadata.RemoteContract, err)
+			if !generator.ContinueOnError {
+				return err
+			}
+			generator.Logger.Error("Failed to write outputs, continuing with the remaining contracts", "contract", contract.metadata.Name, "err", err)
+			failures = append(failures, err)
+		}
+	}
 
-		var err error
-		switch contract.Name {
-		case "MultiCall3", "Safe_v130", "SafeL2_v130", "MultiSendCallOnly_v130",
-			"EntryPoint", "SafeSingletonFactory", "DeterministicDeploymentProxy":
-			err = generator.standardHandler(&contractMetadata)
-		case "Create2Deployer":
-			err = generator.create2DeployerHandler(&contractMetadata)
-		case "MultiSend_v130":
-			err = generator.multiSendHandler(&contractMetadata)
-		case "SenderCreator":
-			// The SenderCreator contract is deployed by EntryPoint, so the transaction data
-			// from the deployment transaction is for the entire EntryPoint deployment.
-			// So, we're manually providing the initialization bytecode
-			contractMetadata.InitBin = contract.InitBytecode
-			err = generator.senderCreatorHandler(&contractMetadata)
-		case "Permit2":
-			// Permit2 has an immutable Solidity variable that resolves to block.chainid,
-			// so we can't use the deployed bytecode, and instead must generate it
-			// at some later point not handled by BindGen.
-			// DeployerAddress is intended to be used to help deploy Permit2 at it's deterministic address
-			// to a chain set with the required id to be able to obtain a diff minimized deployed bytecode
-			contractMetadata.Deployer = contract.Deployer
-			err = generator.permit2Handler(&contractMetadata)
-		default:
-			err = fmt.Errorf("unknown contract: %s, don't know how to handle it", contract.Name)
+	return errors.Join(failures...)
+}
+
+// logEtherscanStats logs the requests sent to Etherscan per endpoint, if they are collected,
+// to see how much of the run was spent waiting for Etherscan.
+func (generator *BindGenGeneratorRemote) logEtherscanStats() {
+	if generator.EtherscanStats == nil {
+		return
+	}
+	for _, stats := range generator.EtherscanStats.Endpoints() {
+		latency := make([]string, len(stats.Latency))
+		for i, count := range stats.Latency {
+			if i < len(etherscan.LatencyBuckets) {
+				latency[i] = fmt.Sprintf("<=%s:%d", etherscan.LatencyBuckets[i], count)
+			} else {
+				latency[i] = fmt.Sprintf(">%s:%d", etherscan.LatencyBuckets[i-1], count)
+			}
 		}
+		generator.Logger.Info("Etherscan requests", "endpoint", stats.Endpoint, "requests", stats.Requests,
+			"retries", stats.Retries, "errors", stats.Errors, "duration", stats.Duration, "latency", strings.Join(latency, " "))
+	}
+}
 
-		if err != nil {
-			return err
+// fetchedRemoteContract is a remote contract with all of its data fetched and verified,
+// along with the template to write its metadata with.
+type fetchedRemoteContract struct {
+	metadata RemoteContractMetadata
+	template string
+	// fetchDuration is the time it took to fetch and verify the contract data
+	fetchDuration time.Duration
+}
+
+// fetchContracts fetches the data of the given contracts using up to Concurrency workers.
+// The first error cancels the remaining work, and all errors encountered up to then are returned together.
+// With ContinueOnError, all contracts are fetched regardless, and the successfully fetched contracts are
+// returned along with the errors of the failed ones.
+// Cancelling ctx aborts all work, and returns its error regardless of ContinueOnError.
+func (generator *BindGenGeneratorRemote) fetchContracts(ctx context.Context, contracts []RemoteContract) ([]fetchedRemoteContract, error) {
+	fetchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]fetchedRemoteContract, len(contracts))
+	errs := make([]error, len(contracts))
+	work := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < max(generator.Concurrency, 1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range work {
+				start := time.Now()
+				results[idx], errs[idx] = generator.fetchContract(fetchCtx, contracts[idx])
+				results[idx].fetchDuration = time.Since(start)
+				if errs[idx] != nil && !generator.ContinueOnError {
+					cancel()
+				}
+			}
+		}()
+	}
+
+feed:
+	for idx := range contracts {
+		select {
+		case work <- idx:
+		case <-fetchCtx.Done():
+			break feed
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("fetching remote contracts was interrupted: %w", err)
+	}
+
+	var failures []error
+	fetched := make([]fetchedRemoteContract, 0, len(results))
+	for idx, err := range errs {
+		if err == nil {
+			fetched = append(fetched, results[idx])
+			continue
 		}
+		// Contracts interrupted by the cancellation did not fail themselves
+		if errors.Is(err, context.Canceled) {
+			continue
+		}
+		failures = append(failures, newRemoteContractError(contracts[idx], err))
+	}
+	if len(failures) != 0 && !generator.ContinueOnError {
+		return nil, errors.Join(failures...)
+	}
+	return fetched, errors.Join(failures...)
+}
+
+func (generator *BindGenGeneratorRemote) fetchContract(ctx context.Context, contract RemoteContract) (fetchedRemoteContract, error) {
+	generator.Logger.Info("Generating bindings and metadata for remote contract", "contract", contract.Name)
+
+	contractMetadata := RemoteContractMetadata{
+		RemoteContract: RemoteContract{
+			Name:           contract.Name,
+			Chain:          contract.Chain,
+			ResolveProxy:   contract.ResolveProxy,
+			AtBlock:        contract.AtBlock,
+			AbiOnly:        contract.AbiOnly,
+			Deployments:    contract.Deployments,
+			DeploymentSalt: contract.DeploymentSalt,
+			ABI:            contract.ABI,
+			Verified:       contract.Verified,
+		},
+		Package: generator.BindingsPackageName,
+	}
+
+	if contract.ResolveProxy && contract.Chain == "" {
+		return fetchedRemoteContract{}, fmt.Errorf("resolving the proxy %s requires a chain to source its implementation from", contract.Name)
+	}
+	if contract.AtBlock != nil && contract.Chain == "" {
+		return fetchedRemoteContract{}, fmt.Errorf("pinning %s to block %d requires a chain to read the block of", contract.Name, *contract.AtBlock)
+	}
+
+	var fileTemplate string
+	var err error
+	if contract.AbiOnly {
+		// ABI-only contracts have no bytecode, so there is nothing to verify
+		fileTemplate, err = generator.abiOnlyHandler(ctx, &contractMetadata)
+		return fetchedRemoteContract{metadata: contractMetadata, template: fileTemplate}, err
+	}
+
+	switch contract.Name {
+	case "MultiCall3", "Safe_v130", "SafeL2_v130", "MultiSendCallOnly_v130",
+		"EntryPoint", "SafeSingletonFactory", "DeterministicDeploymentProxy":
+		fileTemplate, err = generator.standardHandler(ctx, &contractMetadata)
+	case "Create2Deployer":
+		fileTemplate, err = generator.create2DeployerHandler(ctx, &contractMetadata)
+	case "MultiSend_v130":
+		fileTemplate, err = generator.multiSendHandler(ctx, &contractMetadata)
+	case "SenderCreator":
+		// The SenderCreator contract is deployed by EntryPoint, so the transaction data
+		// from the deployment transaction is for the entire EntryPoint deployment.
+		// So, we're manually providing the initialization bytecode
+		contractMetadata.InitBin = contract.InitBytecode
+		fileTemplate, err = generator.senderCreatorHandler(ctx, &contractMetadata)
+	case "Permit2":
+		// Permit2 has an immutable Solidity variable that resolves to block.chainid,
+		// so we can't use the deployed bytecode, and instead must generate it
+		// at some later point not handled by BindGen.
+		// DeployerAddress is intended to be used to help deploy Permit2 at it's deterministic address
+		// to a chain set with the required id to be able to obtain a diff minimized deployed bytecode
+		contractMetadata.Deployer = contract.Deployer
+		fileTemplate, err = generator.permit2Handler(ctx, &contractMetadata)
+	default:
+		if contract.Chain == "" {
+			err = fmt.Errorf("unknown contract: %s, don't know how to handle it without a chain to source it from", contract.Name)
+		} else {
+			fileTemplate, err = generator.chainHandler(ctx, &contractMetadata)
+		}
+	}
+
+	if err == nil && generator.VerifyBytecode {
+		err = generator.verifyDeployedBytecode(ctx, &contractMetadata)
 	}
 
-	return nil
+	return fetchedRemoteContract{metadata: contractMetadata, template: fileTemplate}, err
 }
@@ -0,0 +1,376 @@
+package bindgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/etherscan"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+const testDeployedBytecode = "0x6001"
+
+// slowContractDataClient serves the same contract data for every address, taking a while to serve
+// the deployed bytecode, and fails for the addresses in failing.
+// If started is set, deployed bytecode is only served once all expected requests have started.
+type slowContractDataClient struct {
+	failing map[common.Address]bool
+	started *sync.WaitGroup
+
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (c *slowContractDataClient) FetchAbi(ctx context.Context, address string) (string, error) {
+	return "[]", nil
+}
+
+func (c *slowContractDataClient) FetchDeployedBytecode(ctx context.Context, address string) (string, error) {
+	if c.started != nil {
+		c.started.Done()
+		c.started.Wait()
+	}
+	if c.failing[common.HexToAddress(address)] {
+		return "", fmt.Errorf("no bytecode for %s", address)
+	}
+	n := c.inFlight.Add(1)
+	defer c.inFlight.Add(-1)
+	for {
+		prev := c.maxInFlight.Load()
+		if n <= prev || c.maxInFlight.CompareAndSwap(prev, n) {
+			break
+		}
+	}
+	select {
+	case <-time.After(20 * time.Millisecond):
+		return testDeployedBytecode, nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func (c *slowContractDataClient) FetchDeploymentTxHash(ctx context.Context, address string) (string, error) {
+	return "0xabcd", nil
+}
+
+func (c *slowContractDataClient) FetchDeploymentTx(ctx context.Context, txHash string) (etherscan.Transaction, error) {
+	return etherscan.Transaction{Hash: txHash, Input: "0x6002"}, nil
+}
+
+// codeService serves the given code, or testDeployedBytecode for other addresses,
+// and the implementations of EIP-1967 proxies. If pinned is set, its code and implementations
+// are served at historicalBlock instead.
+type codeService struct {
+	code            map[common.Address]hexutil.Bytes
+	implementations map[common.Address]common.Address
+
+	historicalBlock string
+	pinned          *codeService
+}
+
+func (s *codeService) GetCode(ctx context.Context, address common.Address, block string) (hexutil.Bytes, error) {
+	if s.pinned != nil && block == s.historicalBlock {
+		return s.pinned.GetCode(ctx, address, "latest")
+	}
+	if code, ok := s.code[address]; ok {
+		return code, nil
+	}
+	return hexutil.MustDecode(testDeployedBytecode), nil
+}
+
+func (s *codeService) GetStorageAt(ctx context.Context, address common.Address, slot common.Hash, block string) (hexutil.Bytes, error) {
+	if s.pinned != nil && block == s.historicalBlock {
+		return s.pinned.GetStorageAt(ctx, address, slot, "latest")
+	}
+	if slot != eip1967ImplementationSlot {
+		return make(hexutil.Bytes, 32), nil
+	}
+	return common.BytesToHash(s.implementations[address].Bytes()).Bytes(), nil
+}
+
+func newTestRemoteGenerator(t *testing.T, client ContractDataClient, concurrency int) *BindGenGeneratorRemote {
+	return newTestRemoteGeneratorWithService(t, client, concurrency, new(codeService))
+}
+
+func newTestRemoteGeneratorWithService(t *testing.T, client ContractDataClient, concurrency int, service *codeService) *BindGenGeneratorRemote {
+	server := rpc.NewServer()
+	t.Cleanup(server.Stop)
+	require.NoError(t, server.RegisterName("eth", service))
+	rpcClient := ethclient.NewClient(rpc.DialInProc(server))
+	t.Cleanup(rpcClient.Close)
+
+	return &BindGenGeneratorRemote{
+		BindGenGeneratorBase: BindGenGeneratorBase{
+			BindingsPackageName: "bindings",
+			Logger:              testlog.Logger(t, log.LevelDebug),
+		},
+		ContractDataClients: map[string]ContractDataClient{"eth": client},
+		RpcClients:          map[string]*ethclient.Client{"eth": rpcClient},
+		Concurrency:         concurrency,
+	}
+}
+
+func testRemoteContracts(n int) []RemoteContract {
+	contracts := make([]RemoteContract, n)
+	for i := range contracts {
+		contracts[i] = RemoteContract{
+			Name:        fmt.Sprintf("Contract%d", n-i),
+			Chain:       "eth",
+			Deployments: Deployments{"eth": common.BigToAddress(big.NewInt(int64(i + 1)))},
+		}
+	}
+	return contracts
+}
+
+func TestFetchContractsConcurrently(t *testing.T) {
+	client := new(slowContractDataClient)
+	gen := newTestRemoteGenerator(t, client, 3)
+	contracts := testRemoteContracts(9)
+
+	fetched, err := gen.fetchContracts(context.Background(), contracts)
+	require.NoError(t, err)
+	require.Len(t, fetched, len(contracts))
+	for i, contract := range fetched {
+		require.Equal(t, contracts[i].Name, contract.metadata.Name)
+		require.Equal(t, testDeployedBytecode, contract.metadata.DeployedBin)
+		require.Equal(t, "0x6002", contract.metadata.InitBin)
+		require.Equal(t, remoteContractMetadataTemplate, contract.template)
+	}
+	require.Equal(t, int32(3), client.maxInFlight.Load())
+}
+
+func TestFetchContractsErrors(t *testing.T) {
+	contracts := testRemoteContracts(8)
+	var started sync.WaitGroup
+	started.Add(len(contracts))
+	client := &slowContractDataClient{
+		failing: map[common.Address]bool{
+			contracts[2].Deployments["eth"]: true,
+			contracts[5].Deployments["eth"]: true,
+		},
+		started: &started,
+	}
+	gen := newTestRemoteGenerator(t, client, len(contracts))
+
+	_, err := gen.fetchContracts(context.Background(), contracts)
+	require.ErrorContains(t, err, contracts[2].Name+" (chain eth): error fetching deployed bytecode")
+	require.ErrorContains(t, err, contracts[5].Name+" (chain eth): error fetching deployed bytecode")
+	// the other contracts were cancelled rather than failing
+	require.Len(t, err.(interface{ Unwrap() []error }).Unwrap(), 2)
+	require.False(t, errors.Is(err, context.Canceled))
+}
+
+func TestFetchContractsContinueOnError(t *testing.T) {
+	contracts := testRemoteContracts(6)
+	client := &slowContractDataClient{failing: map[common.Address]bool{
+		contracts[1].Deployments["eth"]: true,
+		contracts[4].Deployments["eth"]: true,
+	}}
+	gen := newTestRemoteGenerator(t, client, 2)
+	gen.ContinueOnError = true
+
+	fetched, err := gen.fetchContracts(context.Background(), contracts)
+	require.Len(t, fetched, 4)
+	for _, contract := range fetched {
+		require.NotEqual(t, contracts[1].Name, contract.metadata.Name)
+		require.NotEqual(t, contracts[4].Name, contract.metadata.Name)
+	}
+
+	contractErrs := ContractErrors(fmt.Errorf("error generating remote bindings: %w", err))
+	require.Len(t, contractErrs, 2)
+	require.Equal(t, contracts[1].Name, contractErrs[0].Contract)
+	require.Equal(t, []string{"eth"}, contractErrs[0].Chains)
+	require.Equal(t, contracts[4].Name, contractErrs[1].Contract)
+	require.ErrorContains(t, contractErrs[1], contracts[4].Name+" (chain eth): error fetching deployed bytecode")
+}
+
+func TestFetchContractsCancelled(t *testing.T) {
+	contracts := testRemoteContracts(4)
+	var started sync.WaitGroup
+	started.Add(len(contracts))
+	client := &slowContractDataClient{started: &started}
+	gen := newTestRemoteGenerator(t, client, len(contracts))
+	// an interrupt aborts the fetches even if the failures of contracts are collected
+	gen.ContinueOnError = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		started.Wait()
+		cancel()
+	}()
+	fetched, err := gen.fetchContracts(ctx, contracts)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Empty(t, fetched)
+
+	gen = newTestRemoteGenerator(t, new(slowContractDataClient), 1)
+	ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	_, err = gen.fetchContracts(ctx, testRemoteContracts(1))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// abiByAddressClient serves a distinct ABI per address, and otherwise the contract data of slowContractDataClient.
+type abiByAddressClient struct {
+	slowContractDataClient
+	abis map[common.Address]string
+}
+
+func (c *abiByAddressClient) FetchAbi(ctx context.Context, address string) (string, error) {
+	return c.abis[common.HexToAddress(address)], nil
+}
+
+func TestFetchContractsResolveProxy(t *testing.T) {
+	proxy := common.HexToAddress("0x4200000000000000000000000000000000000010")
+	implementation := common.HexToAddress("0xC0d3c0d3c0D3c0d3C0D3c0D3C0d3C0D3C0D30010")
+	implementationAbi := `[{"type":"function","name":"bridge","inputs":[],"outputs":[],"stateMutability":"nonpayable"}]`
+	client := &abiByAddressClient{abis: map[common.Address]string{
+		proxy:          `[{"type":"fallback","stateMutability":"payable"}]`,
+		implementation: implementationAbi,
+	}}
+	gen := newTestRemoteGeneratorWithService(t, client, 1, &codeService{implementations: map[common.Address]common.Address{proxy: implementation}})
+
+	contract := RemoteContract{
+		Name:         "Bridge",
+		Verified:     true,
+		Chain:        "eth",
+		Deployments:  Deployments{"eth": proxy},
+		ResolveProxy: true,
+	}
+	fetched, err := gen.fetchContracts(context.Background(), []RemoteContract{contract})
+	require.NoError(t, err)
+	require.Equal(t, implementationAbi, fetched[0].metadata.ABI)
+	require.Equal(t, proxy, fetched[0].metadata.Deployments["eth"])
+
+	// contracts that aren't proxies fail to resolve
+	contract.Deployments = Deployments{"eth": implementation}
+	_, err = gen.fetchContracts(context.Background(), []RemoteContract{contract})
+	require.ErrorContains(t, err, "is neither an EIP-1167 minimal proxy nor an EIP-1967 proxy")
+}
+
+func TestFetchContractsResolveMinimalProxy(t *testing.T) {
+	clone := common.HexToAddress("0x1167")
+	implementation := common.HexToAddress("0xC0d3c0d3c0D3c0d3C0D3c0D3C0d3C0D3C0D30010")
+	implementationAbi := `[{"type":"function","name":"bridge","inputs":[],"outputs":[],"stateMutability":"nonpayable"}]`
+	client := &abiByAddressClient{abis: map[common.Address]string{implementation: implementationAbi}}
+	code := append(append(append([]byte{}, eip1167Prefix...), implementation.Bytes()...), eip1167Suffix...)
+	gen := newTestRemoteGeneratorWithService(t, client, 1, &codeService{code: map[common.Address]hexutil.Bytes{clone: code}})
+
+	fetched, err := gen.fetchContracts(context.Background(), []RemoteContract{{
+		Name:         "Bridge",
+		Verified:     true,
+		Chain:        "eth",
+		Deployments:  Deployments{"eth": clone},
+		ResolveProxy: true,
+	}})
+	require.NoError(t, err)
+	require.Equal(t, implementationAbi, fetched[0].metadata.ABI)
+	require.Equal(t, implementation, fetched[0].metadata.implementation)
+	require.Equal(t, clone, fetched[0].metadata.Deployments["eth"])
+}
+
+func TestFetchContractsAtBlock(t *testing.T) {
+	proxy := common.HexToAddress("0x4200000000000000000000000000000000000010")
+	implementation := common.HexToAddress("0xC0d3c0d3c0D3c0d3C0D3c0D3C0d3C0D3C0D30010")
+	upgraded := common.HexToAddress("0xC0d3c0d3c0D3c0d3C0D3c0D3C0d3C0D3C0D30011")
+	implementationAbi := `[{"type":"function","name":"bridge","inputs":[],"outputs":[],"stateMutability":"nonpayable"}]`
+	client := &abiByAddressClient{abis: map[common.Address]string{
+		implementation: implementationAbi,
+		upgraded:       `[{"type":"function","name":"bridgeV2","inputs":[],"outputs":[],"stateMutability":"nonpayable"}]`,
+	}}
+	service := &codeService{
+		implementations: map[common.Address]common.Address{proxy: upgraded},
+		historicalBlock: "0x64",
+		pinned: &codeService{
+			code:            map[common.Address]hexutil.Bytes{implementation: hexutil.MustDecode("0x6003")},
+			implementations: map[common.Address]common.Address{proxy: implementation},
+		},
+	}
+	gen := newTestRemoteGeneratorWithService(t, client, 1, service)
+	gen.VerifyBytecode = true
+
+	atBlock := uint64(100)
+	contract := RemoteContract{
+		Name:         "Bridge",
+		Verified:     true,
+		Chain:        "eth",
+		Deployments:  Deployments{"eth": proxy},
+		ResolveProxy: true,
+		AtBlock:      &atBlock,
+	}
+	fetched, err := gen.fetchContracts(context.Background(), []RemoteContract{contract})
+	require.NoError(t, err)
+	require.Equal(t, implementation, fetched[0].metadata.implementation)
+	require.Equal(t, implementationAbi, fetched[0].metadata.ABI)
+	// the code at the pinned block is used, rather than the latest code served by the contract data client
+	require.Equal(t, "0x6003", fetched[0].metadata.DeployedBin)
+
+	// the contract must not have been deployed after the pinned block
+	contract.ResolveProxy = false
+	contract.Deployments = Deployments{"eth": upgraded}
+	service.pinned.code[upgraded] = hexutil.Bytes{}
+	_, err = gen.fetchContracts(context.Background(), []RemoteContract{contract})
+	require.ErrorContains(t, err, "has no code at block 100 on chain eth")
+
+	contract.Chain = ""
+	contract.Name = "Unknown"
+	_, err = gen.fetchContracts(context.Background(), []RemoteContract{contract})
+	require.ErrorContains(t, err, "pinning Unknown to block 100 requires a chain")
+}
+
+func TestEip1167Implementation(t *testing.T) {
+	// a minimal proxy of 0xd9db270c1b5e3bd161e8c8503c55ceabee709552
+	code := common.FromHex("0x363d3d373d3d3d363d73d9db270c1b5e3bd161e8c8503c55ceabee7095525af43d82803e903d91602b57fd5bf3")
+	implementation, ok := eip1167Implementation(code)
+	require.True(t, ok)
+	require.Equal(t, common.HexToAddress("0xd9db270c1b5e3bd161e8c8503c55ceabee709552"), implementation)
+
+	_, ok = eip1167Implementation(code[:len(code)-1])
+	require.False(t, ok)
+	_, ok = eip1167Implementation(append(code, 0x00))
+	require.False(t, ok)
+	_, ok = eip1167Implementation(common.FromHex(testDeployedBytecode))
+	require.False(t, ok)
+}
+
+func TestFetchContractsAbiOnly(t *testing.T) {
+	contracts := testRemoteContracts(2)
+	// ABI-only contracts do not need their bytecode, even if it is not available
+	client := &slowContractDataClient{failing: map[common.Address]bool{
+		contracts[0].Deployments["eth"]: true,
+		contracts[1].Deployments["eth"]: true,
+	}}
+	gen := newTestRemoteGenerator(t, client, 1)
+	gen.VerifyBytecode = true
+	for i := range contracts {
+		contracts[i].AbiOnly = true
+	}
+	given := RemoteContract{Name: "Given", AbiOnly: true, ABI: `[{"type":"fallback","stateMutability":"payable"}]`}
+
+	fetched, err := gen.fetchContracts(context.Background(), append(contracts, given))
+	require.NoError(t, err)
+	require.Len(t, fetched, 3)
+	require.Equal(t, []string{"Contract2", "Contract1", "Given"}, []string{fetched[0].metadata.Name, fetched[1].metadata.Name, fetched[2].metadata.Name})
+	for _, contract := range fetched {
+		require.True(t, contract.metadata.AbiOnly)
+		require.Empty(t, contract.metadata.DeployedBin)
+		require.Empty(t, contract.metadata.InitBin)
+		require.Empty(t, contract.template)
+	}
+	require.Equal(t, "[]", fetched[0].metadata.ABI)
+	require.Equal(t, given.ABI, fetched[2].metadata.ABI)
+
+	_, err = gen.fetchContracts(context.Background(), []RemoteContract{{Name: "Unknown", AbiOnly: true}})
+	require.ErrorContains(t, err, "requires an abi or a chain to source it from")
+}
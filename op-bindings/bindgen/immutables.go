@@ -0,0 +1,162 @@
+package bindgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+)
+
+// immutableDeclaration is the declaration of an immutable variable, as found in a contract's AST.
+type immutableDeclaration struct {
+	Name       string
+	TypeString string
+}
+
+// immutableGetter describes a typed getter, generated to decode an immutable value from deployed bytecode.
+type immutableGetter struct {
+	// Name is the name of the immutable variable in Solidity.
+	Name string
+	// GoName is the name of the generated getter.
+	GoName string
+	// GoType is the Go type the immutable value is decoded as.
+	GoType string
+	// Decoder is the bindings package function that decodes the immutable value.
+	Decoder string
+	// Offsets are the positions of the immutable value within the deployed bytecode.
+	Offsets string
+}
+
+// collectImmutableDeclarations walks a solc AST, and records all immutable variable declarations by AST ID.
+func collectImmutableDeclarations(node any, decls map[string]immutableDeclaration) {
+	switch n := node.(type) {
+	case map[string]any:
+		if n["nodeType"] == "VariableDeclaration" && n["mutability"] == "immutable" {
+			id, idOk := n["id"].(float64)
+			name, nameOk := n["name"].(string)
+			typeDescriptions, _ := n["typeDescriptions"].(map[string]any)
+			typeString, typeOk := typeDescriptions["typeString"].(string)
+			if idOk && nameOk && typeOk {
+				decls[strconv.FormatUint(uint64(id), 10)] = immutableDeclaration{Name: name, TypeString: typeString}
+			}
+		}
+		for _, child := range n {
+			collectImmutableDeclarations(child, decls)
+		}
+	case []any:
+		for _, child := range n {
+			collectImmutableDeclarations(child, decls)
+		}
+	}
+}
+
+// readImmutableDeclarations indexes the immutable declarations of all the given forge artifacts.
+// Immutables are commonly declared in a parent contract, in another source file,
+// so the AST of the contract the references belong to is not sufficient on its own.
+func readImmutableDeclarations(artifactPaths map[string]string) (map[string]immutableDeclaration, error) {
+	decls := make(map[string]immutableDeclaration)
+	for _, artifactPath := range artifactPaths {
+		raw, err := os.ReadFile(artifactPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading forge artifact %s: %w", artifactPath, err)
+		}
+		var artifact struct {
+			Ast json.RawMessage `json:"ast"`
+		}
+		if err := json.Unmarshal(raw, &artifact); err != nil {
+			return nil, fmt.Errorf("error parsing forge artifact %s: %w", artifactPath, err)
+		}
+		if len(artifact.Ast) == 0 {
+			continue
+		}
+		var ast any
+		if err := json.Unmarshal(artifact.Ast, &ast); err != nil {
+			return nil, fmt.Errorf("error parsing AST of forge artifact %s: %w", artifactPath, err)
+		}
+		collectImmutableDeclarations(ast, decls)
+	}
+	return decls, nil
+}
+
+// immutableGetters returns the typed getters to generate for the given immutable references,
+// sorted by name. Immutables of types that cannot be decoded are skipped.
+func immutableGetters(immutableRefs json.RawMessage, decls map[string]immutableDeclaration) ([]immutableGetter, []string, error) {
+	if len(immutableRefs) == 0 {
+		return nil, nil, nil
+	}
+	var refs map[string][]solc.LinkReferenceOffset
+	if err := json.Unmarshal(immutableRefs, &refs); err != nil {
+		return nil, nil, fmt.Errorf("error parsing immutable references: %w", err)
+	}
+
+	var getters []immutableGetter
+	var skipped []string
+	for id, offsets := range refs {
+		decl, ok := decls[id]
+		if !ok {
+			return nil, nil, fmt.Errorf("no immutable declaration found for AST ID %s", id)
+		}
+		goType, decoder, ok := immutableGoType(decl.TypeString)
+		if !ok {
+			skipped = append(skipped, decl.Name)
+			continue
+		}
+		starts := make([]string, 0, len(offsets))
+		for _, offset := range offsets {
+			if offset.Length != 32 {
+				return nil, nil, fmt.Errorf("immutable %s has unexpected reference length %d", decl.Name, offset.Length)
+			}
+			starts = append(starts, strconv.FormatUint(uint64(offset.Start), 10))
+		}
+		getters = append(getters, immutableGetter{
+			Name:    decl.Name,
+			GoName:  immutableGoName(decl.Name),
+			GoType:  goType,
+			Decoder: decoder,
+			Offsets: strings.Join(starts, ", "),
+		})
+	}
+	sort.Slice(getters, func(i, j int) bool { return getters[i].Name < getters[j].Name })
+	sort.Strings(skipped)
+	return getters, skipped, nil
+}
+
+// immutableGoType maps a solc type string to the Go type it is decoded as,
+// and the name of the decoder in the bindings package.
+func immutableGoType(typeString string) (string, string, bool) {
+	switch {
+	case typeString == "address", typeString == "address payable", strings.HasPrefix(typeString, "contract "):
+		return "common.Address", "immutableAddress", true
+	case strings.HasPrefix(typeString, "uint"), strings.HasPrefix(typeString, "enum "):
+		return "*big.Int", "immutableUint", true
+	case typeString == "bool":
+		return "bool", "immutableBool", true
+	case typeString == "bytes32":
+		return "[32]byte", "immutableBytes32", true
+	default:
+		return "", "", false
+	}
+}
+
+// immutableGoName converts the Solidity name of an immutable, commonly SCREAMING_SNAKE_CASE,
+// to an exported Go name, e.g. MIN_WITHDRAWAL_AMOUNT becomes MinWithdrawalAmount.
+func immutableGoName(name string) string {
+	var sb strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		if strings.ToUpper(part) == part {
+			part = strings.ToLower(part)
+		}
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		sb.WriteString(string(runes))
+	}
+	return sb.String()
+}
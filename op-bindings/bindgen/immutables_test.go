@@ -0,0 +1,90 @@
+package bindgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/format"
+	"os"
+	"path/filepath"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/require"
+)
+
+// feeVaultAst is a condensed AST of FeeVault, which declares the immutables of SequencerFeeVault.
+const feeVaultAst = `{
+	"nodeType": "SourceUnit",
+	"nodes": [{
+		"nodeType": "ContractDefinition",
+		"name": "FeeVault",
+		"nodes": [
+			{"id": 101, "nodeType": "VariableDeclaration", "name": "MIN_WITHDRAWAL_AMOUNT", "mutability": "immutable", "typeDescriptions": {"typeString": "uint256"}},
+			{"id": 102, "nodeType": "VariableDeclaration", "name": "RECIPIENT", "mutability": "immutable", "typeDescriptions": {"typeString": "address"}},
+			{"id": 103, "nodeType": "VariableDeclaration", "name": "WITHDRAWAL_NETWORK", "mutability": "immutable", "typeDescriptions": {"typeString": "enum FeeVault.WithdrawalNetwork"}},
+			{"id": 104, "nodeType": "VariableDeclaration", "name": "totalProcessed", "mutability": "mutable", "typeDescriptions": {"typeString": "uint256"}},
+			{"id": 105, "nodeType": "VariableDeclaration", "name": "label", "mutability": "immutable", "typeDescriptions": {"typeString": "string"}}
+		]
+	}]
+}`
+
+const sequencerFeeVaultImmutableRefs = `{
+	"101": [{"start": 10, "length": 32}, {"start": 100, "length": 32}],
+	"102": [{"start": 50, "length": 32}],
+	"103": [{"start": 250, "length": 32}],
+	"105": [{"start": 280, "length": 32}]
+}`
+
+func TestImmutableGetters(t *testing.T) {
+	dir := t.TempDir()
+	feeVaultPath := filepath.Join(dir, "FeeVault.json")
+	require.NoError(t, os.WriteFile(feeVaultPath, []byte(`{"ast": `+feeVaultAst+`}`), 0o600))
+	sequencerFeeVaultPath := filepath.Join(dir, "SequencerFeeVault.json")
+	require.NoError(t, os.WriteFile(sequencerFeeVaultPath, []byte(`{"abi": []}`), 0o600))
+
+	decls, err := readImmutableDeclarations(map[string]string{"FeeVault": feeVaultPath, "SequencerFeeVault": sequencerFeeVaultPath})
+	require.NoError(t, err)
+	require.Len(t, decls, 4)
+
+	getters, skipped, err := immutableGetters(json.RawMessage(sequencerFeeVaultImmutableRefs), decls)
+	require.NoError(t, err)
+	require.Equal(t, []string{"label"}, skipped)
+	require.Equal(t, []immutableGetter{
+		{Name: "MIN_WITHDRAWAL_AMOUNT", GoName: "MinWithdrawalAmount", GoType: "*big.Int", Decoder: "immutableUint", Offsets: "10, 100"},
+		{Name: "RECIPIENT", GoName: "Recipient", GoType: "common.Address", Decoder: "immutableAddress", Offsets: "50"},
+		{Name: "WITHDRAWAL_NETWORK", GoName: "WithdrawalNetwork", GoType: "*big.Int", Decoder: "immutableUint", Offsets: "250"},
+	}, getters)
+
+	_, _, err = immutableGetters(json.RawMessage(`{"999": [{"start": 0, "length": 32}]}`), decls)
+	require.ErrorContains(t, err, "no immutable declaration found")
+
+	stdImports, imports := metadataImports(getters, nil)
+	immutableRefs, err := json.Marshal(`{"101":[{"start":10,"length":32}]}`)
+	require.NoError(t, err)
+	tmpl := template.Must(template.New("localContractMetadata").Parse(localContractMetadataTemplate))
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, localContractMetadata{
+		Name:                   "SequencerFeeVault",
+		StorageLayout:          `{\"storage\":[],\"types\":{}}`,
+		DeployedBin:            "0x",
+		Package:                "bindings",
+		HasImmutableReferences: true,
+		ImmutableReferences:    string(immutableRefs),
+		ImmutableGetters:       getters,
+		StdImports:             stdImports,
+		Imports:                imports,
+	}))
+	_, err = format.Source(buf.Bytes())
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), `func (i *SequencerFeeVaultImmutables) Recipient() (common.Address, error) {`)
+	require.Contains(t, buf.String(), `return immutableUint(i.deployedBin, "MIN_WITHDRAWAL_AMOUNT", []uint{10, 100})`)
+	require.Contains(t, buf.String(), `const SequencerFeeVaultImmutableReferencesJSON = "{\"101\":[{\"start\":10,\"length\":32}]}"`)
+	require.Contains(t, buf.String(), `registerImmutableReferences("SequencerFeeVault", SequencerFeeVaultDeployedBin, SequencerFeeVaultImmutableReferencesJSON)`)
+}
+
+func TestImmutableGoName(t *testing.T) {
+	require.Equal(t, "MinWithdrawalAmount", immutableGoName("MIN_WITHDRAWAL_AMOUNT"))
+	require.Equal(t, "Recipient", immutableGoName("RECIPIENT"))
+	require.Equal(t, "L2Oracle", immutableGoName("l2Oracle"))
+	require.Equal(t, "Owner", immutableGoName("_owner"))
+}
@@ -0,0 +1,47 @@
+package bindgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+)
+
+// inspectedContract is the output of Inspect with the storage layout.
+type inspectedContract struct {
+	Abi           json.RawMessage     `json:"abi"`
+	StorageLayout *solc.StorageLayout `json:"storageLayout"`
+}
+
+// Inspect writes the ABI of the forge artifact of a contract to w as indented JSON, to look at it without
+// generating bindings. If withStorage is set, an object of the ABI and the storage layout of the contract is
+// written instead. The artifact is looked up in ForgeArtifactsPath like for generating bindings.
+func (generator *BindGenGeneratorLocal) Inspect(w io.Writer, contractName string, withStorage bool) error {
+	contractArtifactPaths, err := generator.getContractArtifactPaths()
+	if err != nil {
+		return err
+	}
+	// the bytecode is not inspected, so it does not need to be linked
+	forgeArtifact, err := generator.readForgeArtifact(contractName, contractArtifactPaths, true)
+	if err != nil {
+		return err
+	}
+
+	out := []byte(forgeArtifact.Abi)
+	if withStorage {
+		if out, err = json.Marshal(inspectedContract{Abi: forgeArtifact.Abi, StorageLayout: &forgeArtifact.StorageLayout}); err != nil {
+			return fmt.Errorf("error marshaling %s's storage layout: %w", contractName, err)
+		}
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, bytes.TrimSpace(out), "", "  "); err != nil {
+		return fmt.Errorf("error formatting %s's ABI: %w", contractName, err)
+	}
+	indented.WriteByte('\n')
+	if _, err := w.Write(indented.Bytes()); err != nil {
+		return fmt.Errorf("error writing %s's ABI: %w", contractName, err)
+	}
+	return nil
+}
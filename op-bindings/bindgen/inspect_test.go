@@ -0,0 +1,62 @@
+package bindgen
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestForgeArtifact writes a forge artifact of the given contract to its standard path in dir.
+func writeTestForgeArtifact(t *testing.T, dir string, contractName string, artifact string) {
+	path := filepath.Join(dir, contractName+".sol", contractName+".json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(artifact), 0o600))
+}
+
+func TestInspect(t *testing.T) {
+	dir := t.TempDir()
+	writeTestForgeArtifact(t, dir, "L1Block", `{
+		"abi": [{"type":"function","name":"number","inputs":[],"outputs":[{"name":"","type":"uint64"}],"stateMutability":"view"}],
+		"storageLayout": {"storage": [{"astId": 1, "contract": "src/L2/L1Block.sol:L1Block", "label": "number", "offset": 0, "slot": "0", "type": "t_uint64"}],
+			"types": {"t_uint64": {"encoding": "inplace", "label": "uint64", "numberOfBytes": "8"}}},
+		"bytecode": {"object": "0x__$1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e$__", "linkReferences": {"src/libraries/SafeCall.sol": {"SafeCall": [{"start": 0, "length": 20}]}}}
+	}`)
+	generator := BindGenGeneratorLocal{
+		BindGenGeneratorBase: BindGenGeneratorBase{Logger: log.NewLogger(log.DiscardHandler())},
+		ForgeArtifactsPath:   dir,
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, generator.Inspect(&out, "L1Block", false))
+	require.Equal(t, `[
+  {
+    "type": "function",
+    "name": "number",
+    "inputs": [],
+    "outputs": [
+      {
+        "name": "",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "view"
+  }
+]
+`, out.String())
+
+	out.Reset()
+	require.NoError(t, generator.Inspect(&out, "L1Block", true))
+	require.Contains(t, out.String(), `"abi": [`)
+	require.Contains(t, out.String(), `"storageLayout": {
+    "storage": [
+      {
+        "astId": 1,
+        "contract": "src/L2/L1Block.sol:L1Block",
+        "label": "number",`)
+
+	require.ErrorContains(t, generator.Inspect(&out, "Unknown", false), `cannot find forge-artifact of "Unknown"`)
+}
@@ -0,0 +1,104 @@
+package bindgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// artifactBytecodeKeys are the keys of the bytecodes of a forge artifact, which may reference external libraries.
+var artifactBytecodeKeys = []string{"bytecode", "deployedBytecode"}
+
+// linkableBytecode is the part of a forge artifact bytecode needed to link external libraries.
+// The object is kept as a string, since it is not valid hex until all libraries are linked.
+type linkableBytecode struct {
+	Object         string              `json:"object"`
+	LinkReferences solc.LinkReferences `json:"linkReferences"`
+}
+
+// linkLibraries replaces the placeholders of external libraries in the bytecodes of the given raw forge artifact
+// with the addresses of the libraries, and returns the linked artifact. Libraries are looked up by name, or by
+// their fully qualified <source>:<name>, which takes precedence, to disambiguate libraries of the same name.
+//
+// If allowUnlinked is set, the placeholders of libraries without an address are replaced with the zero address,
+// for artifacts whose bytecode is not used. Otherwise an error listing all unlinked libraries is returned,
+// since the bytecode could not be deployed.
+func linkLibraries(rawArtifact []byte, libraries map[string]common.Address, allowUnlinked bool) ([]byte, error) {
+	var artifact map[string]json.RawMessage
+	if err := json.Unmarshal(rawArtifact, &artifact); err != nil {
+		return nil, err
+	}
+
+	unlinked := make(map[string]struct{})
+	linkedAny := false
+	for _, key := range artifactBytecodeKeys {
+		rawBytecode, ok := artifact[key]
+		if !ok {
+			continue
+		}
+		var bytecode linkableBytecode
+		if err := json.Unmarshal(rawBytecode, &bytecode); err != nil {
+			return nil, fmt.Errorf("error parsing %s: %w", key, err)
+		}
+		if len(bytecode.LinkReferences) == 0 {
+			continue
+		}
+
+		object := []byte(bytecode.Object)
+		prefix := 0
+		if strings.HasPrefix(bytecode.Object, "0x") {
+			prefix = 2
+		}
+		for source, references := range bytecode.LinkReferences {
+			for name, offsets := range references {
+				address, ok := libraries[source+":"+name]
+				if !ok {
+					address, ok = libraries[name]
+				}
+				if !ok {
+					unlinked[source+":"+name] = struct{}{}
+				}
+				hexAddress := common.Bytes2Hex(address.Bytes())
+				for _, offset := range offsets {
+					start := prefix + 2*int(offset.Start)
+					end := start + 2*int(offset.Length)
+					if offset.Length != common.AddressLength || end > len(object) {
+						return nil, fmt.Errorf("invalid %s link reference of library %s:%s at offset %d", key, source, name, offset.Start)
+					}
+					copy(object[start:end], hexAddress)
+				}
+			}
+		}
+
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(rawBytecode, &fields); err != nil {
+			return nil, fmt.Errorf("error parsing %s: %w", key, err)
+		}
+		linkedObject, err := json.Marshal(string(object))
+		if err != nil {
+			return nil, err
+		}
+		fields["object"] = linkedObject
+		if artifact[key], err = json.Marshal(fields); err != nil {
+			return nil, err
+		}
+		linkedAny = true
+	}
+
+	if len(unlinked) > 0 && !allowUnlinked {
+		names := make([]string, 0, len(unlinked))
+		for name := range unlinked {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("unlinked external libraries %s, provide their addresses to link them", strings.Join(names, ", "))
+	}
+	if !linkedAny {
+		return rawArtifact, nil
+	}
+	return json.Marshal(artifact)
+}
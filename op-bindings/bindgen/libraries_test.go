@@ -0,0 +1,70 @@
+package bindgen
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/foundry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkLibraries(t *testing.T) {
+	// a PUSH20 of the SafeCall library placeholder, followed by a PUSH20 of the Encoding library placeholder
+	object := "0x73__$1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e$__73__$7e6d5c4b3a2f1e0d9c8b7a6f5e4d3c2b1a$__00"
+	artifact := []byte(`{
+		"abi": [],
+		"bytecode": {"object": "` + object + `", "sourceMap": "1:2:3", "linkReferences": {
+			"src/libraries/SafeCall.sol": {"SafeCall": [{"start": 1, "length": 20}]},
+			"src/libraries/Encoding.sol": {"Encoding": [{"start": 22, "length": 20}]}
+		}},
+		"deployedBytecode": {"object": "` + object + `", "linkReferences": {
+			"src/libraries/SafeCall.sol": {"SafeCall": [{"start": 1, "length": 20}]},
+			"src/libraries/Encoding.sol": {"Encoding": [{"start": 22, "length": 20}]}
+		}}
+	}`)
+	safeCall := common.HexToAddress("0x4200000000000000000000000000000000000042")
+	encoding := common.HexToAddress("0x4200000000000000000000000000000000000043")
+	linked := "0x73" + common.Bytes2Hex(safeCall.Bytes()) + "73" + common.Bytes2Hex(encoding.Bytes()) + "00"
+
+	t.Run("Linked", func(t *testing.T) {
+		raw, err := linkLibraries(artifact, map[string]common.Address{
+			"SafeCall":                            safeCall,
+			"Encoding":                            common.HexToAddress("0x01"),
+			"src/libraries/Encoding.sol:Encoding": encoding,
+		}, false)
+		require.NoError(t, err)
+
+		var forgeArtifact foundry.Artifact
+		require.NoError(t, json.Unmarshal(raw, &forgeArtifact))
+		require.Equal(t, linked, forgeArtifact.Bytecode.Object.String())
+		require.Equal(t, linked, forgeArtifact.DeployedBytecode.Object.String())
+		require.Equal(t, "1:2:3", forgeArtifact.Bytecode.SourceMap)
+	})
+
+	t.Run("Unlinked", func(t *testing.T) {
+		_, err := linkLibraries(artifact, map[string]common.Address{"SafeCall": safeCall}, false)
+		require.EqualError(t, err, "unlinked external libraries src/libraries/Encoding.sol:Encoding, provide their addresses to link them")
+	})
+
+	t.Run("UnlinkedAllowed", func(t *testing.T) {
+		raw, err := linkLibraries(artifact, nil, true)
+		require.NoError(t, err)
+		var forgeArtifact foundry.Artifact
+		require.NoError(t, json.Unmarshal(raw, &forgeArtifact))
+		require.Equal(t, "0x73"+common.Bytes2Hex(common.Address{}.Bytes())+"73"+common.Bytes2Hex(common.Address{}.Bytes())+"00", forgeArtifact.Bytecode.Object.String())
+	})
+
+	t.Run("NoLinkReferences", func(t *testing.T) {
+		raw := []byte(`{"abi": [], "bytecode": {"object": "0x00", "linkReferences": {}}}`)
+		linkedRaw, err := linkLibraries(raw, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, raw, linkedRaw)
+	})
+
+	t.Run("InvalidLinkReference", func(t *testing.T) {
+		raw := []byte(`{"bytecode": {"object": "0x00", "linkReferences": {"src/A.sol": {"A": [{"start": 0, "length": 20}]}}}}`)
+		_, err := linkLibraries(raw, map[string]common.Address{"A": safeCall}, false)
+		require.ErrorContains(t, err, "invalid bytecode link reference of library src/A.sol:A at offset 0")
+	})
+}
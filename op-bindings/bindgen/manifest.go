@@ -0,0 +1,97 @@
+package bindgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ManifestFileName is the name of the manifest file written to the metadata output directory.
+const ManifestFileName = "manifest.json"
+
+const (
+	ManifestSourceLocal  = "local"
+	ManifestSourceRemote = "remote"
+)
+
+// ManifestEntry describes the binding generated for a single contract.
+type ManifestEntry struct {
+	Name string `json:"name"`
+	// Source is where the contract data was sourced from, ManifestSourceLocal or ManifestSourceRemote
+	Source string `json:"source"`
+	// Chain is the chain a remote contract was sourced from, if sourced from a single chain
+	Chain string `json:"chain,omitempty"`
+	// Address is the address of the contract on Chain, or the address of a proxy
+	Address *common.Address `json:"address,omitempty"`
+	// Deployments are the addresses of a remote contract on every chain it is deployed on
+	Deployments Deployments `json:"deployments,omitempty"`
+	// AbiHash is the keccak256 hash of the compacted JSON ABI
+	AbiHash common.Hash `json:"abiHash"`
+	// BytecodeHash is the keccak256 hash of the deployed bytecode, absent for bindings without bytecode
+	BytecodeHash *common.Hash `json:"bytecodeHash,omitempty"`
+}
+
+// Manifest is a machine-readable index of the generated bindings. It may be shared by
+// multiple generators, so a single manifest describes all bindings of a run.
+type Manifest struct {
+	mu      sync.Mutex
+	entries map[string]ManifestEntry
+}
+
+func NewManifest() *Manifest {
+	return &Manifest{entries: make(map[string]ManifestEntry)}
+}
+
+// record adds the binding of a contract to the manifest, if there is one.
+// The bytecode is hex encoded, and may be empty for bindings without bytecode.
+func (m *Manifest) record(entry ManifestEntry, abi []byte, bytecode string) error {
+	if m == nil {
+		return nil
+	}
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, abi); err != nil {
+		return fmt.Errorf("error compacting %s's ABI for the manifest: %w", entry.Name, err)
+	}
+	entry.AbiHash = crypto.Keccak256Hash(compacted.Bytes())
+	if code := common.FromHex(bytecode); len(code) != 0 {
+		hash := crypto.Keccak256Hash(code)
+		entry.BytecodeHash = &hash
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.entries[entry.Name]; ok {
+		return fmt.Errorf("contract %s is recorded in the manifest more than once", entry.Name)
+	}
+	m.entries[entry.Name] = entry
+	return nil
+}
+
+// Write writes the manifest to ManifestFileName in dir, with the contracts sorted by name.
+func (m *Manifest) Write(dir string) error {
+	m.mu.Lock()
+	contracts := make([]ManifestEntry, 0, len(m.entries))
+	for _, entry := range m.entries {
+		contracts = append(contracts, entry)
+	}
+	m.mu.Unlock()
+	sort.Slice(contracts, func(i, j int) bool { return contracts[i].Name < contracts[j].Name })
+
+	data, err := json.MarshalIndent(struct {
+		Contracts []ManifestEntry `json:"contracts"`
+	}{contracts}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling manifest: %w", err)
+	}
+	manifestPath := filepath.Join(dir, ManifestFileName)
+	if err := writeFileIfChanged(manifestPath, append(data, '\n')); err != nil {
+		return fmt.Errorf("error writing manifest to %s: %w", manifestPath, err)
+	}
+	return nil
+}
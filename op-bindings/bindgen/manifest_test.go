@@ -0,0 +1,50 @@
+package bindgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifest(t *testing.T) {
+	var disabled *Manifest
+	require.NoError(t, disabled.record(ManifestEntry{Name: "L1Block"}, []byte("[]"), "0x6001"))
+
+	manifest := NewManifest()
+	address := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+	require.NoError(t, manifest.record(ManifestEntry{
+		Name: "MultiCall3", Source: ManifestSourceRemote, Chain: "eth", Address: &address, Deployments: Deployments{"eth": address},
+	}, []byte(`[ {"type": "fallback"} ]`), "0x6001"))
+	require.NoError(t, manifest.record(ManifestEntry{Name: "IERC20", Source: ManifestSourceLocal}, []byte(`[{"type":"fallback"}]`), ""))
+	require.ErrorContains(t, manifest.record(ManifestEntry{Name: "IERC20", Source: ManifestSourceRemote}, []byte("[]"), ""), "more than once")
+
+	dir := t.TempDir()
+	require.NoError(t, manifest.Write(dir))
+	data, err := os.ReadFile(filepath.Join(dir, ManifestFileName))
+	require.NoError(t, err)
+	// entries are sorted by name, and the ABI hash does not depend on the formatting of the ABI
+	require.Equal(t, `{
+  "contracts": [
+    {
+      "name": "IERC20",
+      "source": "local",
+      "abiHash": "0x49c1e760ec4fe8796b10a50d32bbbc9dab116fcf8b0ecb56f13e72ca428fc73d"
+    },
+    {
+      "name": "MultiCall3",
+      "source": "remote",
+      "chain": "eth",
+      "address": "0xca11bde05977b3631167028862be2a173976ca11",
+      "deployments": {
+        "eth": "0xca11bde05977b3631167028862be2a173976ca11"
+      },
+      "abiHash": "0x49c1e760ec4fe8796b10a50d32bbbc9dab116fcf8b0ecb56f13e72ca428fc73d",
+      "bytecodeHash": "0x309c67890bde4c575dc23d2cc3b5c3a3d599e312e980e9b61b5bc8f3cd87c8bb"
+    }
+  ]
+}
+`, string(data))
+}
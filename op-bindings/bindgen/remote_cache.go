@@ -0,0 +1,100 @@
+package bindgen
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/etherscan"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// cachingContractDataClient wraps a ContractDataClient with an on-disk cache of its responses,
+// so regenerating bindings does not re-fetch unchanged contract data.
+// Responses are cached per chain, by contract address or deployment transaction hash.
+type cachingContractDataClient struct {
+	inner  ContractDataClient
+	dir    string
+	ttl    time.Duration
+	logger log.Logger
+}
+
+var _ ContractDataClient = (*cachingContractDataClient)(nil)
+
+// NewCachingContractDataClient returns a ContractDataClient which caches the responses of the given client
+// in a subdirectory of cacheDir named after the chain. Cached responses older than ttl are refetched,
+// a ttl of 0 never expires cached responses.
+func NewCachingContractDataClient(logger log.Logger, inner ContractDataClient, cacheDir, chain string, ttl time.Duration) ContractDataClient {
+	return &cachingContractDataClient{
+		inner:  inner,
+		dir:    filepath.Join(cacheDir, chain),
+		ttl:    ttl,
+		logger: logger.New("chain", chain),
+	}
+}
+
+// cached returns the cached response stored under the given key, or fetches, caches and returns it on a cache miss.
+func cached[T any](ctx context.Context, c *cachingContractDataClient, kind, key string, fetch func(ctx context.Context) (T, error)) (T, error) {
+	path := filepath.Join(c.dir, kind, strings.ToLower(key)+".json")
+
+	if info, err := os.Stat(path); err == nil && (c.ttl == 0 || time.Since(info.ModTime()) < c.ttl) {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return *new(T), fmt.Errorf("error reading cached %s of %s: %w", kind, key, err)
+		}
+		var result T
+		if err := json.Unmarshal(raw, &result); err == nil {
+			c.logger.Debug("Using cached contract data", "kind", kind, "key", key)
+			return result, nil
+		}
+		c.logger.Warn("Ignoring malformed cached contract data", "kind", kind, "key", key, "path", path)
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return *new(T), fmt.Errorf("error checking cached %s of %s: %w", kind, key, err)
+	}
+
+	result, err := fetch(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return result, fmt.Errorf("error encoding %s of %s for caching: %w", kind, key, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return result, fmt.Errorf("error creating cache directory: %w", err)
+	}
+	if err := os.WriteFile(path, raw, 0o600); err != nil {
+		return result, fmt.Errorf("error caching %s of %s: %w", kind, key, err)
+	}
+	return result, nil
+}
+
+func (c *cachingContractDataClient) FetchAbi(ctx context.Context, address string) (string, error) {
+	return cached(ctx, c, "abi", address, func(ctx context.Context) (string, error) {
+		return c.inner.FetchAbi(ctx, address)
+	})
+}
+
+func (c *cachingContractDataClient) FetchDeployedBytecode(ctx context.Context, address string) (string, error) {
+	return cached(ctx, c, "deployedBytecode", address, func(ctx context.Context) (string, error) {
+		return c.inner.FetchDeployedBytecode(ctx, address)
+	})
+}
+
+func (c *cachingContractDataClient) FetchDeploymentTxHash(ctx context.Context, address string) (string, error) {
+	return cached(ctx, c, "deploymentTxHash", address, func(ctx context.Context) (string, error) {
+		return c.inner.FetchDeploymentTxHash(ctx, address)
+	})
+}
+
+func (c *cachingContractDataClient) FetchDeploymentTx(ctx context.Context, txHash string) (etherscan.Transaction, error) {
+	return cached(ctx, c, "deploymentTx", txHash, func(ctx context.Context) (etherscan.Transaction, error) {
+		return c.inner.FetchDeploymentTx(ctx, txHash)
+	})
+}
@@ -0,0 +1,81 @@
+package bindgen
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/etherscan"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+type countingContractDataClient struct {
+	calls int
+}
+
+func (c *countingContractDataClient) FetchAbi(ctx context.Context, address string) (string, error) {
+	c.calls++
+	return `[{"type":"fallback"}]`, nil
+}
+
+func (c *countingContractDataClient) FetchDeployedBytecode(ctx context.Context, address string) (string, error) {
+	c.calls++
+	return "0x6001", nil
+}
+
+func (c *countingContractDataClient) FetchDeploymentTxHash(ctx context.Context, address string) (string, error) {
+	c.calls++
+	return "0xabcd", nil
+}
+
+func (c *countingContractDataClient) FetchDeploymentTx(ctx context.Context, txHash string) (etherscan.Transaction, error) {
+	c.calls++
+	return etherscan.Transaction{Hash: txHash, Input: "0x6002", To: ""}, nil
+}
+
+func TestCachingContractDataClient(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelDebug)
+	ctx := context.Background()
+	dir := t.TempDir()
+	const address = "0xcA11bde05977b3631167028862bE2a173976CA11"
+
+	fetchAll := func(c ContractDataClient) {
+		abi, err := c.FetchAbi(ctx, address)
+		require.NoError(t, err)
+		require.Equal(t, `[{"type":"fallback"}]`, abi)
+		bytecode, err := c.FetchDeployedBytecode(ctx, address)
+		require.NoError(t, err)
+		require.Equal(t, "0x6001", bytecode)
+		txHash, err := c.FetchDeploymentTxHash(ctx, address)
+		require.NoError(t, err)
+		tx, err := c.FetchDeploymentTx(ctx, txHash)
+		require.NoError(t, err)
+		require.Equal(t, etherscan.Transaction{Hash: "0xabcd", Input: "0x6002"}, tx)
+	}
+
+	inner := new(countingContractDataClient)
+	fetchAll(NewCachingContractDataClient(logger, inner, dir, "eth", time.Hour))
+	require.Equal(t, 4, inner.calls)
+	require.FileExists(t, filepath.Join(dir, "eth", "abi", "0xca11bde05977b3631167028862be2a173976ca11.json"))
+
+	// a rerun is served from the cache entirely
+	fetchAll(NewCachingContractDataClient(logger, inner, dir, "eth", time.Hour))
+	require.Equal(t, 4, inner.calls)
+
+	// the cache is per chain
+	fetchAll(NewCachingContractDataClient(logger, inner, dir, "op", time.Hour))
+	require.Equal(t, 8, inner.calls)
+
+	// stale entries are refreshed
+	stale := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, filepath.Walk(filepath.Join(dir, "eth"), func(path string, info os.FileInfo, err error) error {
+		require.NoError(t, err)
+		return os.Chtimes(path, stale, stale)
+	}))
+	fetchAll(NewCachingContractDataClient(logger, inner, dir, "eth", time.Hour))
+	require.Equal(t, 12, inner.calls)
+}
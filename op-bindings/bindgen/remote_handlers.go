@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math/big"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -12,7 +13,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-bindings/etherscan"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type ContractData struct {
@@ -21,18 +22,18 @@ type ContractData struct {
 	DeploymentTx etherscan.Transaction
 }
 
-func (generator *BindGenGeneratorRemote) standardHandler(contractMetadata *RemoteContractMetadata) error {
-	fetchedData, err := generator.FetchContractData(contractMetadata.Verified, "eth", contractMetadata.Deployments.Eth.Hex())
+func (generator *BindGenGeneratorRemote) standardHandler(ctx context.Context, contractMetadata *RemoteContractMetadata) (string, error) {
+	fetchedData, err := generator.FetchContractData(ctx, contractMetadata.Verified, "eth", contractMetadata.Deployments["eth"].Hex())
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	contractMetadata.DeployedBin = fetchedData.DeployedBin
-	if err = generator.CompareDeployedBytecodeWithRpc(contractMetadata, "eth"); err != nil {
-		return err
+	if err = generator.CompareDeployedBytecodeWithRpc(ctx, contractMetadata, "eth"); err != nil {
+		return "", err
 	}
-	if err = generator.CompareDeployedBytecodeWithRpc(contractMetadata, "op"); err != nil {
-		return err
+	if err = generator.CompareDeployedBytecodeWithRpc(ctx, contractMetadata, "op"); err != nil {
+		return "", err
 	}
 
 	// If ABI was explicitly provided by config, don't overwrite
@@ -40,160 +41,326 @@ func (generator *BindGenGeneratorRemote) standardHandler(contractMetadata *Remot
 		contractMetadata.ABI = fetchedData.Abi
 	} else if fetchedData.Abi != "" && contractMetadata.ABI != fetchedData.Abi {
 		generator.Logger.Debug("ABIs", "given", contractMetadata.ABI, "fetched", fetchedData.Abi)
-		return fmt.Errorf("the given ABI for %s differs from what was fetched from Etherscan", contractMetadata.Name)
+		return "", fmt.Errorf("the given ABI for %s differs from what was fetched from Etherscan", contractMetadata.Name)
 	}
 
 	if contractMetadata.InitBin, err = generator.removeDeploymentSalt(fetchedData.DeploymentTx.Input, contractMetadata.DeploymentSalt); err != nil {
-		return err
+		return "", err
 	}
 
-	if err := generator.CompareInitBytecodeWithOp(contractMetadata, true); err != nil {
-		return fmt.Errorf("%s: %w", contractMetadata.Name, err)
+	if err := generator.CompareInitBytecodeWithOp(ctx, contractMetadata, true); err != nil {
+		return "", fmt.Errorf("%s: %w", contractMetadata.Name, err)
 	}
-	if err := generator.CompareDeployedBytecodeWithOp(contractMetadata, true); err != nil {
-		return fmt.Errorf("%s: %w", contractMetadata.Name, err)
+	if err := generator.CompareDeployedBytecodeWithOp(ctx, contractMetadata, true); err != nil {
+		return "", fmt.Errorf("%s: %w", contractMetadata.Name, err)
 	}
 
-	return generator.writeAllOutputs(contractMetadata, remoteContractMetadataTemplate)
+	return remoteContractMetadataTemplate, nil
 }
 
-func (generator *BindGenGeneratorRemote) create2DeployerHandler(contractMetadata *RemoteContractMetadata) error {
-	fetchedData, err := generator.FetchContractData(contractMetadata.Verified, "eth", contractMetadata.Deployments.Eth.Hex())
+func (generator *BindGenGeneratorRemote) create2DeployerHandler(ctx context.Context, contractMetadata *RemoteContractMetadata) (string, error) {
+	fetchedData, err := generator.FetchContractData(ctx, contractMetadata.Verified, "eth", contractMetadata.Deployments["eth"].Hex())
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	contractMetadata.ABI = fetchedData.Abi
 	contractMetadata.DeployedBin = fetchedData.DeployedBin
 	if contractMetadata.InitBin, err = generator.removeDeploymentSalt(fetchedData.DeploymentTx.Input, contractMetadata.DeploymentSalt); err != nil {
-		return err
+		return "", err
 	}
 
 	// We're expecting the initialization bytecode for Create2Deployer to not match the init code on OP,
 	// because the deployment on OP has been overwritten by the Canyon hardfork, and the init code
 	// Etherscan returns for the OP deployment is from the initial outdated deployment.
 	// For context: https://github.com/ethereum-optimism/op-geth/pull/126
-	if err := generator.CompareInitBytecodeWithOp(contractMetadata, false); err != nil {
-		return fmt.Errorf("%s: %w", contractMetadata.Name, err)
+	if err := generator.CompareInitBytecodeWithOp(ctx, contractMetadata, false); err != nil {
+		return "", fmt.Errorf("%s: %w", contractMetadata.Name, err)
 	}
-	if err := generator.CompareDeployedBytecodeWithOp(contractMetadata, true); err != nil {
-		return fmt.Errorf("%s: %w", contractMetadata.Name, err)
+	if err := generator.CompareDeployedBytecodeWithOp(ctx, contractMetadata, true); err != nil {
+		return "", fmt.Errorf("%s: %w", contractMetadata.Name, err)
 	}
 
-	return generator.writeAllOutputs(contractMetadata, remoteContractMetadataTemplate)
+	return remoteContractMetadataTemplate, nil
 }
 
-func (generator *BindGenGeneratorRemote) multiSendHandler(contractMetadata *RemoteContractMetadata) error {
+func (generator *BindGenGeneratorRemote) multiSendHandler(ctx context.Context, contractMetadata *RemoteContractMetadata) (string, error) {
 	// MultiSend has an immutable that resolves to this(address).
 	// Because we're predeploying MultiSend to the same address as on OP,
 	// we can use the deployed bytecode directly for the predeploy
-	fetchedData, err := generator.FetchContractData(contractMetadata.Verified, "op", contractMetadata.Deployments.Op.Hex())
+	fetchedData, err := generator.FetchContractData(ctx, contractMetadata.Verified, "op", contractMetadata.Deployments["op"].Hex())
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	contractMetadata.ABI = fetchedData.Abi
 	contractMetadata.DeployedBin = fetchedData.DeployedBin
-	if err = generator.CompareDeployedBytecodeWithRpc(contractMetadata, "op"); err != nil {
-		return err
+	if err = generator.CompareDeployedBytecodeWithRpc(ctx, contractMetadata, "op"); err != nil {
+		return "", err
 	}
 	if contractMetadata.InitBin, err = generator.removeDeploymentSalt(fetchedData.DeploymentTx.Input, contractMetadata.DeploymentSalt); err != nil {
-		return err
+		return "", err
 	}
 
-	return generator.writeAllOutputs(contractMetadata, remoteContractMetadataTemplate)
+	return remoteContractMetadataTemplate, nil
 }
 
-func (generator *BindGenGeneratorRemote) senderCreatorHandler(contractMetadata *RemoteContractMetadata) error {
-	var err error
-	contractMetadata.DeployedBin, err = generator.ContractDataClients.Eth.FetchDeployedBytecode(context.Background(), contractMetadata.Deployments.Eth.Hex())
+func (generator *BindGenGeneratorRemote) senderCreatorHandler(ctx context.Context, contractMetadata *RemoteContractMetadata) (string, error) {
+	client, err := generator.contractDataClient("eth")
 	if err != nil {
-		return fmt.Errorf("error fetching deployed bytecode: %w", err)
+		return "", err
 	}
-	if err = generator.CompareDeployedBytecodeWithRpc(contractMetadata, "eth"); err != nil {
-		return err
+	contractMetadata.DeployedBin, err = client.FetchDeployedBytecode(ctx, contractMetadata.Deployments["eth"].Hex())
+	if err != nil {
+		return "", fmt.Errorf("error fetching deployed bytecode: %w", err)
 	}
-	if err = generator.CompareDeployedBytecodeWithRpc(contractMetadata, "op"); err != nil {
-		return err
+	if err = generator.CompareDeployedBytecodeWithRpc(ctx, contractMetadata, "eth"); err != nil {
+		return "", err
+	}
+	if err = generator.CompareDeployedBytecodeWithRpc(ctx, contractMetadata, "op"); err != nil {
+		return "", err
 	}
 
 	// The SenderCreator contract is deployed by EntryPoint, so the transaction data
 	// from the deployment transaction is for the entire EntryPoint deployment.
 	// So, we're manually providing the initialization bytecode and therefore it isn't being compared here
-	if err := generator.CompareInitBytecodeWithOp(contractMetadata, false); err != nil {
-		return fmt.Errorf("%s: %w", contractMetadata.Name, err)
+	if err := generator.CompareInitBytecodeWithOp(ctx, contractMetadata, false); err != nil {
+		return "", fmt.Errorf("%s: %w", contractMetadata.Name, err)
 	}
-	if err := generator.CompareDeployedBytecodeWithOp(contractMetadata, true); err != nil {
-		return fmt.Errorf("%s: %w", contractMetadata.Name, err)
+	if err := generator.CompareDeployedBytecodeWithOp(ctx, contractMetadata, true); err != nil {
+		return "", fmt.Errorf("%s: %w", contractMetadata.Name, err)
 	}
 
-	return generator.writeAllOutputs(contractMetadata, remoteContractMetadataTemplate)
+	return remoteContractMetadataTemplate, nil
 }
 
-func (generator *BindGenGeneratorRemote) permit2Handler(contractMetadata *RemoteContractMetadata) error {
-	fetchedData, err := generator.FetchContractData(contractMetadata.Verified, "eth", contractMetadata.Deployments.Eth.Hex())
+func (generator *BindGenGeneratorRemote) permit2Handler(ctx context.Context, contractMetadata *RemoteContractMetadata) (string, error) {
+	fetchedData, err := generator.FetchContractData(ctx, contractMetadata.Verified, "eth", contractMetadata.Deployments["eth"].Hex())
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	contractMetadata.ABI = fetchedData.Abi
 	contractMetadata.DeployedBin = fetchedData.DeployedBin
 	if contractMetadata.InitBin, err = generator.removeDeploymentSalt(fetchedData.DeploymentTx.Input, contractMetadata.DeploymentSalt); err != nil {
-		return err
+		return "", err
 	}
 
 	if !strings.EqualFold(contractMetadata.Deployer.Hex(), fetchedData.DeploymentTx.To) {
-		return fmt.Errorf(
+		return "", fmt.Errorf(
 			"expected deployer address: %s doesn't match the to address: %s for Permit2's proxy deployment transaction",
 			contractMetadata.Deployer.Hex(),
 			fetchedData.DeploymentTx.To,
 		)
 	}
 
-	if err := generator.CompareInitBytecodeWithOp(contractMetadata, true); err != nil {
-		return fmt.Errorf("%s: %w", contractMetadata.Name, err)
+	if err := generator.CompareInitBytecodeWithOp(ctx, contractMetadata, true); err != nil {
+		return "", fmt.Errorf("%s: %w", contractMetadata.Name, err)
 	}
 	// We're asserting the deployed bytecode doesn't match, because Permit2 has immutable Solidity variables that
 	// are dependent on block.chainid
-	if err := generator.CompareDeployedBytecodeWithOp(contractMetadata, false); err != nil {
-		return fmt.Errorf("%s: %w", contractMetadata.Name, err)
+	if err := generator.CompareDeployedBytecodeWithOp(ctx, contractMetadata, false); err != nil {
+		return "", fmt.Errorf("%s: %w", contractMetadata.Name, err)
 	}
 
-	return generator.writeAllOutputs(contractMetadata, permit2MetadataTemplate)
+	return permit2MetadataTemplate, nil
 }
 
-func (generator *BindGenGeneratorRemote) FetchContractData(contractVerified bool, chain, deploymentAddress string) (ContractData, error) {
+// chainHandler handles contracts without a dedicated handler, sourcing them from the chain
+// specified in the contracts list, and verifying the fetched deployed bytecode against the
+// RPC of every chain the contract is listed as deployed on.
+func (generator *BindGenGeneratorRemote) chainHandler(ctx context.Context, contractMetadata *RemoteContractMetadata) (string, error) {
+	chain := contractMetadata.Chain
+	deployment, ok := contractMetadata.Deployments[chain]
+	if !ok {
+		return "", fmt.Errorf("no deployment address on chain %s provided for %s", chain, contractMetadata.Name)
+	}
+
+	if contractMetadata.ResolveProxy {
+		implementation, err := generator.resolveProxyImplementation(ctx, chain, deployment, contractMetadata.blockNumber())
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", contractMetadata.Name, err)
+		}
+		generator.Logger.Info("Resolved proxy implementation", "contract", contractMetadata.Name, "chain", chain, "proxy", deployment, "implementation", implementation)
+		contractMetadata.implementation = implementation
+		deployment = implementation
+	}
+
+	fetchedData, err := generator.FetchContractData(ctx, contractMetadata.Verified, chain, deployment.Hex())
+	if err != nil {
+		return "", err
+	}
+
+	contractMetadata.DeployedBin = fetchedData.DeployedBin
+	if contractMetadata.AtBlock != nil {
+		// The contract data source serves the latest code, which may differ from the code at the pinned block,
+		// so the code at the pinned block is read from the RPC instead, and there is nothing to compare it against.
+		if contractMetadata.DeployedBin, err = generator.codeAt(ctx, chain, deployment, contractMetadata.blockNumber()); err != nil {
+			return "", fmt.Errorf("%s: %w", contractMetadata.Name, err)
+		}
+	} else if contractMetadata.ResolveProxy {
+		// The implementation may be deployed at a different address on every chain,
+		// so its bytecode is only verified on the chain it was resolved on.
+		if err = generator.compareDeployedBytecodeWithRpcAt(ctx, contractMetadata, chain, deployment); err != nil {
+			return "", err
+		}
+	} else {
+		for deploymentChain := range contractMetadata.Deployments {
+			if err = generator.CompareDeployedBytecodeWithRpc(ctx, contractMetadata, deploymentChain); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	// If ABI was explicitly provided by config, don't overwrite
+	if contractMetadata.ABI == "" {
+		contractMetadata.ABI = fetchedData.Abi
+	} else if fetchedData.Abi != "" && contractMetadata.ABI != fetchedData.Abi {
+		generator.Logger.Debug("ABIs", "given", contractMetadata.ABI, "fetched", fetchedData.Abi)
+		return "", fmt.Errorf("the given ABI for %s differs from what was fetched from chain %s", contractMetadata.Name, chain)
+	}
+
+	if contractMetadata.InitBin, err = generator.removeDeploymentSalt(fetchedData.DeploymentTx.Input, contractMetadata.DeploymentSalt); err != nil {
+		return "", err
+	}
+
+	return remoteContractMetadataTemplate, nil
+}
+
+// eip1967ImplementationSlot is the storage slot EIP-1967 proxies store their implementation address in,
+// bytes32(uint256(keccak256('eip1967.proxy.implementation')) - 1).
+var eip1967ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+
+// abiOnlyHandler sources just the ABI of a contract, from the contracts list if given, otherwise from the
+// contract's chain. No metadata file is written for ABI-only contracts, so no template is returned.
+func (generator *BindGenGeneratorRemote) abiOnlyHandler(ctx context.Context, contractMetadata *RemoteContractMetadata) (string, error) {
+	if contractMetadata.ABI != "" {
+		return "", nil
+	}
+
+	chain := contractMetadata.Chain
+	if chain == "" {
+		return "", fmt.Errorf("the ABI-only contract %s requires an abi or a chain to source it from", contractMetadata.Name)
+	}
+	deployment, ok := contractMetadata.Deployments[chain]
+	if !ok {
+		return "", fmt.Errorf("no deployment address on chain %s provided for %s", chain, contractMetadata.Name)
+	}
+
+	if contractMetadata.ResolveProxy {
+		implementation, err := generator.resolveProxyImplementation(ctx, chain, deployment, contractMetadata.blockNumber())
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", contractMetadata.Name, err)
+		}
+		contractMetadata.implementation = implementation
+		deployment = implementation
+	}
+
+	client, err := generator.contractDataClient(chain)
+	if err != nil {
+		return "", err
+	}
+	if contractMetadata.ABI, err = client.FetchAbi(ctx, deployment.Hex()); err != nil {
+		return "", fmt.Errorf("error fetching ABI: %w", err)
+	}
+	return "", nil
+}
+
+// eip1167Prefix and eip1167Suffix surround the implementation address in the deployed bytecode of EIP-1167 minimal proxies.
+var (
+	eip1167Prefix = common.FromHex("0x363d3d373d3d3d363d73")
+	eip1167Suffix = common.FromHex("0x5af43d82803e903d91602b57fd5bf3")
+)
+
+// eip1167Implementation returns the implementation address embedded in the deployed bytecode of an EIP-1167 minimal proxy,
+// and false if the code is not a minimal proxy.
+func eip1167Implementation(code []byte) (common.Address, bool) {
+	if len(code) != len(eip1167Prefix)+common.AddressLength+len(eip1167Suffix) ||
+		!bytes.HasPrefix(code, eip1167Prefix) || !bytes.HasSuffix(code, eip1167Suffix) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(code[len(eip1167Prefix) : len(eip1167Prefix)+common.AddressLength]), true
+}
+
+// resolveProxyImplementation reads the implementation address of a proxy at the given block, nil for the latest block,
+// from the RPC of the given chain. EIP-1167 minimal proxies embed it in their bytecode, other proxies are expected to be
+// EIP-1967 proxies, which store it in their implementation slot.
+func (generator *BindGenGeneratorRemote) resolveProxyImplementation(ctx context.Context, chain string, proxy common.Address, block *big.Int) (common.Address, error) {
+	client, ok := generator.RpcClients[chain]
+	if !ok {
+		return common.Address{}, fmt.Errorf("unknown chain: %s, unable to retrieve a RPC client", chain)
+	}
+	code, err := client.CodeAt(ctx, proxy, block)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("error getting deployed bytecode of %s on chain %s: %w", proxy, chain, err)
+	}
+	if implementation, ok := eip1167Implementation(code); ok {
+		generator.Logger.Debug("Resolved EIP-1167 minimal proxy", "chain", chain, "proxy", proxy, "implementation", implementation)
+		return implementation, nil
+	}
+	value, err := client.StorageAt(ctx, proxy, eip1967ImplementationSlot, block)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("error reading EIP-1967 implementation slot of %s on chain %s: %w", proxy, chain, err)
+	}
+	implementation := common.BytesToAddress(value)
+	if implementation == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("%s on chain %s is neither an EIP-1167 minimal proxy nor an EIP-1967 proxy, its implementation slot is empty", proxy, chain)
+	}
+	return implementation, nil
+}
+
+// codeAt reads the code at the given address and block from the RPC of the given chain, as hex.
+// It fails if there is no code, e.g. since the contract was not deployed yet at the block.
+func (generator *BindGenGeneratorRemote) codeAt(ctx context.Context, chain string, address common.Address, block *big.Int) (string, error) {
+	client, ok := generator.RpcClients[chain]
+	if !ok {
+		return "", fmt.Errorf("unknown chain: %s, unable to retrieve a RPC client", chain)
+	}
+	code, err := client.CodeAt(ctx, address, block)
+	if err != nil {
+		return "", fmt.Errorf("error getting deployed bytecode of %s at block %v from RPC on chain %s: %w", address, block, chain, err)
+	}
+	if len(code) == 0 {
+		return "", fmt.Errorf("%s has no code at block %v on chain %s", address, block, chain)
+	}
+	return hexutil.Encode(code), nil
+}
+
+func (generator *BindGenGeneratorRemote) contractDataClient(chain string) (ContractDataClient, error) {
+	client, ok := generator.ContractDataClients[chain]
+	if !ok {
+		return nil, fmt.Errorf("unknown chain, unable to retrieve a contract data client for chain: %s", chain)
+	}
+	return client, nil
+}
+
+func (generator *BindGenGeneratorRemote) FetchContractData(ctx context.Context, contractVerified bool, chain, deploymentAddress string) (ContractData, error) {
 	var data ContractData
 	var err error
 
-	var client contractDataClient
-	switch chain {
-	case "eth":
-		client = generator.ContractDataClients.Eth
-	case "op":
-		client = generator.ContractDataClients.Op
-	default:
-		return data, fmt.Errorf("unknown chain, unable to retrieve a contract data client for chain: %s", chain)
+	client, err := generator.contractDataClient(chain)
+	if err != nil {
+		return data, err
 	}
 
 	if contractVerified {
-		data.Abi, err = client.FetchAbi(context.Background(), deploymentAddress)
+		data.Abi, err = client.FetchAbi(ctx, deploymentAddress)
 		if err != nil {
 			return ContractData{}, fmt.Errorf("error fetching ABI: %w", err)
 		}
 	}
 
-	data.DeployedBin, err = client.FetchDeployedBytecode(context.Background(), deploymentAddress)
+	data.DeployedBin, err = client.FetchDeployedBytecode(ctx, deploymentAddress)
 	if err != nil {
 		return ContractData{}, fmt.Errorf("error fetching deployed bytecode: %w", err)
 	}
 
-	deploymentTxHash, err := client.FetchDeploymentTxHash(context.Background(), deploymentAddress)
+	deploymentTxHash, err := client.FetchDeploymentTxHash(ctx, deploymentAddress)
 	if err != nil {
 		return ContractData{}, fmt.Errorf("error fetching deployment transaction hash: %w", err)
 	}
 
-	data.DeploymentTx, err = client.FetchDeploymentTx(context.Background(), deploymentTxHash)
+	data.DeploymentTx, err = client.FetchDeploymentTx(ctx, deploymentTxHash)
 	if err != nil {
 		return ContractData{}, fmt.Errorf("error fetching deployment transaction data: %w", err)
 	}
@@ -219,18 +386,18 @@ func (generator *BindGenGeneratorRemote) removeDeploymentSalt(deploymentData, de
 	return re.ReplaceAllString(deploymentData, ""), nil
 }
 
-func (generator *BindGenGeneratorRemote) CompareInitBytecodeWithOp(contractMetadataEth *RemoteContractMetadata, initCodeShouldMatch bool) error {
+func (generator *BindGenGeneratorRemote) CompareInitBytecodeWithOp(ctx context.Context, contractMetadataEth *RemoteContractMetadata, initCodeShouldMatch bool) error {
 	if contractMetadataEth.InitBin == "" {
 		return fmt.Errorf("no initialization bytecode provided for ETH deployment for comparison")
 	}
 
 	var zeroAddress common.Address
-	if contractMetadataEth.Deployments.Op == zeroAddress {
+	if contractMetadataEth.Deployments["op"] == zeroAddress {
 		return fmt.Errorf("no deployment address on Optimism provided for %s", contractMetadataEth.Name)
 	}
 
 	// Passing false here, because true will retrieve contract's ABI, but we don't need it for bytecode comparison
-	opContractData, err := generator.FetchContractData(false, "op", contractMetadataEth.Deployments.Op.Hex())
+	opContractData, err := generator.FetchContractData(ctx, false, "op", contractMetadataEth.Deployments["op"].Hex())
 	if err != nil {
 		return err
 	}
@@ -259,18 +426,18 @@ func (generator *BindGenGeneratorRemote) CompareInitBytecodeWithOp(contractMetad
 	return nil
 }
 
-func (generator *BindGenGeneratorRemote) CompareDeployedBytecodeWithOp(contractMetadataEth *RemoteContractMetadata, deployedCodeShouldMatch bool) error {
+func (generator *BindGenGeneratorRemote) CompareDeployedBytecodeWithOp(ctx context.Context, contractMetadataEth *RemoteContractMetadata, deployedCodeShouldMatch bool) error {
 	if contractMetadataEth.DeployedBin == "" {
 		return fmt.Errorf("no deployed bytecode provided for ETH deployment for comparison")
 	}
 
 	var zeroAddress common.Address
-	if contractMetadataEth.Deployments.Op == zeroAddress {
+	if contractMetadataEth.Deployments["op"] == zeroAddress {
 		return fmt.Errorf("no deployment address on Optimism provided for %s", contractMetadataEth.Name)
 	}
 
 	// Passing false here, because true will retrieve contract's ABI, but we don't need it for bytecode comparison
-	opContractData, err := generator.FetchContractData(false, "op", contractMetadataEth.Deployments.Op.Hex())
+	opContractData, err := generator.FetchContractData(ctx, false, "op", contractMetadataEth.Deployments["op"].Hex())
 	if err != nil {
 		return err
 	}
@@ -295,29 +462,24 @@ func (generator *BindGenGeneratorRemote) CompareDeployedBytecodeWithOp(contractM
 	return nil
 }
 
-func (generator *BindGenGeneratorRemote) CompareDeployedBytecodeWithRpc(contractMetadata *RemoteContractMetadata, chain string) error {
-	var client *ethclient.Client
-	switch chain {
-	case "eth":
-		client = generator.RpcClients.Eth
-	case "op":
-		client = generator.RpcClients.Op
-	default:
-		return fmt.Errorf("unknown chain: %s, unable to retrieve a RPC client", chain)
+func (generator *BindGenGeneratorRemote) CompareDeployedBytecodeWithRpc(ctx context.Context, contractMetadata *RemoteContractMetadata, chain string) error {
+	deployment, ok := contractMetadata.Deployments[chain]
+	if !ok {
+		generator.Logger.Warn("Unable to compare bytecode from Etherscan against RPC client, no deployment address provided for chain", "chain", chain)
 	}
+	return generator.compareDeployedBytecodeWithRpcAt(ctx, contractMetadata, chain, deployment)
+}
 
-	var deployment common.Address
-	switch chain {
-	case "eth":
-		deployment = contractMetadata.Deployments.Eth
-	case "op":
-		deployment = contractMetadata.Deployments.Op
-	default:
-		generator.Logger.Warn("Unable to compare bytecode from Etherscan against RPC client, no deployment address provided for chain", "chain", chain)
+// compareDeployedBytecodeWithRpcAt compares the deployed bytecode of the contract against the code at the given
+// address on the given chain. The comparison is skipped for the zero address.
+func (generator *BindGenGeneratorRemote) compareDeployedBytecodeWithRpcAt(ctx context.Context, contractMetadata *RemoteContractMetadata, chain string, deployment common.Address) error {
+	client, ok := generator.RpcClients[chain]
+	if !ok {
+		return fmt.Errorf("unknown chain: %s, unable to retrieve a RPC client", chain)
 	}
 
 	if deployment != (common.Address{}) {
-		bytecode, err := client.CodeAt(context.Background(), common.HexToAddress(deployment.Hex()), nil)
+		bytecode, err := client.CodeAt(ctx, deployment, nil)
 		if err != nil {
 			return fmt.Errorf("error getting deployed bytecode from RPC on chain: %s err: %w", chain, err)
 		}
@@ -331,19 +493,50 @@ func (generator *BindGenGeneratorRemote) CompareDeployedBytecodeWithRpc(contract
 }
 
 func (generator *BindGenGeneratorRemote) writeAllOutputs(contractMetadata *RemoteContractMetadata, fileTemplate string) error {
+	var bytecode []byte
+	if !contractMetadata.AbiOnly {
+		bytecode = []byte(contractMetadata.InitBin)
+	}
 	abiFilePath, bytecodeFilePath, err := writeContractArtifacts(
 		generator.Logger, generator.tempArtifactsDir, contractMetadata.Name,
-		[]byte(contractMetadata.ABI), []byte(contractMetadata.InitBin),
+		[]byte(contractMetadata.ABI), bytecode,
 	)
 	if err != nil {
 		return err
 	}
 
-	err = genContractBindings(generator.Logger, generator.MonorepoBasePath, abiFilePath, bytecodeFilePath, generator.BindingsPackageName, contractMetadata.Name)
+	bindingsOut, err := generator.bindingsOutDir()
+	if err != nil {
+		return err
+	}
+	err = genContractBindings(generator.Logger, generator.MonorepoBasePath, abiFilePath, bytecodeFilePath, bindingsOut, generator.BindingsPackageName, contractMetadata.Name, generator.SpdxLicense)
 	if err != nil {
 		return err
 	}
 
+	if err := writeTsAbi(generator.Logger, generator.TsOut, contractMetadata.Name, []byte(contractMetadata.ABI), generator.SpdxLicense); err != nil {
+		return err
+	}
+
+	if generator.EventTopics {
+		if err := writeEventTopics(generator.Logger, bindingsOut, generator.BindingsPackageName, contractMetadata.Name, []byte(contractMetadata.ABI), generator.SpdxLicense); err != nil {
+			return err
+		}
+	}
+
+	entry := ManifestEntry{Name: contractMetadata.Name, Source: ManifestSourceRemote, Chain: contractMetadata.Chain, Deployments: contractMetadata.Deployments}
+	if address, ok := contractMetadata.Deployments[contractMetadata.Chain]; ok {
+		entry.Address = &address
+	}
+	if err := generator.Manifest.record(entry, []byte(contractMetadata.ABI), contractMetadata.DeployedBin); err != nil {
+		return err
+	}
+	generator.AbiRegistry.record(ManifestSourceRemote, contractMetadata.Name)
+
+	if contractMetadata.AbiOnly {
+		return nil
+	}
+
 	return generator.writeContractMetadata(
 		contractMetadata,
 		template.Must(template.New("RemoteContractMetadata").Parse(fileTemplate)),
@@ -361,23 +554,16 @@ func (generator *BindGenGeneratorRemote) writeContractMetadata(contractMetadata
 		}
 	}
 
-	metadataFile, err := os.OpenFile(
-		metadataFilePath,
-		os.O_RDWR|os.O_CREATE|os.O_TRUNC,
-		0o600,
-	)
-	if err != nil {
-		return fmt.Errorf("error opening %s's metadata file at %s: %w", contractMetadata.Name, metadataFilePath, err)
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, contractMetadata); err != nil {
+		return fmt.Errorf("error generating %s's contract metadata: %w", contractMetadata.Name, err)
 	}
-	defer metadataFile.Close()
-
-	if err := fileTemplate.Execute(metadataFile, contractMetadata); err != nil {
+	if err := writeGoSource(metadataFilePath, buf.Bytes(), generator.SpdxLicense); err != nil {
 		return fmt.Errorf("error writing %s's contract metadata at %s: %w", contractMetadata.Name, metadataFilePath, err)
 	}
 
 	if len(existingOutput) != 0 {
-		var newOutput []byte
-		newOutput, err = os.ReadFile(metadataFilePath)
+		newOutput, err := os.ReadFile(metadataFilePath)
 		if err != nil {
 			return fmt.Errorf("error reading new file: %w", err)
 		}
@@ -0,0 +1,53 @@
+package bindgen
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/etherscan"
+	"golang.org/x/time/rate"
+)
+
+// rateLimitedContractDataClient wraps a ContractDataClient, gating every request by a rate limiter.
+// The limiter may be shared across clients, to stay under a limit that applies to all of them.
+type rateLimitedContractDataClient struct {
+	inner   ContractDataClient
+	limiter *rate.Limiter
+}
+
+var _ ContractDataClient = (*rateLimitedContractDataClient)(nil)
+
+// NewRateLimitedContractDataClient returns a ContractDataClient which waits for the given limiter before every request.
+func NewRateLimitedContractDataClient(inner ContractDataClient, limiter *rate.Limiter) ContractDataClient {
+	return &rateLimitedContractDataClient{
+		inner:   inner,
+		limiter: limiter,
+	}
+}
+
+func (c *rateLimitedContractDataClient) FetchAbi(ctx context.Context, address string) (string, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return "", err
+	}
+	return c.inner.FetchAbi(ctx, address)
+}
+
+func (c *rateLimitedContractDataClient) FetchDeployedBytecode(ctx context.Context, address string) (string, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return "", err
+	}
+	return c.inner.FetchDeployedBytecode(ctx, address)
+}
+
+func (c *rateLimitedContractDataClient) FetchDeploymentTxHash(ctx context.Context, address string) (string, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return "", err
+	}
+	return c.inner.FetchDeploymentTxHash(ctx, address)
+}
+
+func (c *rateLimitedContractDataClient) FetchDeploymentTx(ctx context.Context, txHash string) (etherscan.Transaction, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return etherscan.Transaction{}, err
+	}
+	return c.inner.FetchDeploymentTx(ctx, txHash)
+}
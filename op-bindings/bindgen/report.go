@@ -0,0 +1,132 @@
+package bindgen
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	ReportTypeContract = "contract"
+	ReportTypeSummary  = "summary"
+)
+
+// ReportContract describes a contract whose bindings were generated.
+type ReportContract struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	// Source is where the contract data was sourced from, ManifestSourceLocal or ManifestSourceRemote
+	Source string `json:"source"`
+	// Chain is the chain a remote contract was sourced from
+	Chain string `json:"chain,omitempty"`
+	// BytesWritten is the total size of the files written for the contract
+	BytesWritten int64 `json:"bytesWritten"`
+	// DurationMs is the time it took to generate the contract, including fetching remote contract data
+	DurationMs int64 `json:"durationMs"`
+	// Changed is whether any file written for the contract was created or changed, or is now gone
+	Changed bool `json:"changed"`
+}
+
+// ReportFailure describes a contract that failed to generate.
+type ReportFailure struct {
+	Name   string   `json:"name"`
+	Chains []string `json:"chains,omitempty"`
+	Error  string   `json:"error"`
+}
+
+// ReportSummary describes a whole run, it is written last.
+type ReportSummary struct {
+	Type         string          `json:"type"`
+	Contracts    int             `json:"contracts"`
+	Failed       []ReportFailure `json:"failed,omitempty"`
+	BytesWritten int64           `json:"bytesWritten"`
+	DurationMs   int64           `json:"durationMs"`
+	// Changed are the names of the contracts whose files changed, in the order they were generated
+	Changed []string `json:"changed,omitempty"`
+	// Error is the error the run failed with, if any
+	Error string `json:"error,omitempty"`
+}
+
+// Report writes a JSON object per line for every generated contract as it is generated,
+// followed by a summary of the run, for automation to consume instead of the logs.
+// Like the Manifest, it may be shared by multiple generators.
+type Report struct {
+	mu           sync.Mutex
+	w            io.Writer
+	start        time.Time
+	contracts    int
+	changed      []string
+	bytesWritten int64
+}
+
+func NewReport(w io.Writer) *Report {
+	return &Report{w: w, start: time.Now()}
+}
+
+// record writes the contract to the report, if there is one. The files written for the contract are
+// given by path, missing files are not counted.
+func (r *Report) record(entry ReportContract, duration time.Duration, paths ...string) error {
+	if r == nil {
+		return nil
+	}
+	entry.Type = ReportTypeContract
+	entry.DurationMs = duration.Milliseconds()
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("error reading size of %s: %w", path, err)
+		}
+		entry.BytesWritten += info.Size()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.contracts++
+	if entry.Changed {
+		r.changed = append(r.changed, entry.Name)
+	}
+	r.bytesWritten += entry.BytesWritten
+	return r.write(entry)
+}
+
+// WriteSummary writes the summary of the run, which failed with err if not nil.
+// The failures of individual contracts within err are listed.
+func (r *Report) WriteSummary(err error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	summary := ReportSummary{
+		Type:         ReportTypeSummary,
+		Contracts:    r.contracts,
+		Changed:      r.changed,
+		BytesWritten: r.bytesWritten,
+		DurationMs:   time.Since(r.start).Milliseconds(),
+	}
+	if err != nil {
+		summary.Error = err.Error()
+	}
+	for _, contractErr := range ContractErrors(err) {
+		summary.Failed = append(summary.Failed, ReportFailure{
+			Name:   contractErr.Contract,
+			Chains: contractErr.Chains,
+			Error:  contractErr.Err.Error(),
+		})
+	}
+	return r.write(summary)
+}
+
+func (r *Report) write(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("error marshaling report: %w", err)
+	}
+	if _, err := r.w.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("error writing report: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package bindgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport(t *testing.T) {
+	var disabled *Report
+	require.NoError(t, disabled.record(ReportContract{Name: "L1Block"}, time.Second))
+
+	var out bytes.Buffer
+	report := NewReport(&out)
+	dir := t.TempDir()
+	bindings := filepath.Join(dir, "multicall3.go")
+	metadata := filepath.Join(dir, "multicall3_more.go")
+	require.NoError(t, os.WriteFile(bindings, make([]byte, 100), 0o600))
+	require.NoError(t, os.WriteFile(metadata, make([]byte, 20), 0o600))
+	// missing files are not counted
+	require.NoError(t, report.record(ReportContract{Name: "MultiCall3", Source: ManifestSourceRemote, Chain: "eth"},
+		1500*time.Millisecond, bindings, metadata, filepath.Join(dir, "MultiCall3.ts")))
+	require.NoError(t, report.record(ReportContract{Name: "IERC20", Source: ManifestSourceLocal, Changed: true}, 0, bindings))
+
+	failure := errors.Join(
+		&ContractError{Contract: "Permit2", Chains: []string{"eth", "op"}, Err: errors.New("bytecode mismatch")},
+		&ContractError{Contract: "L2OutputOracle", Err: errors.New("artifact not found")},
+	)
+	require.NoError(t, report.WriteSummary(failure))
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+	require.Equal(t, `{"type":"contract","name":"MultiCall3","source":"remote","chain":"eth","bytesWritten":120,"durationMs":1500,"changed":false}`, lines[0])
+	require.Equal(t, `{"type":"contract","name":"IERC20","source":"local","bytesWritten":100,"durationMs":0,"changed":true}`, lines[1])
+
+	var summary ReportSummary
+	require.NoError(t, json.Unmarshal([]byte(lines[2]), &summary))
+	require.Equal(t, ReportTypeSummary, summary.Type)
+	require.Equal(t, 2, summary.Contracts)
+	require.Equal(t, int64(220), summary.BytesWritten)
+	require.Equal(t, []string{"IERC20"}, summary.Changed)
+	require.Equal(t, failure.Error(), summary.Error)
+	require.Equal(t, []ReportFailure{
+		{Name: "Permit2", Chains: []string{"eth", "op"}, Error: "bytecode mismatch"},
+		{Name: "L2OutputOracle", Error: "artifact not found"},
+	}, summary.Failed)
+}
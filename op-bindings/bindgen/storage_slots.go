@@ -0,0 +1,106 @@
+package bindgen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+)
+
+// storageSlotGetter describes a generated function, which computes the storage slot of a variable in a storage layout.
+type storageSlotGetter struct {
+	// Label is the name of the storage variable in Solidity.
+	Label string
+	// GoName is the name of the variable in the generated function name.
+	GoName string
+	// Slot is the slot the variable is assigned in the storage layout.
+	Slot uint
+	// Offset is the byte offset of the variable within the slot, if it is packed with other variables.
+	Offset uint
+	// Params are the parameters of the generated function, one key per level of nested mappings.
+	Params string
+	// Keys are the expressions encoding each key, from the outer to the inner mapping.
+	Keys []string
+}
+
+// storageSlotGetters returns the functions to generate, computing the storage slot of each variable of the layout,
+// in the order of the layout. For mappings, the slot of the value at the given keys is computed.
+// Variables of mappings with key types that cannot be encoded, or with ambiguous names, are skipped.
+func storageSlotGetters(layout solc.StorageLayout) ([]storageSlotGetter, []string) {
+	var getters []storageSlotGetter
+	var skipped []string
+	goNames := make(map[string]struct{})
+	for _, entry := range layout.Storage {
+		getter := storageSlotGetter{
+			Label:  entry.Label,
+			GoName: immutableGoName(entry.Label),
+			Slot:   entry.Slot,
+			Offset: entry.Offset,
+		}
+		if _, ok := goNames[getter.GoName]; ok || getter.GoName == "" {
+			skipped = append(skipped, entry.Label)
+			continue
+		}
+		keyTypes, ok := mappingKeyTypes(layout, entry.Type)
+		if !ok {
+			skipped = append(skipped, entry.Label)
+			continue
+		}
+		var params []string
+		for i, keyType := range keyTypes {
+			name := "key"
+			if len(keyTypes) > 1 {
+				name = fmt.Sprintf("key%d", i)
+			}
+			goType, encoder, _ := storageKeyGoType(keyType)
+			params = append(params, name+" "+goType)
+			getter.Keys = append(getter.Keys, fmt.Sprintf("%s(%s)", encoder, name))
+		}
+		getter.Params = strings.Join(params, ", ")
+		goNames[getter.GoName] = struct{}{}
+		getters = append(getters, getter)
+	}
+	return getters, skipped
+}
+
+// mappingKeyTypes returns the labels of the key types of the given type, one for each level of nested mappings,
+// or none if the type is not a mapping. It returns false if any of the key types cannot be encoded.
+func mappingKeyTypes(layout solc.StorageLayout, typeID string) ([]string, bool) {
+	var keyTypes []string
+	for {
+		ty, ok := layout.Types[typeID]
+		if !ok || ty.Encoding != "mapping" {
+			return keyTypes, true
+		}
+		keyType, ok := layout.Types[ty.Key]
+		if !ok {
+			return nil, false
+		}
+		if _, _, ok := storageKeyGoType(keyType.Label); !ok {
+			return nil, false
+		}
+		keyTypes = append(keyTypes, keyType.Label)
+		typeID = ty.Value
+	}
+}
+
+// storageKeyGoType maps the label of a solc mapping key type to the Go type of the key,
+// and the name of the encoder of the key in the bindings package.
+func storageKeyGoType(label string) (string, string, bool) {
+	switch {
+	case label == "address", label == "address payable", strings.HasPrefix(label, "contract "):
+		return "common.Address", "storageKeyAddress", true
+	case strings.HasPrefix(label, "uint"), strings.HasPrefix(label, "enum "):
+		return "*big.Int", "storageKeyUint", true
+	case label == "bool":
+		return "bool", "storageKeyBool", true
+	case label == "bytes32":
+		return "common.Hash", "storageKeyBytes32", true
+	case label == "bytes":
+		return "[]byte", "storageKeyBytes", true
+	case label == "string":
+		return "string", "storageKeyString", true
+	default:
+		return "", "", false
+	}
+}
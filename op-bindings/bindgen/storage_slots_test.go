@@ -0,0 +1,77 @@
+package bindgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/format"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+)
+
+// storageSlotsLayout is a condensed storage layout, with the variables of DelayedVetoable and some nested mappings.
+const storageSlotsLayout = `{
+	"storage": [
+		{"label": "_delay", "offset": 0, "slot": "0", "type": "t_uint256"},
+		{"label": "_queuedAt", "offset": 0, "slot": "1", "type": "t_mapping(t_bytes32,t_uint256)"},
+		{"label": "initialized", "offset": 20, "slot": "2", "type": "t_bool"},
+		{"label": "allowance", "offset": 0, "slot": "3", "type": "t_mapping(t_address,t_mapping(t_address,t_uint256))"},
+		{"label": "gameImpls", "offset": 0, "slot": "4", "type": "t_mapping(t_userDefinedValueType(GameType)1011,t_address)"},
+		{"label": "delay", "offset": 0, "slot": "5", "type": "t_uint256"}
+	],
+	"types": {
+		"t_address": {"encoding": "inplace", "label": "address", "numberOfBytes": "20"},
+		"t_bool": {"encoding": "inplace", "label": "bool", "numberOfBytes": "1"},
+		"t_bytes32": {"encoding": "inplace", "label": "bytes32", "numberOfBytes": "32"},
+		"t_uint256": {"encoding": "inplace", "label": "uint256", "numberOfBytes": "32"},
+		"t_userDefinedValueType(GameType)1011": {"encoding": "inplace", "label": "GameType", "numberOfBytes": "4"},
+		"t_mapping(t_bytes32,t_uint256)": {"encoding": "mapping", "label": "mapping(bytes32 => uint256)", "numberOfBytes": "32", "key": "t_bytes32", "value": "t_uint256"},
+		"t_mapping(t_address,t_uint256)": {"encoding": "mapping", "label": "mapping(address => uint256)", "numberOfBytes": "32", "key": "t_address", "value": "t_uint256"},
+		"t_mapping(t_address,t_mapping(t_address,t_uint256))": {"encoding": "mapping", "label": "mapping(address => mapping(address => uint256))", "numberOfBytes": "32", "key": "t_address", "value": "t_mapping(t_address,t_uint256)"},
+		"t_mapping(t_userDefinedValueType(GameType)1011,t_address)": {"encoding": "mapping", "label": "mapping(GameType => address)", "numberOfBytes": "32", "key": "t_userDefinedValueType(GameType)1011", "value": "t_address"}
+	}
+}`
+
+func TestStorageSlotGetters(t *testing.T) {
+	var layout solc.StorageLayout
+	require.NoError(t, json.Unmarshal([]byte(storageSlotsLayout), &layout))
+
+	slots, skipped := storageSlotGetters(layout)
+	// the GameType key cannot be encoded, and delay is ambiguous with _delay
+	require.Equal(t, []string{"gameImpls", "delay"}, skipped)
+	require.Equal(t, []storageSlotGetter{
+		{Label: "_delay", GoName: "Delay", Slot: 0},
+		{Label: "_queuedAt", GoName: "QueuedAt", Slot: 1, Params: "key common.Hash", Keys: []string{"storageKeyBytes32(key)"}},
+		{Label: "initialized", GoName: "Initialized", Slot: 2, Offset: 20},
+		{Label: "allowance", GoName: "Allowance", Slot: 3, Params: "key0 common.Address, key1 common.Address",
+			Keys: []string{"storageKeyAddress(key0)", "storageKeyAddress(key1)"}},
+	}, slots)
+
+	stdImports, imports := metadataImports(nil, slots)
+	require.Empty(t, stdImports)
+	require.Equal(t, []string{"github.com/ethereum/go-ethereum/common"}, imports)
+
+	tmpl := template.Must(template.New("localContractMetadata").Parse(localContractMetadataTemplate))
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, localContractMetadata{
+		Name:          "DelayedVetoable",
+		StorageLayout: `{\"storage\":[],\"types\":{}}`,
+		DeployedBin:   "0x",
+		Package:       "bindings",
+		StorageSlots:  slots,
+		StdImports:    stdImports,
+		Imports:       imports,
+	}))
+	_, err := format.Source(buf.Bytes())
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), "func DelayedVetoableQueuedAtSlot(key common.Hash) common.Hash {\n"+
+		"\tslot := storageSlot(1)\n"+
+		"\tslot = mappingSlot(storageKeyBytes32(key), slot)\n"+
+		"\treturn slot\n}")
+	require.Contains(t, buf.String(), "// DelayedVetoableAllowanceSlot returns the storage slot of the value at the given keys of allowance.")
+	require.Contains(t, buf.String(), "// DelayedVetoableInitializedSlot returns the storage slot of initialized, which is stored at byte offset 20 of the slot.")
+	require.Contains(t, buf.String(), "func DelayedVetoableDelaySlot() common.Hash {")
+}
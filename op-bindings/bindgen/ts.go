@@ -0,0 +1,53 @@
+package bindgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// writeTsAbi writes the ABI of a contract to a TypeScript file in tsOut, exporting it as a
+// `const` asserted `as const`, so TypeScript consumers get the same ABI as the Go bindings.
+// It is a no-op if tsOut is empty.
+//
+// Parameters:
+// - logger: An instance of go-ethereum/log
+// - tsOut: The directory to write the TypeScript file to.
+// - contractName: The name of the contract, used for the file name and the exported constant.
+// - abi: The JSON ABI of the contract.
+// - spdxLicense: An optional SPDX license identifier to inject at the top of the file.
+//
+// Returns:
+// - An error if the ABI is malformed or writing the file fails, nil otherwise.
+func writeTsAbi(logger log.Logger, tsOut, contractName string, abi []byte, spdxLicense string) error {
+	if tsOut == "" {
+		return nil
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, bytes.TrimSpace(abi), "", "  "); err != nil {
+		return fmt.Errorf("error formatting %s's ABI for TypeScript: %w", contractName, err)
+	}
+
+	var out bytes.Buffer
+	if spdxLicense != "" {
+		fmt.Fprintf(&out, "// SPDX-License-Identifier: %s\n\n", spdxLicense)
+	}
+	out.WriteString("// Code generated - DO NOT EDIT.\n// This file is a generated binding and any manual changes will be lost.\n\n")
+	fmt.Fprintf(&out, "export const %sAbi = %s as const;\n", contractName, indented.Bytes())
+
+	if err := os.MkdirAll(tsOut, 0o755); err != nil {
+		return fmt.Errorf("error creating TypeScript output directory %s: %w", tsOut, err)
+	}
+	tsFilePath := filepath.Join(tsOut, contractName+".ts")
+	if err := writeFileIfChanged(tsFilePath, out.Bytes()); err != nil {
+		return fmt.Errorf("error writing %s's TypeScript ABI at %s: %w", contractName, tsFilePath, err)
+	}
+
+	logger.Debug("Successfully wrote TypeScript ABI", "contract", contractName, "path", tsFilePath)
+	return nil
+}
@@ -0,0 +1,52 @@
+package bindgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteTsAbi(t *testing.T) {
+	logger := log.NewLogger(log.DiscardHandler())
+	abi := []byte(`[{"type":"function","name":"number","inputs":[],"outputs":[{"name":"","type":"uint64"}],"stateMutability":"view"}]`)
+
+	t.Run("NoOutputDir", func(t *testing.T) {
+		require.NoError(t, writeTsAbi(logger, "", "L1Block", abi, ""))
+	})
+
+	t.Run("WritesTypedConst", func(t *testing.T) {
+		tsOut := filepath.Join(t.TempDir(), "ts")
+		require.NoError(t, writeTsAbi(logger, tsOut, "L1Block", abi, "MIT"))
+
+		content, err := os.ReadFile(filepath.Join(tsOut, "L1Block.ts"))
+		require.NoError(t, err)
+		require.Equal(t, `// SPDX-License-Identifier: MIT
+
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+export const L1BlockAbi = [
+  {
+    "type": "function",
+    "name": "number",
+    "inputs": [],
+    "outputs": [
+      {
+        "name": "",
+        "type": "uint64"
+      }
+    ],
+    "stateMutability": "view"
+  }
+] as const;
+`, string(content))
+	})
+
+	t.Run("MalformedAbi", func(t *testing.T) {
+		err := writeTsAbi(logger, t.TempDir(), "L1Block", []byte(`[{`), "")
+		require.ErrorContains(t, err, "error formatting L1Block's ABI for TypeScript")
+	})
+}
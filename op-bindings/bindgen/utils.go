@@ -4,14 +4,24 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"os/exec"
 	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
+	"golang.org/x/tools/imports"
 )
 
 type BindGenGeneratorBase struct {
@@ -19,37 +29,310 @@ type BindGenGeneratorBase struct {
 	BindingsPackageName string
 	MonorepoBasePath    string
 	ContractsListPath   string
-	Logger              log.Logger
+	SpdxLicense         string
+	// BindingsOut optionally overrides the directory the Go bindings are written to,
+	// which defaults to BindingsPackageName in the working directory
+	BindingsOut string
+	// TsOut optionally specifies a directory to write the ABI of every contract to as TypeScript
+	TsOut string
+	// EventTopics enables generating a variable holding the topic0 of each event of every contract
+	EventTopics bool
+	// Only optionally restricts the generated contracts to those whose name matches any of these glob patterns
+	Only []string
+	// Skip excludes the contracts whose name matches any of these glob patterns
+	Skip []string
+	// MetadataOnly only writes the metadata files of contracts, i.e. their storage layout, deployed bytecode and
+	// immutable references, leaving their bindings, event topics and TypeScript ABIs untouched.
+	// It is only supported by the local generator.
+	MetadataOnly bool
+	// ContinueOnError keeps generating the remaining contracts when one fails,
+	// returning the failures of all contracts together at the end
+	ContinueOnError bool
+	// Manifest optionally records every generated binding, to be written once all generators ran
+	Manifest *Manifest
+	// AbiRegistry optionally records every generated binding, to register their ABIs once all generators ran
+	AbiRegistry *AbiRegistry
+	// Report optionally reports every generated binding as JSON
+	Report *Report
+	// Changes optionally records the contracts whose outputs changed
+	Changes *Changes
+	Logger  log.Logger
+}
+
+// bindingsOutDir returns the directory the Go bindings are written to.
+func (generator *BindGenGeneratorBase) bindingsOutDir() (string, error) {
+	if generator.BindingsOut != "" {
+		return generator.BindingsOut, nil
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("error getting cwd: %w", err)
+	}
+	return path.Join(cwd, generator.BindingsPackageName), nil
+}
+
+// outputPaths returns the paths of the files written for a contract, including its metadata if written.
+func (generator *BindGenGeneratorBase) outputPaths(contractName string, withMetadata bool) ([]string, error) {
+	bindingsOut, err := generator.bindingsOutDir()
+	if err != nil {
+		return nil, err
+	}
+	metadataPath := path.Join(generator.MetadataOut, strings.ToLower(contractName)+"_more.go")
+	if generator.MetadataOnly {
+		if !withMetadata {
+			return nil, nil
+		}
+		return []string{metadataPath}, nil
+	}
+	paths := []string{path.Join(bindingsOut, strings.ToLower(contractName)+".go")}
+	if withMetadata {
+		paths = append(paths, metadataPath)
+	}
+	if generator.EventTopics {
+		paths = append(paths, path.Join(bindingsOut, eventTopicsFileName(contractName)))
+	}
+	if generator.TsOut != "" {
+		paths = append(paths, path.Join(generator.TsOut, contractName+".ts"))
+	}
+	return paths, nil
+}
+
+// reportContract records whether a generated contract changed, comparing its outputs with the content hashes
+// taken with hashOutputs before it was generated, and reports it if there is a report.
+func (generator *BindGenGeneratorBase) reportContract(entry ReportContract, withMetadata bool, before map[string]common.Hash, duration time.Duration) error {
+	changed, err := generator.outputsChanged(entry.Name, withMetadata, before)
+	if err != nil {
+		return err
+	}
+	if changed {
+		generator.Changes.record(entry.Name)
+	}
+	if generator.Report == nil {
+		return nil
+	}
+	paths, err := generator.outputPaths(entry.Name, withMetadata)
+	if err != nil {
+		return err
+	}
+	entry.Changed = changed
+	return generator.Report.record(entry, duration, paths...)
 }
 
 type contractsList struct {
-	Local  []string         `json:"local"`
-	Remote []RemoteContract `json:"remote"`
+	Local   []LocalContract  `json:"local"`
+	Remote  []RemoteContract `json:"remote"`
+	Proxies []ProxyContract  `json:"proxies"`
 }
 
-// readContractList reads a JSON file from the given `filePath` and unmarshals
-// its content into the provided result interface. It logs the path of the file
-// being read.
+// ContractsListStdin is the contracts list path to read the contracts list from stdin.
+const ContractsListStdin = "-"
+
+// readStdin reads stdin once, so the contracts list piped in can be read by every generator of a run.
+var readStdin = sync.OnceValues(func() ([]byte, error) {
+	return io.ReadAll(os.Stdin)
+})
+
+// readContractList reads the contracts list at `listPath` and unmarshals it.
+// The path is either a JSON file, ContractsListStdin to read the list from stdin,
+// or a directory or glob pattern matching multiple JSON files, which are merged.
 //
 // Parameters:
 // - logger: An instance of go-ethereum/log
-// - filePath: The path to the JSON file to be read.
-// - result: A pointer to the structure where the JSON data will be unmarshaled.
+// - listPath: The path of the contracts list.
 //
 // Returns:
-// - An error if reading the file or unmarshaling fails, nil otherwise.
-func readContractList(logger log.Logger, filePath string) (contractsList, error) {
+// - The contracts list, or an error if reading, unmarshaling or merging fails.
+func readContractList(logger log.Logger, listPath string) (contractsList, error) {
+	if listPath == ContractsListStdin {
+		logger.Debug("Reading contract list from stdin")
+		contractData, err := readStdin()
+		if err != nil {
+			return contractsList{}, fmt.Errorf("error reading stdin: %w", err)
+		}
+		return parseContractList(contractData)
+	}
+
+	filePaths, err := contractListFiles(listPath)
+	if err != nil {
+		return contractsList{}, err
+	}
+	if len(filePaths) == 1 {
+		return readContractListFile(logger, filePaths[0])
+	}
+
+	var merged contractsList
+	localFiles := make(map[string]string)
+	remoteFiles := make(map[string]string)
+	proxyFiles := make(map[string]string)
+	for _, filePath := range filePaths {
+		contracts, err := readContractListFile(logger, filePath)
+		if err != nil {
+			return contractsList{}, fmt.Errorf("%s: %w", filePath, err)
+		}
+		if merged.Local, err = mergeContracts(merged.Local, contracts.Local, localFiles, filePath, func(c LocalContract) string { return c.Name }); err != nil {
+			return contractsList{}, err
+		}
+		if merged.Remote, err = mergeContracts(merged.Remote, contracts.Remote, remoteFiles, filePath, func(c RemoteContract) string { return c.Name }); err != nil {
+			return contractsList{}, err
+		}
+		if merged.Proxies, err = mergeContracts(merged.Proxies, contracts.Proxies, proxyFiles, filePath, func(c ProxyContract) string { return c.Name }); err != nil {
+			return contractsList{}, err
+		}
+	}
+	return merged, nil
+}
+
+func readContractListFile(logger log.Logger, filePath string) (contractsList, error) {
 	logger.Debug("Reading contract list", "filePath", filePath)
 
-	var contracts contractsList
 	contractData, err := os.ReadFile(filePath)
 	if err != nil {
-		return contracts, err
+		return contractsList{}, err
 	}
+	return parseContractList(contractData)
+}
 
+func parseContractList(contractData []byte) (contractsList, error) {
+	var contracts contractsList
 	return contracts, json.Unmarshal(contractData, &contracts)
 }
 
+// contractListFiles returns the contracts list files at listPath, sorted by path: the JSON files in the directory
+// or matching the glob pattern, or listPath itself if it is a plain path.
+func contractListFiles(listPath string) ([]string, error) {
+	pattern := listPath
+	if info, err := os.Stat(listPath); err == nil {
+		if !info.IsDir() {
+			return []string{listPath}, nil
+		}
+		pattern = filepath.Join(listPath, "*.json")
+	} else if !strings.ContainsAny(listPath, "*?[") {
+		return []string{listPath}, nil
+	}
+
+	filePaths, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contracts list pattern %s: %w", pattern, err)
+	}
+	if len(filePaths) == 0 {
+		return nil, fmt.Errorf("no contracts lists match %s", pattern)
+	}
+	sort.Strings(filePaths)
+	return filePaths, nil
+}
+
+// mergeContracts appends the contracts read from filePath to merged, deduplicating them by name.
+// Contracts defined more than once must be defined the same way. files tracks the file each contract was read from.
+func mergeContracts[T any](merged []T, contracts []T, files map[string]string, filePath string, name func(T) string) ([]T, error) {
+	for _, contract := range contracts {
+		existingFile, ok := files[name(contract)]
+		if !ok {
+			files[name(contract)] = filePath
+			merged = append(merged, contract)
+			continue
+		}
+		for _, existing := range merged {
+			if name(existing) == name(contract) && !reflect.DeepEqual(existing, contract) {
+				return nil, fmt.Errorf("contract %s is defined differently in %s and %s", name(contract), existingFile, filePath)
+			}
+		}
+	}
+	return merged, nil
+}
+
+// matchesAny reports whether name matches any of the given glob patterns.
+func matchesAny(patterns []string, name string) (bool, error) {
+	for _, pattern := range patterns {
+		matched, err := path.Match(pattern, name)
+		if err != nil {
+			return false, fmt.Errorf("invalid contract name pattern %q: %w", pattern, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isSelected reports whether the contract with the given name is selected by the Only and Skip patterns
+// of the generator. All contracts are selected if there are no Only patterns.
+func (generator *BindGenGeneratorBase) isSelected(name string) (bool, error) {
+	if len(generator.Only) != 0 {
+		if only, err := matchesAny(generator.Only, name); err != nil || !only {
+			return false, err
+		}
+	}
+	skip, err := matchesAny(generator.Skip, name)
+	return !skip, err
+}
+
+// NameCollisions returns the names of the selected contracts that are both local, or proxies, and remote,
+// sorted by name. Their bindings are written to the same files, so the remote bindings overwrite the local
+// ones when both are generated.
+func (generator *BindGenGeneratorBase) NameCollisions() ([]string, error) {
+	contracts, err := readContractList(generator.Logger, generator.ContractsListPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading contract list %s: %w", generator.ContractsListPath, err)
+	}
+	if contracts, err = generator.filterContracts(contracts); err != nil {
+		return nil, err
+	}
+	local := make(map[string]struct{}, len(contracts.Local)+len(contracts.Proxies))
+	for _, contract := range contracts.Local {
+		local[contract.Name] = struct{}{}
+	}
+	for _, proxy := range contracts.Proxies {
+		local[proxy.Name] = struct{}{}
+	}
+	var collisions []string
+	for _, contract := range contracts.Remote {
+		if _, ok := local[contract.Name]; ok {
+			collisions = append(collisions, contract.Name)
+		}
+	}
+	sort.Strings(collisions)
+	return collisions, nil
+}
+
+// filterContracts returns the contracts of the list which are selected by the Only and Skip patterns.
+// The output of contracts which are not selected is left untouched.
+func (generator *BindGenGeneratorBase) filterContracts(contracts contractsList) (contractsList, error) {
+	if len(generator.Only) == 0 && len(generator.Skip) == 0 {
+		return contracts, nil
+	}
+	var filtered contractsList
+	var skipped []string
+	for _, contract := range contracts.Local {
+		if ok, err := generator.isSelected(contract.Name); err != nil {
+			return contractsList{}, err
+		} else if ok {
+			filtered.Local = append(filtered.Local, contract)
+		} else {
+			skipped = append(skipped, contract.Name)
+		}
+	}
+	for _, contract := range contracts.Remote {
+		if ok, err := generator.isSelected(contract.Name); err != nil {
+			return contractsList{}, err
+		} else if ok {
+			filtered.Remote = append(filtered.Remote, contract)
+		} else {
+			skipped = append(skipped, contract.Name)
+		}
+	}
+	for _, proxy := range contracts.Proxies {
+		if ok, err := generator.isSelected(proxy.Name); err != nil {
+			return contractsList{}, err
+		} else if ok {
+			filtered.Proxies = append(filtered.Proxies, proxy)
+		} else {
+			skipped = append(skipped, proxy.Name)
+		}
+	}
+	generator.Logger.Debug("Filtered contracts list", "only", generator.Only, "skip", generator.Skip, "skipped", skipped)
+	return filtered, nil
+}
+
 // mkTempArtifactsDir creates a temporary directory with a "op-bindings" prefix
 // for holding contract artifacts. The path to the created directory is logged.
 //
@@ -80,11 +363,11 @@ func mkTempArtifactsDir(logger log.Logger) (string, error) {
 // - tempDirPath: The directory path where the ABI and bytecode files will be written.
 // - contractName: The name of the contract, used to create the filenames.
 // - abi: The ABI data of the contract.
-// - bytecode: The bytecode of the contract.
+// - bytecode: The bytecode of the contract, nil for ABI-only contracts.
 //
 // Returns:
 // - The full path to the written ABI file.
-// - The full path to the written bytecode file.
+// - The full path to the written bytecode file, empty if no bytecode is given.
 // - An error if writing either file fails, nil otherwise.
 func writeContractArtifacts(logger log.Logger, tempDirPath, contractName string, abi, bytecode []byte) (string, string, error) {
 	logger.Debug("Writing ABI and bytecode to temporary artifacts directory", "contractName", contractName, "tempDirPath", tempDirPath)
@@ -94,6 +377,10 @@ func writeContractArtifacts(logger log.Logger, tempDirPath, contractName string,
 		return "", "", fmt.Errorf("error writing %s's ABI file: %w", contractName, err)
 	}
 
+	if bytecode == nil {
+		return abiFilePath, "", nil
+	}
+
 	bytecodeFilePath := path.Join(tempDirPath, contractName+".bin")
 	if err := os.WriteFile(bytecodeFilePath, bytecode, 0o600); err != nil {
 		return "", "", fmt.Errorf("error writing %s's bytecode file: %w", contractName, err)
@@ -104,7 +391,7 @@ func writeContractArtifacts(logger log.Logger, tempDirPath, contractName string,
 
 // genContractBindings generates Go bindings for an Ethereum contract using
 // the provided ABI and bytecode files. The bindings are generated using the
-// `abigen` tool and are written to the specified Go package directory. The
+// `abigen` tool and are written to the specified output directory. The
 // generated file's name is based on the provided contract name and will have
 // a ".go" extension. The generated bindings will be part of the provided Go
 // package.
@@ -112,10 +399,14 @@ func writeContractArtifacts(logger log.Logger, tempDirPath, contractName string,
 // Parameters:
 // - logger: An instance of go-ethereum/log
 // - abiFilePath: The path to the ABI file for the contract.
-// - bytecodeFilePath: The path to the bytecode file for the contract.
-// - goPackageName: The name of the Go package where the bindings will be written.
+// - bytecodeFilePath: The path to the bytecode file for the contract, empty for
+// ABI-only bindings, which can not deploy the contract.
+// - outDir: The directory the bindings will be written to.
+// - goPackageName: The name of the Go package of the generated bindings.
 // - contractName: The name of the contract, used for naming the output file and
 // defining the type in the generated bindings.
+// - spdxLicense: An optional SPDX license identifier to inject at the top of the
+// generated file.
 //
 // Returns:
 // - An error if there's an issue during any step of the binding generation process,
@@ -123,13 +414,8 @@ func writeContractArtifacts(logger log.Logger, tempDirPath, contractName string,
 //
 // Note: This function relies on the external `abigen` tool, which should be
 // installed and available in the system's PATH.
-func genContractBindings(logger log.Logger, monorepoRootPath, abiFilePath, bytecodeFilePath, goPackageName, contractName string) error {
-	cwd, err := os.Getwd()
-	if err != nil {
-		return fmt.Errorf("error getting cwd: %w", err)
-	}
-
-	outFilePath := path.Join(cwd, goPackageName, strings.ToLower(contractName)+".go")
+func genContractBindings(logger log.Logger, monorepoRootPath, abiFilePath, bytecodeFilePath, outDir, goPackageName, contractName, spdxLicense string) error {
+	outFilePath := path.Join(outDir, strings.ToLower(contractName)+".go")
 
 	var existingOutput []byte
 	if _, err := os.Stat(outFilePath); err == nil {
@@ -165,12 +451,27 @@ func genContractBindings(logger log.Logger, monorepoRootPath, abiFilePath, bytec
 	}
 
 	logger.Debug("Generating contract bindings", "contractName", contractName, "outFilePath", outFilePath)
-	cmd := exec.Command("abigen", "--abi", abiFilePath, "--bin", bytecodeFilePath, "--pkg", goPackageName, "--type", contractName, "--out", outFilePath)
+	// abigen writes next to the ABI in the temporary artifacts directory, so the bindings are only rewritten if they changed
+	abigenOutFilePath := path.Join(path.Dir(abiFilePath), strings.ToLower(contractName)+".go")
+	args := []string{"--abi", abiFilePath, "--pkg", goPackageName, "--type", contractName, "--out", abigenOutFilePath}
+	if bytecodeFilePath != "" {
+		args = append(args, "--bin", bytecodeFilePath)
+	}
+	cmd := exec.Command("abigen", args...)
 	cmd.Stdout = os.Stdout
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("error running abigen for %s: %w", contractName, err)
 	}
 
+	// abigen formats the bindings with the go/format of the Go version it was built with, so they are formatted again
+	generated, err := os.ReadFile(abigenOutFilePath)
+	if err != nil {
+		return fmt.Errorf("error reading generated bindings: %w", err)
+	}
+	if err := writeGoSource(outFilePath, generated, spdxLicense); err != nil {
+		return fmt.Errorf("error writing generated bindings to %s: %w", outFilePath, err)
+	}
+
 	if len(existingOutput) != 0 {
 		newOutput, err := os.ReadFile(outFilePath)
 		if err != nil {
@@ -189,6 +490,30 @@ func genContractBindings(logger log.Logger, monorepoRootPath, abiFilePath, bytec
 	return nil
 }
 
+// prependSpdxHeader prefixes the given Go source with an SPDX license identifier
+// comment, separated from the generated-code header by an empty line so that the
+// header remains recognized by Go tooling. If the source already starts with an
+// SPDX license identifier, it is replaced rather than duplicated.
+//
+// Parameters:
+// - src: The Go source to prefix.
+// - spdxLicense: The SPDX license identifier, e.g. "MIT".
+//
+// Returns:
+// - The Go source, starting with the SPDX license identifier comment.
+func prependSpdxHeader(src []byte, spdxLicense string) []byte {
+	const spdxPrefix = "// SPDX-License-Identifier:"
+	if bytes.HasPrefix(src, []byte(spdxPrefix)) {
+		if i := bytes.IndexByte(src, '\n'); i >= 0 {
+			src = bytes.TrimLeft(src[i+1:], "\n")
+		} else {
+			src = nil
+		}
+	}
+	header := fmt.Sprintf("%s %s\n\n", spdxPrefix, spdxLicense)
+	return append([]byte(header), src...)
+}
+
 // Versions is a struct for holding the versions of the tools used in the monorepo
 type Versions struct {
 	Abigen  string `json:"abigen"`
@@ -227,3 +552,70 @@ func readExpectedAbigenVersion(monorepoRootPath string) (string, error) {
 	// Trim the 'v' prefix from the version string
 	return strings.Trim(versions.Abigen, "v"), nil
 }
+
+// formatGoSource formats generated Go source like gofmt, and sorts and groups its imports like goimports,
+// so generated files come out the same regardless of the Go version and tools of the generating machine.
+// Imports are only formatted, not added or removed, which would depend on the packages available to the machine.
+func formatGoSource(src []byte) ([]byte, error) {
+	return imports.Process("", src, &imports.Options{
+		Comments:   true,
+		TabIndent:  true,
+		TabWidth:   8,
+		FormatOnly: true,
+	})
+}
+
+// writeGoSource formats the given generated Go source with formatGoSource, and writes it to path,
+// prefixed with the SPDX license identifier if one is given.
+func writeGoSource(path string, src []byte, spdxLicense string) error {
+	formatted, err := formatGoSource(src)
+	if err != nil {
+		return fmt.Errorf("error formatting %s: %w", path, err)
+	}
+	if spdxLicense != "" {
+		formatted = prependSpdxHeader(formatted, spdxLicense)
+	}
+	return writeFileIfChanged(path, formatted)
+}
+
+// writeFileIfChanged writes data to path, unless the file already holds exactly data, in which case it is left
+// untouched, so reruns without changes neither update its modification time nor show up in version control.
+func writeFileIfChanged(path string, data []byte) error {
+	existing, err := os.ReadFile(path)
+	if err == nil && bytes.Equal(existing, data) {
+		return nil
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error reading existing %s: %w", path, err)
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// hashOutputs returns the content hashes of the output files of a contract, by path, to compare with outputsChanged
+// once the contract is generated. Missing files are omitted.
+func (generator *BindGenGeneratorBase) hashOutputs(contractName string, withMetadata bool) (map[string]common.Hash, error) {
+	paths, err := generator.outputPaths(contractName, withMetadata)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make(map[string]common.Hash, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("error hashing %s: %w", path, err)
+		}
+		hashes[path] = crypto.Keccak256Hash(data)
+	}
+	return hashes, nil
+}
+
+// outputsChanged returns whether any output file of a contract was created, removed or changed
+// since its content hashes were taken with hashOutputs.
+func (generator *BindGenGeneratorBase) outputsChanged(contractName string, withMetadata bool, before map[string]common.Hash) (bool, error) {
+	after, err := generator.hashOutputs(contractName, withMetadata)
+	if err != nil {
+		return false, err
+	}
+	return !maps.Equal(before, after), nil
+}
@@ -2,11 +2,17 @@ package bindgen
 
 import (
 	"encoding/json"
+	"go/format"
 	"os"
 	"path"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
 )
 
 func TestReadExpectedAbigenVersion(t *testing.T) {
@@ -32,3 +38,258 @@ func TestReadExpectedAbigenVersion(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedVersion, "1.2.3")
 }
+
+func TestPrependSpdxHeader(t *testing.T) {
+	src := []byte("// Code generated - DO NOT EDIT.\n// This file is a generated binding and any manual changes will be lost.\n\npackage bindings\n\nvar x = 1\n")
+
+	withHeader := prependSpdxHeader(src, "MIT")
+	formatted, err := format.Source(withHeader)
+	require.NoError(t, err)
+
+	for _, out := range [][]byte{withHeader, formatted} {
+		lines := strings.Split(string(out), "\n")
+		require.Equal(t, "// SPDX-License-Identifier: MIT", lines[0])
+		require.Equal(t, "", lines[1])
+		require.Equal(t, "// Code generated - DO NOT EDIT.", lines[2])
+		require.Equal(t, 1, strings.Count(string(out), "SPDX-License-Identifier"))
+	}
+
+	// Re-applying replaces the existing identifier rather than adding another one
+	relicensed := prependSpdxHeader(withHeader, "Apache-2.0")
+	require.True(t, strings.HasPrefix(string(relicensed), "// SPDX-License-Identifier: Apache-2.0\n\n// Code generated"))
+	require.Equal(t, 1, strings.Count(string(relicensed), "SPDX-License-Identifier"))
+}
+
+func TestWriteGoSource(t *testing.T) {
+	src := []byte(`// Code generated - DO NOT EDIT.
+
+package bindings
+
+import (
+	"strings"
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+)
+
+var StorageLayout = "{}"
+func init() {
+	_, _, _ = strings.ToLower, common.Big1, big.NewInt
+
+
+}
+
+`)
+	expected := `// SPDX-License-Identifier: MIT
+
+// Code generated - DO NOT EDIT.
+
+package bindings
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var StorageLayout = "{}"
+
+func init() {
+	_, _, _ = strings.ToLower, common.Big1, big.NewInt
+
+}
+`
+	outPath := path.Join(t.TempDir(), "storage_more.go")
+	require.NoError(t, writeGoSource(outPath, src, "MIT"))
+	out, err := os.ReadFile(outPath)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(out))
+
+	// formatting is idempotent, so regenerating formatted files does not change them
+	formatted, err := formatGoSource(out)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(formatted))
+
+	require.ErrorContains(t, writeGoSource(outPath, []byte("package bindings\nfunc {"), ""), "error formatting")
+}
+
+func TestWriteFileIfChanged(t *testing.T) {
+	outPath := path.Join(t.TempDir(), "l1block.go")
+	require.NoError(t, writeFileIfChanged(outPath, []byte("package bindings\n")))
+	past := time.Now().Add(-time.Hour).Truncate(time.Second)
+	require.NoError(t, os.Chtimes(outPath, past, past))
+
+	// identical content is not rewritten
+	require.NoError(t, writeFileIfChanged(outPath, []byte("package bindings\n")))
+	info, err := os.Stat(outPath)
+	require.NoError(t, err)
+	require.Equal(t, past, info.ModTime())
+
+	require.NoError(t, writeFileIfChanged(outPath, []byte("package bindings2\n")))
+	info, err = os.Stat(outPath)
+	require.NoError(t, err)
+	require.NotEqual(t, past, info.ModTime())
+	out, err := os.ReadFile(outPath)
+	require.NoError(t, err)
+	require.Equal(t, "package bindings2\n", string(out))
+}
+
+func TestReportContractChanges(t *testing.T) {
+	dir := t.TempDir()
+	generator := BindGenGeneratorBase{BindingsOut: dir, MetadataOut: dir, Changes: NewChanges()}
+	write := func(name, content string) {
+		require.NoError(t, writeFileIfChanged(path.Join(dir, name), []byte(content)))
+	}
+	generate := func(contractName string, withMetadata bool, outputs map[string]string) {
+		before, err := generator.hashOutputs(contractName, withMetadata)
+		require.NoError(t, err)
+		for name, content := range outputs {
+			write(name, content)
+		}
+		require.NoError(t, generator.reportContract(ReportContract{Name: contractName}, withMetadata, before, 0))
+	}
+
+	generate("L1Block", true, map[string]string{"l1block.go": "bindings", "l1block_more.go": "metadata"})
+	generate("L2ToL1MessagePasser", false, map[string]string{"l2tol1messagepasser.go": "bindings"})
+	require.Equal(t, []string{"L1Block", "L2ToL1MessagePasser"}, generator.Changes.Contracts())
+
+	// rerunning with the same outputs changes nothing
+	generator.Changes = NewChanges()
+	generate("L1Block", true, map[string]string{"l1block.go": "bindings", "l1block_more.go": "metadata"})
+	generate("L2ToL1MessagePasser", false, map[string]string{"l2tol1messagepasser.go": "bindings"})
+	require.Empty(t, generator.Changes.Contracts())
+
+	// a single changed output changes the contract
+	generate("L1Block", true, map[string]string{"l1block.go": "bindings", "l1block_more.go": "new metadata"})
+	generate("L2ToL1MessagePasser", false, map[string]string{"l2tol1messagepasser.go": "bindings"})
+	require.Equal(t, []string{"L1Block"}, generator.Changes.Contracts())
+
+	// changes are optional
+	generator.Changes = nil
+	generate("L1Block", true, map[string]string{"l1block.go": "new bindings"})
+}
+
+func TestReadContractListAbiOnly(t *testing.T) {
+	listPath := path.Join(t.TempDir(), "artifacts.json")
+	require.NoError(t, os.WriteFile(listPath, []byte(`{
+		"local": ["L1Block", {"name": "IERC20", "abiOnly": true}],
+		"remote": [{"name": "WETH", "chain": "eth", "abiOnly": true}]
+	}`), 0o600))
+
+	contracts, err := readContractList(testlog.Logger(t, log.LevelDebug), listPath)
+	require.NoError(t, err)
+	require.Equal(t, []LocalContract{{Name: "L1Block"}, {Name: "IERC20", AbiOnly: true}}, contracts.Local)
+	require.True(t, contracts.Remote[0].AbiOnly)
+
+	require.NoError(t, os.WriteFile(listPath, []byte(`{"local": [{"abiOnly": true}]}`), 0o600))
+	_, err = readContractList(testlog.Logger(t, log.LevelDebug), listPath)
+	require.ErrorContains(t, err, "local contract is missing a name")
+}
+
+func TestReadContractListStdin(t *testing.T) {
+	original := readStdin
+	defer func() { readStdin = original }()
+	readStdin = func() ([]byte, error) { return []byte(`{"local": ["L1Block"]}`), nil }
+
+	contracts, err := readContractList(testlog.Logger(t, log.LevelDebug), ContractsListStdin)
+	require.NoError(t, err)
+	require.Equal(t, []LocalContract{{Name: "L1Block"}}, contracts.Local)
+}
+
+func TestReadContractListMerged(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(dir, "a.json"), []byte(`{
+		"local": ["L1Block", "L2OutputOracle"],
+		"remote": [{"name": "WETH", "chain": "eth", "abiOnly": true}]
+	}`), 0o600))
+	require.NoError(t, os.WriteFile(path.Join(dir, "b.json"), []byte(`{
+		"local": ["L1Block", "IERC20"],
+		"remote": [{"name": "WETH", "chain": "eth", "abiOnly": true}],
+		"proxies": [{"name": "L1BlockProxy", "implementation": "L1Block", "address": "0x4200000000000000000000000000000000000015"}]
+	}`), 0o600))
+	require.NoError(t, os.WriteFile(path.Join(dir, "notes.txt"), []byte("not a contracts list"), 0o600))
+
+	for _, listPath := range []string{dir, path.Join(dir, "*.json")} {
+		contracts, err := readContractList(testlog.Logger(t, log.LevelDebug), listPath)
+		require.NoError(t, err)
+		require.Equal(t, []LocalContract{{Name: "L1Block"}, {Name: "L2OutputOracle"}, {Name: "IERC20"}}, contracts.Local)
+		require.Len(t, contracts.Remote, 1)
+		require.Len(t, contracts.Proxies, 1)
+	}
+
+	// a plain path reads just that file
+	contracts, err := readContractList(testlog.Logger(t, log.LevelDebug), path.Join(dir, "a.json"))
+	require.NoError(t, err)
+	require.Len(t, contracts.Local, 2)
+
+	_, err = readContractList(testlog.Logger(t, log.LevelDebug), path.Join(dir, "*.yaml"))
+	require.ErrorContains(t, err, "no contracts lists match")
+
+	require.NoError(t, os.WriteFile(path.Join(dir, "c.json"), []byte(`{"remote": [{"name": "WETH", "chain": "op"}]}`), 0o600))
+	_, err = readContractList(testlog.Logger(t, log.LevelDebug), dir)
+	require.ErrorContains(t, err, "contract WETH is defined differently in "+path.Join(dir, "a.json")+" and "+path.Join(dir, "c.json"))
+}
+
+func TestNameCollisions(t *testing.T) {
+	listPath := path.Join(t.TempDir(), "artifacts.json")
+	require.NoError(t, os.WriteFile(listPath, []byte(`{
+		"local": ["WETH", "L1Block", "MultiCall3"],
+		"remote": [{"name": "MultiCall3", "chain": "eth"}, {"name": "Permit2", "chain": "eth"}, {"name": "WETH", "chain": "op"}],
+		"proxies": [{"name": "Permit2", "implementation": "L1Block", "address": "0x4200000000000000000000000000000000000015"}]
+	}`), 0o600))
+
+	generator := BindGenGeneratorBase{ContractsListPath: listPath, Logger: testlog.Logger(t, log.LevelDebug)}
+	collisions, err := generator.NameCollisions()
+	require.NoError(t, err)
+	require.Equal(t, []string{"MultiCall3", "Permit2", "WETH"}, collisions)
+
+	// contracts that are not selected do not collide
+	generator.Skip = []string{"WETH", "Permit2"}
+	collisions, err = generator.NameCollisions()
+	require.NoError(t, err)
+	require.Equal(t, []string{"MultiCall3"}, collisions)
+}
+
+func TestWriteContractArtifactsAbiOnly(t *testing.T) {
+	dir := t.TempDir()
+	abiFilePath, bytecodeFilePath, err := writeContractArtifacts(testlog.Logger(t, log.LevelDebug), dir, "IERC20", []byte("[]"), nil)
+	require.NoError(t, err)
+	require.Equal(t, path.Join(dir, "IERC20.abi"), abiFilePath)
+	require.Empty(t, bytecodeFilePath)
+	require.NoFileExists(t, path.Join(dir, "IERC20.bin"))
+}
+
+func TestFilterContracts(t *testing.T) {
+	contracts := contractsList{
+		Local:   []LocalContract{{Name: "L1Block"}, {Name: "L1StandardBridge"}, {Name: "L2OutputOracle"}},
+		Remote:  []RemoteContract{{Name: "Safe_v130"}, {Name: "SafeL2_v130"}, {Name: "MultiCall3"}},
+		Proxies: []ProxyContract{{Name: "L1StandardBridgeProxy"}},
+	}
+	generator := BindGenGeneratorBase{Logger: testlog.Logger(t, log.LevelDebug)}
+
+	filtered, err := generator.filterContracts(contracts)
+	require.NoError(t, err)
+	require.Equal(t, contracts, filtered)
+
+	generator.Only = []string{"L1*", "Safe*"}
+	generator.Skip = []string{"L1Block", "*L2*"}
+	filtered, err = generator.filterContracts(contracts)
+	require.NoError(t, err)
+	require.Equal(t, contractsList{
+		Local:   []LocalContract{{Name: "L1StandardBridge"}},
+		Remote:  []RemoteContract{{Name: "Safe_v130"}},
+		Proxies: []ProxyContract{{Name: "L1StandardBridgeProxy"}},
+	}, filtered)
+
+	generator.Only = nil
+	generator.Skip = []string{"L1*"}
+	filtered, err = generator.filterContracts(contracts)
+	require.NoError(t, err)
+	require.Equal(t, []LocalContract{{Name: "L2OutputOracle"}}, filtered.Local)
+	require.Len(t, filtered.Remote, 3)
+	require.Empty(t, filtered.Proxies)
+
+	generator.Only = []string{"L1["}
+	_, err = generator.filterContracts(contracts)
+	require.ErrorContains(t, err, `invalid contract name pattern "L1["`)
+}
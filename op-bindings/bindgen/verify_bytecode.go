@@ -0,0 +1,98 @@
+package bindgen
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// bytecodeDiffContext is the number of bytes from the first difference on included in mismatch errors.
+const bytecodeDiffContext = 16
+
+// firstBytecodeDifference returns the offset of the first byte at which actual differs from expected,
+// or -1 if they match. Differences within the operands of PUSH32 instructions in expected are ignored,
+// since that is where solc places the values of immutables, which may legitimately differ per deployment.
+func firstBytecodeDifference(expected, actual []byte) int {
+	for pc := 0; pc < len(expected); pc++ {
+		if pc >= len(actual) || expected[pc] != actual[pc] {
+			return pc
+		}
+		op := vm.OpCode(expected[pc])
+		if !op.IsPush() {
+			continue
+		}
+		size := int(op - vm.PUSH0)
+		if op != vm.PUSH32 {
+			for i := 1; i <= size && pc+i < len(expected); i++ {
+				if pc+i >= len(actual) || expected[pc+i] != actual[pc+i] {
+					return pc + i
+				}
+			}
+		}
+		// skip the operand, so it is not interpreted as instructions
+		pc += size
+	}
+	if len(actual) != len(expected) {
+		return min(len(expected), len(actual))
+	}
+	return -1
+}
+
+// bytecodeAt returns up to bytecodeDiffContext bytes of code from the given offset on, for mismatch errors.
+func bytecodeAt(code []byte, offset int) []byte {
+	if offset >= len(code) {
+		return nil
+	}
+	return code[offset:min(offset+bytecodeDiffContext, len(code))]
+}
+
+// verifyDeployedBytecode asserts the fetched deployed bytecode of a contract matches the code on-chain,
+// modulo immutables, and modulo metadata if VerifyBytecodeStripMetadata is set, on every chain the contract
+// is deployed on.
+func (generator *BindGenGeneratorRemote) verifyDeployedBytecode(ctx context.Context, contractMetadata *RemoteContractMetadata) error {
+	deployments := contractMetadata.Deployments
+	if contractMetadata.ResolveProxy {
+		// the bytecode is the implementation's, which is only known on the chain it was resolved on
+		deployments = Deployments{contractMetadata.Chain: contractMetadata.implementation}
+	} else if contractMetadata.AtBlock != nil {
+		// the pinned block is a block of the contract's chain only
+		deployments = Deployments{contractMetadata.Chain: contractMetadata.Deployments[contractMetadata.Chain]}
+	}
+
+	chains := make([]string, 0, len(deployments))
+	for chain := range deployments {
+		chains = append(chains, chain)
+	}
+	sort.Strings(chains)
+
+	expected := common.FromHex(contractMetadata.DeployedBin)
+	if generator.VerifyBytecodeStripMetadata {
+		expected = solc.StripMetadata(expected)
+	}
+	for _, chain := range chains {
+		address := deployments[chain]
+		client, ok := generator.RpcClients[chain]
+		if !ok {
+			return fmt.Errorf("unknown chain: %s, unable to retrieve a RPC client to verify the bytecode of %s", chain, contractMetadata.Name)
+		}
+		actual, err := client.CodeAt(ctx, address, contractMetadata.blockNumber())
+		if err != nil {
+			return fmt.Errorf("error getting deployed bytecode of %s from RPC on chain %s: %w", contractMetadata.Name, chain, err)
+		}
+		if generator.VerifyBytecodeStripMetadata {
+			actual = solc.StripMetadata(actual)
+		}
+		if offset := firstBytecodeDifference(expected, actual); offset >= 0 {
+			return fmt.Errorf(
+				"%s: fetched deployed bytecode (%d bytes) differs from the code on chain %s at %s (%d bytes) at byte offset %d: fetched=0x%x onchain=0x%x",
+				contractMetadata.Name, len(expected), chain, address, len(actual), offset, bytecodeAt(expected, offset), bytecodeAt(actual, offset),
+			)
+		}
+		generator.Logger.Debug("Verified deployed bytecode against on-chain code", "contract", contractMetadata.Name, "chain", chain, "address", address)
+	}
+	return nil
+}
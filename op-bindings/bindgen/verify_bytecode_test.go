@@ -0,0 +1,77 @@
+package bindgen
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirstBytecodeDifference(t *testing.T) {
+	immutable := "7f" + "00000000000000000000000000000000000000000000000000000000000000aa"
+	otherImmutable := "7f" + "00000000000000000000000000000000000000000000000000000000000000bb"
+	tests := []struct {
+		name     string
+		expected string
+		actual   string
+		offset   int
+	}{
+		{name: "equal", expected: "0x600160020150", actual: "0x600160020150", offset: -1},
+		{name: "empty", expected: "0x", actual: "0x", offset: -1},
+		{name: "differing opcode", expected: "0x600160020150", actual: "0x600160020250", offset: 4},
+		{name: "differing push operand", expected: "0x600160020150", actual: "0x600160030150", offset: 3},
+		{name: "differing immutable", expected: "0x6001" + immutable + "50", actual: "0x6001" + otherImmutable + "50", offset: -1},
+		{name: "differing after immutable", expected: "0x6001" + immutable + "50", actual: "0x6001" + otherImmutable + "51", offset: 35},
+		{name: "truncated", expected: "0x600160020150", actual: "0x6001600201", offset: 5},
+		{name: "truncated immutable", expected: "0x6001" + immutable, actual: "0x60017f00", offset: 4},
+		{name: "extended", expected: "0x6001", actual: "0x600150", offset: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.offset, firstBytecodeDifference(common.FromHex(tt.expected), common.FromHex(tt.actual)))
+		})
+	}
+}
+
+func TestVerifyDeployedBytecode(t *testing.T) {
+	matching := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	stale := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	gen := newTestRemoteGeneratorWithService(t, new(slowContractDataClient), 1, &codeService{
+		code: map[common.Address]hexutil.Bytes{stale: hexutil.MustDecode("0x6002")},
+	})
+
+	contract := &RemoteContractMetadata{
+		RemoteContract: RemoteContract{Name: "Contract", Deployments: Deployments{"eth": matching}},
+		DeployedBin:    testDeployedBytecode,
+	}
+	require.NoError(t, gen.verifyDeployedBytecode(context.Background(), contract))
+
+	contract.Deployments["eth"] = stale
+	err := gen.verifyDeployedBytecode(context.Background(), contract)
+	require.ErrorContains(t, err, "differs from the code on chain eth at "+stale.Hex()+" (2 bytes) at byte offset 1: fetched=0x01 onchain=0x02")
+
+	contract.Deployments = Deployments{"eth": matching, "op": matching}
+	require.ErrorContains(t, gen.verifyDeployedBytecode(context.Background(), contract), "unable to retrieve a RPC client")
+}
+
+func TestVerifyDeployedBytecodeStripMetadata(t *testing.T) {
+	// the same code, with the metadata of different ipfs hashes appended
+	withMetadata := func(hash string) string {
+		return testDeployedBytecode + "a2646970667358221220" + hash + "64736f6c63430008130033"
+	}
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	gen := newTestRemoteGeneratorWithService(t, new(slowContractDataClient), 1, &codeService{
+		code: map[common.Address]hexutil.Bytes{address: hexutil.MustDecode(withMetadata(strings.Repeat("22", 32)))},
+	})
+	contract := &RemoteContractMetadata{
+		RemoteContract: RemoteContract{Name: "Contract", Deployments: Deployments{"eth": address}},
+		DeployedBin:    withMetadata(strings.Repeat("11", 32)),
+	}
+	require.ErrorContains(t, gen.verifyDeployedBytecode(context.Background(), contract), "differs from the code on chain eth")
+
+	gen.VerifyBytecodeStripMetadata = true
+	require.NoError(t, gen.verifyDeployedBytecode(context.Background(), contract))
+}
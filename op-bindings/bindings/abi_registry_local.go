@@ -0,0 +1,51 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+func init() {
+	abis["AddressManager"] = AddressManagerMetaData
+	abis["AlphabetVM"] = AlphabetVMMetaData
+	abis["BaseFeeVault"] = BaseFeeVaultMetaData
+	abis["CrossDomainMessenger"] = CrossDomainMessengerMetaData
+	abis["DataAvailabilityChallenge"] = DataAvailabilityChallengeMetaData
+	abis["DelayedVetoable"] = DelayedVetoableMetaData
+	abis["DelayedWETH"] = DelayedWETHMetaData
+	abis["DeployerWhitelist"] = DeployerWhitelistMetaData
+	abis["DisputeGameFactory"] = DisputeGameFactoryMetaData
+	abis["EAS"] = EASMetaData
+	abis["ERC20"] = ERC20MetaData
+	abis["FaultDisputeGame"] = FaultDisputeGameMetaData
+	abis["GasPriceOracle"] = GasPriceOracleMetaData
+	abis["ISemver"] = ISemverMetaData
+	abis["L1Block"] = L1BlockMetaData
+	abis["L1BlockNumber"] = L1BlockNumberMetaData
+	abis["L1CrossDomainMessenger"] = L1CrossDomainMessengerMetaData
+	abis["L1ERC721Bridge"] = L1ERC721BridgeMetaData
+	abis["L1FeeVault"] = L1FeeVaultMetaData
+	abis["L1StandardBridge"] = L1StandardBridgeMetaData
+	abis["L2CrossDomainMessenger"] = L2CrossDomainMessengerMetaData
+	abis["L2ERC721Bridge"] = L2ERC721BridgeMetaData
+	abis["L2OutputOracle"] = L2OutputOracleMetaData
+	abis["L2StandardBridge"] = L2StandardBridgeMetaData
+	abis["L2ToL1MessagePasser"] = L2ToL1MessagePasserMetaData
+	abis["LegacyMessagePasser"] = LegacyMessagePasserMetaData
+	abis["MIPS"] = MIPSMetaData
+	abis["OptimismMintableERC20"] = OptimismMintableERC20MetaData
+	abis["OptimismMintableERC20Factory"] = OptimismMintableERC20FactoryMetaData
+	abis["OptimismMintableERC721Factory"] = OptimismMintableERC721FactoryMetaData
+	abis["OptimismPortal"] = OptimismPortalMetaData
+	abis["PreimageOracle"] = PreimageOracleMetaData
+	abis["ProtocolVersions"] = ProtocolVersionsMetaData
+	abis["Proxy"] = ProxyMetaData
+	abis["ProxyAdmin"] = ProxyAdminMetaData
+	abis["Safe"] = SafeMetaData
+	abis["SafeProxyFactory"] = SafeProxyFactoryMetaData
+	abis["SchemaRegistry"] = SchemaRegistryMetaData
+	abis["SequencerFeeVault"] = SequencerFeeVaultMetaData
+	abis["StandardBridge"] = StandardBridgeMetaData
+	abis["StorageSetter"] = StorageSetterMetaData
+	abis["SuperchainConfig"] = SuperchainConfigMetaData
+	abis["SystemConfig"] = SystemConfigMetaData
+	abis["WETH9"] = WETH9MetaData
+}
@@ -0,0 +1,18 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+func init() {
+	abis["Create2Deployer"] = Create2DeployerMetaData
+	abis["DeterministicDeploymentProxy"] = DeterministicDeploymentProxyMetaData
+	abis["EntryPoint"] = EntryPointMetaData
+	abis["MultiCall3"] = MultiCall3MetaData
+	abis["MultiSendCallOnly_v130"] = MultiSendCallOnlyV130MetaData
+	abis["MultiSend_v130"] = MultiSendV130MetaData
+	abis["Permit2"] = Permit2MetaData
+	abis["SafeL2_v130"] = SafeL2V130MetaData
+	abis["SafeSingletonFactory"] = SafeSingletonFactoryMetaData
+	abis["Safe_v130"] = SafeV130MetaData
+	abis["SenderCreator"] = SenderCreatorMetaData
+}
@@ -15,7 +15,6 @@ var CrossDomainMessengerStorageLayout = new(solc.StorageLayout)
 
 var CrossDomainMessengerDeployedBin = "0x"
 
-
 func init() {
 	if err := json.Unmarshal([]byte(CrossDomainMessengerStorageLayoutJSON), CrossDomainMessengerStorageLayout); err != nil {
 		panic(err)
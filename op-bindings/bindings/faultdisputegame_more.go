@@ -15,7 +15,6 @@ var FaultDisputeGameStorageLayout = new(solc.StorageLayout)
 
 var FaultDisputeGameDeployedBin = "0x6080604052600436106101e75760003560e01c80638b85902b1161010e578063d5d44d80116100a7578063ec5e630811610079578063fa24f74311610061578063fa24f74314610759578063fa315aa91461077d578063fdffbb28146107b057005b8063ec5e630814610706578063f8f43ff61461073957005b8063d5d44d8014610653578063d6ae3cd514610680578063d8cc1a3c146106b3578063e1f0c376146106d357005b8063c395e1ca116100e0578063c395e1ca14610575578063c55cd0c714610595578063c6f0308c146105a8578063cf09e0d01461063257005b80638b85902b146104845780638d450a95146104c4578063bbdc02db146104f7578063bcef3b551461053857005b806357da950e116101805780636361506d116101525780636361506d1461041257806370872aa5146104525780638129fc1c146104675780638980e0cc1461046f57005b806357da950e1461039a578063609d3334146103ca57806360e27464146103df578063632247ea146103ff57005b806335fef567116101b957806335fef567146102aa5780633a768463146102bd5780633fc8cef31461031157806354fd4d501461034457005b806319effeb4146101f0578063200d2ed21461023b57806325fc2ace146102765780632810e1d61461029557005b366101ee57005b005b3480156101fc57600080fd5b5060005461021d9068010000000000000000900467ffffffffffffffff1681565b60405167ffffffffffffffff90911681526020015b60405180910390f35b34801561024757600080fd5b5060005461026990700100000000000000000000000000000000900460ff1681565b6040516102329190613f34565b34801561028257600080fd5b506006545b604051908152602001610232565b3480156102a157600080fd5b506102696107c3565b6101ee6102b8366004613f75565b610a40565b3480156102c957600080fd5b507f00000000000000000000000000000000000000000000000000000000000000005b60405173ffffffffffffffffffffffffffffffffffffffff9091168152602001610232565b34801561031d57600080fd5b507f00000000000000000000000000000000000000000000000000000000000000006102ec565b34801561035057600080fd5b5061038d6040518060400160405280600581526020017f302e392e3000000000000000000000000000000000000000000000000000000081525081565b6040516102329190614002565b3480156103a657600080fd5b506006546007546103b5919082565b60408051928352602083019190915201610232565b3480156103d657600080fd5b5061038d610a50565b3480156103eb57600080fd5b506101ee6103fa366004614037565b610a63565b6101ee61040d366004614070565b610c0f565b34801561041e57600080fd5b50367ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe81013560f01c900360200135610287565b34801561045e57600080fd5b50600754610287565b6101ee611486565b34801561047b57600080fd5b50600154610287565b34801561049057600080fd5b50367ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe81013560f01c900360400135610287565b3480156104d057600080fd5b507f0000000000000000000000000000000000000000000000000000000000000000610287565b34801561050357600080fd5b5060405163ffffffff7f0000000000000000000000000000000000000000000000000000000000000000168152602001610232565b34801561054457600080fd5b50367ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe81013560f01c900335610287565b34801561058157600080fd5b506102876105903660046140a5565b6119b2565b6101ee6105a3366004613f75565b611b9c565b3480156105b457600080fd5b506105c86105c33660046140d7565b611ba8565b6040805163ffffffff909816885273ffffffffffffffffffffffffffffffffffffffff968716602089015295909416948601949094526fffffffffffffffffffffffffffffffff9182166060860152608085015291821660a08401521660c082015260e001610232565b34801561063e57600080fd5b5060005461021d9067ffffffffffffffff1681565b34801561065f57600080fd5b5061028761066e366004614037565b60026020526000908152604090205481565b34801561068c57600080fd5b507f0000000000000000000000000000000000000000000000000000000000000000610287565b3480156106bf57600080fd5b506101ee6106ce366004614139565b611c3f565b3480156106df57600080fd5b507f000000000000000000000000000000000000000000000000000000000000000061021d565b34801561071257600080fd5b507f0000000000000000000000000000000000000000000000000000000000000000610287565b34801561074557600080fd5b506101ee6107543660046141c3565b612274565b34801561076557600080fd5b5061076e6126d1565b604051610232939291906141ef565b34801561078957600080fd5b507f0000000000000000000000000000000000000000000000000000000000000000610287565b6101ee6107be3660046140d7565b61272e565b600080600054700100000000000000000000000000000000900460ff1660028111156107f1576107f1613f05565b14610828576040517f67fe195000000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b60055460ff16610864576040517f9a07664600000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff1660016000815481106108905761089061421d565b6000918252602090912060059091020154640100000000900473ffffffffffffffffffffffffffffffffffffffff16146108cb5760016108ce565b60025b6000805467ffffffffffffffff421668010000000000000000027fffffffffffffffffffffffffffffffff0000000000000000ffffffffffffffff82168117835592935083927fffffffffffffffffffffffffffffff00ffffffffffffffffffffffffffffffff167fffffffffffffffffffffffffffffff000000000000000000ffffffffffffffff9091161770010000000000000000000000000000000083600281111561097f5761097f613f05565b02179055600281111561099457610994613f05565b6040517f5e186f09b9c93491f14e277eea7faa5de6a2d4bda75a79af7a3684fbfb42da6090600090a27f000000000000000000000000000000000000000000000000000000000000000073ffffffffffffffffffffffffffffffffffffffff1663838c2d1e6040518163ffffffff1660e01b8152600401600060405180830381600087803b158015610a2557600080fd5b505af1158015610a39573d6000803e3d6000fd5b5050505090565b610a4c82826000610c0f565b5050565b6060610a5e60406020612b8f565b905090565b73ffffffffffffffffffffffffffffffffffffffff8116600090815260026020526040812080549082905590819003610ac8576040517f17bfe5f700000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b6040517ff3fef3a300000000000000000000000000000000000000000000000000000000815273ffffffffffffffffffffffffffffffffffffffff8381166004830152602482018390527f0000000000000000000000000000000000000000000000000000000000000000169063f3fef3a390604401600060405180830381600087803b158015610b5857600080fd5b505af1158015610b6c573d6000803e3d6000fd5b5050505060008273ffffffffffffffffffffffffffffffffffffffff168260405160006040518083038185875af1925050503d8060008114610bca576040519150601f19603f3d011682016040523d82523d6000602084013e610bcf565b606091505b5050905080610c0a576040517f83e6cc6b00000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b505050565b60008054700100000000000000000000000000000000900460ff166002811115610c3b57610c3b613f05565b14610c72576040517f67fe195000000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b600060018481548110610c8757610c8761421d565b600091825260208083206040805160e0810182526005909402909101805463ffffffff808216865273ffffffffffffffffffffffffffffffffffffffff6401000000009092048216948601949094526001820154169184019190915260028101546fffffffffffffffffffffffffffffffff90811660608501526003820154608085015260049091015480821660a0850181905270010000000000000000000000000000000090910490911660c0840152919350909190610d4c9083908690612c2616565b90506000610dec826fffffffffffffffffffffffffffffffff167e09010a0d15021d0b0e10121619031e080c141c0f111807131b17061a05041f7f07c4acdd0000000000000000000000000000000000000000000000000000000067ffffffffffffffff831160061b83811c63ffffffff1060051b1792831c600181901c17600281901c17600481901c17600881901c17601081901c170260fb1c1a1790565b67ffffffffffffffff169050861580610e2e5750610e2b7f0000000000000000000000000000000000000000000000000000000000000000600261427b565b81145b8015610e38575084155b15610e6f576040517fa42637bc00000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b7f0000000000000000000000000000000000000000000000000000000000000000811115610ec9576040517f56f57b2b00000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b610ef47f0000000000000000000000000000000000000000000000000000000000000000600161427b565b8103610f0657610f0686888588612c2e565b34610f10836119b2565b14610f47576040517f8620aa1900000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b835160009063ffffffff90811614610fa7576001856000015163ffffffff1681548110610f7657610f7661421d565b906000526020600020906005020160040160109054906101000a90046fffffffffffffffffffffffffffffffff1690505b60c0850151600090610fcb9067ffffffffffffffff165b67ffffffffffffffff1690565b67ffffffffffffffff1642610ff5610fbe856fffffffffffffffffffffffffffffffff1660401c90565b67ffffffffffffffff16611009919061427b565b6110139190614293565b90507f000000000000000000000000000000000000000000000000000000000000000060011c677fffffffffffffff1667ffffffffffffffff82161115611086576040517f3381d11400000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b6000604082901b421760008a8152608087901b6fffffffffffffffffffffffffffffffff8d1617602052604081209192509060008181526003602052604090205490915060ff1615611104576040517f80497e3b00000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b60016003600083815260200190815260200160002060006101000a81548160ff02191690831515021790555060016040518060e001604052808d63ffffffff168152602001600073ffffffffffffffffffffffffffffffffffffffff1681526020013373ffffffffffffffffffffffffffffffffffffffff168152602001346fffffffffffffffffffffffffffffffff1681526020018c8152602001886fffffffffffffffffffffffffffffffff168152602001846fffffffffffffffffffffffffffffffff16815250908060018154018082558091505060019003906000526020600020906005020160009091909190915060008201518160000160006101000a81548163ffffffff021916908363ffffffff16021790555060208201518160000160046101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555060408201518160010160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555060608201518160020160006101000a8154816fffffffffffffffffffffffffffffffff02191690836fffffffffffffffffffffffffffffffff1602179055506080820151816003015560a08201518160040160006101000a8154816fffffffffffffffffffffffffffffffff02191690836fffffffffffffffffffffffffffffffff16021790555060c08201518160040160106101000a8154816fffffffffffffffffffffffffffffffff02191690836fffffffffffffffffffffffffffffffff1602179055505050600460008c8152602001908152602001600020600180805490506113999190614293565b81546001810183556000928352602083200155604080517fd0e30db0000000000000000000000000000000000000000000000000000000008152905173ffffffffffffffffffffffffffffffffffffffff7f0000000000000000000000000000000000000000000000000000000000000000169263d0e30db09234926004808301939282900301818588803b15801561143157600080fd5b505af1158015611445573d6000803e3d6000fd5b50506040513393508d92508e91507f9b3245740ec3b155098a55be84957a4da13eaf7f14a8bc6f53126c0b9350f2be90600090a45050505050505050505050565b600554610100900460ff16156114c8576040517f0dc149f000000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b6040517f7258a80700000000000000000000000000000000000000000000000000000000815263ffffffff7f0000000000000000000000000000000000000000000000000000000000000000166004820152600090819073ffffffffffffffffffffffffffffffffffffffff7f00000000000000000000000000000000000000000000000000000000000000001690637258a807906024016040805180830381865afa15801561157c573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906115a091906142aa565b9092509050816115dc576040517f6a6bc3b200000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b604080518082018252838152602001829052600683905560078290558190367ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe81013560f01c900301351161168e576040517ff40239db000000000000000000000000000000000000000000000000000000008152367ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe81013560f01c90033560048201526024015b60405180910390fd5b60663611156116a55763c407e0256000526004601cfd5b6040805160e08101825263ffffffff808252600060208301818152328486019081526fffffffffffffffffffffffffffffffff34818116606088019081527ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe369081013560f01c90033560808901908152600160a08a0181815242861660c08c0190815282548084018455928a529a5160059092027fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf681018054995173ffffffffffffffffffffffffffffffffffffffff908116640100000000027fffffffffffffffff000000000000000000000000000000000000000000000000909b1694909c16939093179890981790915594517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf787018054918a167fffffffffffffffffffffffff000000000000000000000000000000000000000090921691909117905590517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf8860180549185167fffffffffffffffffffffffffffffffff00000000000000000000000000000000909216919091179055517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf9850155915195518116700100000000000000000000000000000000029516949094177fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cfa9091015583517fd0e30db000000000000000000000000000000000000000000000000000000000815293517f00000000000000000000000000000000000000000000000000000000000000009092169363d0e30db093926004828101939282900301818588803b15801561193357600080fd5b505af1158015611947573d6000803e3d6000fd5b50506000805467ffffffffffffffff42167fffffffffffffffffffffffffffffffffffffffffffffffff00000000000000009091161790555050600580547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff16610100179055505050565b600080611a51836fffffffffffffffffffffffffffffffff167e09010a0d15021d0b0e10121619031e080c141c0f111807131b17061a05041f7f07c4acdd0000000000000000000000000000000000000000000000000000000067ffffffffffffffff831160061b83811c63ffffffff1060051b1792831c600181901c17600281901c17600481901c17600881901c17601081901c170260fb1c1a1790565b67ffffffffffffffff1690507f0000000000000000000000000000000000000000000000000000000000000000811115611ab7576040517f56f57b2b00000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b642e90edd00062061a80630bebc2006000611ad283836142fd565b9050670de0b6b3a76400006000611b09827f0000000000000000000000000000000000000000000000000000000000000000614311565b90506000611b27611b22670de0b6b3a764000086614311565b612def565b90506000611b35848461304a565b90506000611b438383613099565b90506000611b50826130c7565b90506000611b6f82611b6a670de0b6b3a76400008f614311565b6132af565b90506000611b7d8b83613099565b9050611b89818d614311565b9f9e505050505050505050505050505050565b610a4c82826001610c0f565b60018181548110611bb857600080fd5b60009182526020909120600590910201805460018201546002830154600384015460049094015463ffffffff8416955064010000000090930473ffffffffffffffffffffffffffffffffffffffff908116949216926fffffffffffffffffffffffffffffffff91821692918082169170010000000000000000000000000000000090041687565b60008054700100000000000000000000000000000000900460ff166002811115611c6b57611c6b613f05565b14611ca2576040517f67fe195000000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b600060018781548110611cb757611cb761421d565b6000918252602082206005919091020160048101549092506fffffffffffffffffffffffffffffffff16908715821760011b9050611d167f0000000000000000000000000000000000000000000000000000000000000000600161427b565b611db2826fffffffffffffffffffffffffffffffff167e09010a0d15021d0b0e10121619031e080c141c0f111807131b17061a05041f7f07c4acdd0000000000000000000000000000000000000000000000000000000067ffffffffffffffff831160061b83811c63ffffffff1060051b1792831c600181901c17600281901c17600481901c17600881901c17601081901c170260fb1c1a1790565b67ffffffffffffffff1614611df3576040517f5f53dd9800000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b6000808915611ee257611e467f00000000000000000000000000000000000000000000000000000000000000007f0000000000000000000000000000000000000000000000000000000000000000614293565b6001901b611e65846fffffffffffffffffffffffffffffffff166132e9565b67ffffffffffffffff16611e79919061434e565b15611eb657611ead611e9e60016fffffffffffffffffffffffffffffffff8716614362565b865463ffffffff16600061338f565b60030154611ed8565b7f00000000000000000000000000000000000000000000000000000000000000005b9150849050611f0c565b60038501549150611f09611e9e6fffffffffffffffffffffffffffffffff86166001614393565b90505b600882901b60088a8a604051611f239291906143c7565b6040518091039020901b14611f64576040517f696550ff00000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b6000611f6f8c613473565b90506000611f7e836003015490565b6040517fe14ced320000000000000000000000000000000000000000000000000000000081527f000000000000000000000000000000000000000000000000000000000000000073ffffffffffffffffffffffffffffffffffffffff169063e14ced3290611ff8908f908f908f908f908a90600401614420565b6020604051808303816000875af1158015612017573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061203b919061445a565b6004850154911491506000906002906120e6906fffffffffffffffffffffffffffffffff167e09010a0d15021d0b0e10121619031e080c141c0f111807131b17061a05041f7f07c4acdd0000000000000000000000000000000000000000000000000000000067ffffffffffffffff831160061b83811c63ffffffff1060051b1792831c600181901c17600281901c17600481901c17600881901c17601081901c170260fb1c1a1790565b612182896fffffffffffffffffffffffffffffffff167e09010a0d15021d0b0e10121619031e080c141c0f111807131b17061a05041f7f07c4acdd0000000000000000000000000000000000000000000000000000000067ffffffffffffffff831160061b83811c63ffffffff1060051b1792831c600181901c17600281901c17600481901c17600881901c17601081901c170260fb1c1a1790565b61218c9190614473565b6121969190614494565b67ffffffffffffffff1615905081151581036121de576040517ffb4e40dd00000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b8754640100000000900473ffffffffffffffffffffffffffffffffffffffff1615612235576040517f9071e6af00000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b505085547fffffffffffffffff0000000000000000000000000000000000000000ffffffff163364010000000002179095555050505050505050505050565b60008054700100000000000000000000000000000000900460ff1660028111156122a0576122a0613f05565b146122d7576040517f67fe195000000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b6000806000806122e6866134a2565b935093509350935060006122fc858585856138b1565b905060007f000000000000000000000000000000000000000000000000000000000000000073ffffffffffffffffffffffffffffffffffffffff16637dc0d1d06040518163ffffffff1660e01b8152600401602060405180830381865afa15801561236b573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061238f91906144bb565b9050600189036124875773ffffffffffffffffffffffffffffffffffffffff81166352f0f3ad8a846123eb367ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe81013560f01c90036020013590565b6040517fffffffff0000000000000000000000000000000000000000000000000000000060e086901b16815260048101939093526024830191909152604482015260206064820152608481018a905260a4015b6020604051808303816000875af115801561245d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612481919061445a565b506126c6565b600289036124b35773ffffffffffffffffffffffffffffffffffffffff81166352f0f3ad8a84896123eb565b600389036124df5773ffffffffffffffffffffffffffffffffffffffff81166352f0f3ad8a84876123eb565b600489036125fb5760006125256fffffffffffffffffffffffffffffffff85167f0000000000000000000000000000000000000000000000000000000000000000613970565b600754612532919061427b565b61253d90600161427b565b905073ffffffffffffffffffffffffffffffffffffffff82166352f0f3ad8b8560405160e084901b7fffffffff000000000000000000000000000000000000000000000000000000001681526004810192909252602482015260c084901b604482015260086064820152608481018b905260a4016020604051808303816000875af11580156125d0573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906125f4919061445a565b50506126c6565b60058903612694576040517f52f0f3ad000000000000000000000000000000000000000000000000000000008152600481018a9052602481018390527f000000000000000000000000000000000000000000000000000000000000000060c01b6044820152600860648201526084810188905273ffffffffffffffffffffffffffffffffffffffff8216906352f0f3ad9060a40161243e565b6040517fff137e6500000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b505050505050505050565b7f0000000000000000000000000000000000000000000000000000000000000000367ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe81013560f01c9003356060612727610a50565b9050909192565b60008054700100000000000000000000000000000000900460ff16600281111561275a5761275a613f05565b14612791576040517f67fe195000000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b6000600182815481106127a6576127a661421d565b6000918252602082206005919091020160048101549092506127e890700100000000000000000000000000000000900460401c67ffffffffffffffff16610fbe565b600483015490915060009061281a90700100000000000000000000000000000000900467ffffffffffffffff16610fbe565b6128249042614473565b9050677fffffffffffffff7f000000000000000000000000000000000000000000000000000000000000000060011c1661285e82846144d8565b67ffffffffffffffff161161289f576040517ff2440b5300000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b60008481526004602052604090208054851580156128bf575060055460ff165b156128f6576040517ff1a9458100000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b8015801561290357508515155b15612968578454640100000000900473ffffffffffffffffffffffffffffffffffffffff16600081156129365781612952565b600187015473ffffffffffffffffffffffffffffffffffffffff165b905061295e8188613a25565b5050505050505050565b60006fffffffffffffffffffffffffffffffff815b83811015612aae5760008582815481106129995761299961421d565b60009182526020808320909101548083526004909152604090912054909150156129ef576040517f9a07664600000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b600060018281548110612a0457612a0461421d565b600091825260209091206005909102018054909150640100000000900473ffffffffffffffffffffffffffffffffffffffff16158015612a5d575060048101546fffffffffffffffffffffffffffffffff908116908516115b15612a9b576001810154600482015473ffffffffffffffffffffffffffffffffffffffff90911695506fffffffffffffffffffffffffffffffff1693505b505080612aa7906144fb565b905061297d565b50612af673ffffffffffffffffffffffffffffffffffffffff831615612ad45782612af0565b600188015473ffffffffffffffffffffffffffffffffffffffff165b88613a25565b86547fffffffffffffffff0000000000000000000000000000000000000000ffffffff1664010000000073ffffffffffffffffffffffffffffffffffffffff8416021787556000888152600460205260408120612b5291613ecb565b8760000361295e57600580547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff001660011790555050505050505050565b60606000612bc684367ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe81013560f01c900361427b565b90508267ffffffffffffffff1667ffffffffffffffff811115612beb57612beb614533565b6040519080825280601f01601f191660200182016040528015612c15576020820181803683370190505b509150828160208401375092915050565b151760011b90565b6000612c4d6fffffffffffffffffffffffffffffffff84166001614393565b90506000612c5d8286600161338f565b9050600086901a8380612d505750612c9660027f000000000000000000000000000000000000000000000000000000000000000061434e565b6004830154600290612d3a906fffffffffffffffffffffffffffffffff167e09010a0d15021d0b0e10121619031e080c141c0f111807131b17061a05041f7f07c4acdd0000000000000000000000000000000000000000000000000000000067ffffffffffffffff831160061b83811c63ffffffff1060051b1792831c600181901c17600281901c17600481901c17600881901c17601081901c170260fb1c1a1790565b612d449190614494565b67ffffffffffffffff16145b15612da85760ff811660011480612d6a575060ff81166002145b612da3576040517ff40239db00000000000000000000000000000000000000000000000000000000815260048101889052602401611685565b612de6565b60ff811615612de6576040517ff40239db00000000000000000000000000000000000000000000000000000000815260048101889052602401611685565b50505050505050565b6fffffffffffffffffffffffffffffffff811160071b81811c67ffffffffffffffff1060061b1781811c63ffffffff1060051b1781811c61ffff1060041b1781811c60ff1060031b1760008213612e4e57631615e6386000526004601cfd5b7ff8f9f9faf9fdfafbf9fdfcfdfafbfcfef9fafdfafcfcfbfefafafcfbffffffff6f8421084210842108cc6318c6db6d54be83831c1c601f161a1890811b609f90811c6c465772b2bbbb5f824b15207a3081018102606090811d6d0388eaa27412d5aca026815d636e018202811d6d0df99ac502031bf953eff472fdcc018202811d6d13cdffb29d51d99322bdff5f2211018202811d6d0a0f742023def783a307a986912e018202811d6d01920d8043ca89b5239253284e42018202811d6c0b7a86d7375468fac667a0a527016c29508e458543d8aa4df2abee7883018302821d6d0139601a2efabe717e604cbb4894018302821d6d02247f7a7b6594320649aa03aba1018302821d7fffffffffffffffffffffffffffffffffffffff73c0c716a594e00d54e3c4cbc9018302821d7ffffffffffffffffffffffffffffffffffffffdc7b88c420e53a9890533129f6f01830290911d7fffffffffffffffffffffffffffffffffffffff465fda27eb4d63ded474e5f832019091027ffffffffffffffff5f6af8f7b3396644f18e157960000000000000000000000000105711340daa0d5f769dba1915cef59f0815a5506029190037d0267a36c0c95b3975ab3ee5b203a7614a3f75373f047d803ae7b6687f2b302017d57115e47018c7177eebf7cd370a3356a1b7863008a5ae8028c72b88642840160ae1d90565b60007812725dd1d243aba0e75fe645cc4873f9e65afe688c928e1f218311670de0b6b3a76400000215820261308757637c5f487d6000526004601cfd5b50670de0b6b3a7640000919091020490565b6000816000190483118202156130b75763bac65e5b6000526004601cfd5b50670de0b6b3a764000091020490565b60007ffffffffffffffffffffffffffffffffffffffffffffffffdc0d0570925a462d782136130f557919050565b680755bf798b4a1bf1e582126131135763a37bfec96000526004601cfd5b6503782dace9d9604e83901b059150600060606bb17217f7d1cf79abc9e3b39884821b056b80000000000000000000000001901d6bb17217f7d1cf79abc9e3b39881029093037fffffffffffffffffffffffffffffffffffffffdbf3ccf1604d263450f02a550481018102606090811d6d0277594991cfc85f6e2461837cd9018202811d7fffffffffffffffffffffffffffffffffffffe5adedaa1cb095af9e4da10e363c018202811d6db1bbb201f443cf962f1a1d3db4a5018202811d7ffffffffffffffffffffffffffffffffffffd38dc772608b0ae56cce01296c0eb018202811d6e05180bb14799ab47a8a8cb2a527d57016d02d16720577bd19bf614176fe9ea6c10fe68e7fd37d0007b713f765084018402831d9081019084017ffffffffffffffffffffffffffffffffffffffe2c69812cf03b0763fd454a8f7e010290911d6e0587f503bb6ea29d25fcb7401964500190910279d835ebba824c98fb31b83b2ca45c000000000000000000000000010574029d9dc38563c32e5c2f6dc192ee70ef65f9978af30260c3939093039290921c92915050565b60006132e0670de0b6b3a7640000836132c786612def565b6132d19190614562565b6132db919061461e565b6130c7565b90505b92915050565b600080613376837e09010a0d15021d0b0e10121619031e080c141c0f111807131b17061a05041f7f07c4acdd0000000000000000000000000000000000000000000000000000000067ffffffffffffffff831160061b83811c63ffffffff1060051b1792831c600181901c17600281901c17600481901c17600881901c17601081901c170260fb1c1a1790565b600167ffffffffffffffff919091161b90920392915050565b600080826133d8576133d36fffffffffffffffffffffffffffffffff86167f0000000000000000000000000000000000000000000000000000000000000000613bb1565b6133f3565b6133f3856fffffffffffffffffffffffffffffffff16613d6f565b9050600184815481106134085761340861421d565b906000526020600020906005020191505b60048201546fffffffffffffffffffffffffffffffff82811691161461346b57815460018054909163ffffffff169081106134565761345661421d565b90600052602060002090600502019150613419565b509392505050565b6000806000806000613484866134a2565b9350935093509350613498848484846138b1565b9695505050505050565b60008060008060008590506000600182815481106134c2576134c261421d565b600091825260209091206004600590920201908101549091507f000000000000000000000000000000000000000000000000000000000000000090613599906fffffffffffffffffffffffffffffffff167e09010a0d15021d0b0e10121619031e080c141c0f111807131b17061a05041f7f07c4acdd0000000000000000000000000000000000000000000000000000000067ffffffffffffffff831160061b83811c63ffffffff1060051b1792831c600181901c17600281901c17600481901c17600881901c17601081901c170260fb1c1a1790565b67ffffffffffffffff16116135da576040517fb34b5c2200000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b6000815b60048301547f0000000000000000000000000000000000000000000000000000000000000000906136a1906fffffffffffffffffffffffffffffffff167e09010a0d15021d0b0e10121619031e080c141c0f111807131b17061a05041f7f07c4acdd0000000000000000000000000000000000000000000000000000000067ffffffffffffffff831160061b83811c63ffffffff1060051b1792831c600181901c17600281901c17600481901c17600881901c17601081901c170260fb1c1a1790565b67ffffffffffffffff16925082111561371d57825463ffffffff166136e77f0000000000000000000000000000000000000000000000000000000000000000600161427b565b83036136f1578391505b600181815481106137045761370461421d565b90600052602060002090600502019350809450506135de565b600481810154908401546fffffffffffffffffffffffffffffffff91821691166000816fffffffffffffffffffffffffffffffff16613786613771856fffffffffffffffffffffffffffffffff1660011c90565b6fffffffffffffffffffffffffffffffff1690565b6fffffffffffffffffffffffffffffffff16149050801561384d5760006137be836fffffffffffffffffffffffffffffffff166132e9565b67ffffffffffffffff1611156138215760006137f86137f060016fffffffffffffffffffffffffffffffff8616614362565b89600161338f565b6003810154600490910154909c506fffffffffffffffffffffffffffffffff169a506138279050565b6006549a505b600386015460048701549099506fffffffffffffffffffffffffffffffff1697506138a3565b600061386f6137f06fffffffffffffffffffffffffffffffff85166001614393565b6003808901546004808b015492840154930154909e506fffffffffffffffffffffffffffffffff9182169d50919b50169850505b505050505050509193509193565b60006fffffffffffffffffffffffffffffffff841681036139175782826040516020016138fa9291909182526fffffffffffffffffffffffffffffffff16602082015260400190565b604051602081830303815290604052805190602001209050613968565b60408051602081018790526fffffffffffffffffffffffffffffffff8087169282019290925260608101859052908316608082015260a0016040516020818303038152906040528051906020012090505b949350505050565b6000806139fd847e09010a0d15021d0b0e10121619031e080c141c0f111807131b17061a05041f7f07c4acdd0000000000000000000000000000000000000000000000000000000067ffffffffffffffff831160061b83811c63ffffffff1060051b1792831c600181901c17600281901c17600481901c17600881901c17601081901c170260fb1c1a1790565b67ffffffffffffffff1690508083036001841b600180831b0386831b17039250505092915050565b60028101546fffffffffffffffffffffffffffffffff167fffffffffffffffffffffffffffffffff000000000000000000000000000000018101613a95576040517ff1a9458100000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b600280830180547fffffffffffffffffffffffffffffffff00000000000000000000000000000000166fffffffffffffffffffffffffffffffff17905573ffffffffffffffffffffffffffffffffffffffff84166000908152602091909152604081208054839290613b0890849061427b565b90915550506040517f7eee288d00000000000000000000000000000000000000000000000000000000815273ffffffffffffffffffffffffffffffffffffffff8481166004830152602482018390527f00000000000000000000000000000000000000000000000000000000000000001690637eee288d90604401600060405180830381600087803b158015613b9d57600080fd5b505af1158015612de6573d6000803e3d6000fd5b600081613c50846fffffffffffffffffffffffffffffffff167e09010a0d15021d0b0e10121619031e080c141c0f111807131b17061a05041f7f07c4acdd0000000000000000000000000000000000000000000000000000000067ffffffffffffffff831160061b83811c63ffffffff1060051b1792831c600181901c17600281901c17600481901c17600881901c17601081901c170260fb1c1a1790565b67ffffffffffffffff1611613c91576040517fb34b5c2200000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b613c9a83613d6f565b905081613d39826fffffffffffffffffffffffffffffffff167e09010a0d15021d0b0e10121619031e080c141c0f111807131b17061a05041f7f07c4acdd0000000000000000000000000000000000000000000000000000000067ffffffffffffffff831160061b83811c63ffffffff1060051b1792831c600181901c17600281901c17600481901c17600881901c17601081901c170260fb1c1a1790565b67ffffffffffffffff16116132e3576132e0613d5683600161427b565b6fffffffffffffffffffffffffffffffff831690613e1b565b60008119600183011681613e03827e09010a0d15021d0b0e10121619031e080c141c0f111807131b17061a05041f7f07c4acdd0000000000000000000000000000000000000000000000000000000067ffffffffffffffff831160061b83811c63ffffffff1060051b1792831c600181901c17600281901c17600481901c17600881901c17601081901c170260fb1c1a1790565b67ffffffffffffffff169390931c8015179392505050565b600080613ea8847e09010a0d15021d0b0e10121619031e080c141c0f111807131b17061a05041f7f07c4acdd0000000000000000000000000000000000000000000000000000000067ffffffffffffffff831160061b83811c63ffffffff1060051b1792831c600181901c17600281901c17600481901c17600881901c17601081901c170260fb1c1a1790565b67ffffffffffffffff169050808303600180821b0385821b179250505092915050565b5080546000825590600052602060002090810190613ee99190613eec565b50565b5b80821115613f015760008155600101613eed565b5090565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b6020810160038310613f6f577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b91905290565b60008060408385031215613f8857600080fd5b50508035926020909101359150565b6000815180845260005b81811015613fbd57602081850181015186830182015201613fa1565b81811115613fcf576000602083870101525b50601f017fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0169290920160200192915050565b6020815260006132e06020830184613f97565b73ffffffffffffffffffffffffffffffffffffffff81168114613ee957600080fd5b60006020828403121561404957600080fd5b813561405481614015565b9392505050565b8035801515811461406b57600080fd5b919050565b60008060006060848603121561408557600080fd5b833592506020840135915061409c6040850161405b565b90509250925092565b6000602082840312156140b757600080fd5b81356fffffffffffffffffffffffffffffffff8116811461405457600080fd5b6000602082840312156140e957600080fd5b5035919050565b60008083601f84011261410257600080fd5b50813567ffffffffffffffff81111561411a57600080fd5b60208301915083602082850101111561413257600080fd5b9250929050565b6000806000806000806080878903121561415257600080fd5b863595506141626020880161405b565b9450604087013567ffffffffffffffff8082111561417f57600080fd5b61418b8a838b016140f0565b909650945060608901359150808211156141a457600080fd5b506141b189828a016140f0565b979a9699509497509295939492505050565b6000806000606084860312156141d857600080fd5b505081359360208301359350604090920135919050565b63ffffffff841681528260208201526060604082015260006142146060830184613f97565b95945050505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b6000821982111561428e5761428e61424c565b500190565b6000828210156142a5576142a561424c565b500390565b600080604083850312156142bd57600080fd5b505080516020909101519092909150565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b60008261430c5761430c6142ce565b500490565b6000817fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff04831182151516156143495761434961424c565b500290565b60008261435d5761435d6142ce565b500690565b60006fffffffffffffffffffffffffffffffff8381169083168181101561438b5761438b61424c565b039392505050565b60006fffffffffffffffffffffffffffffffff8083168185168083038211156143be576143be61424c565b01949350505050565b8183823760009101908152919050565b8183528181602085013750600060208284010152600060207fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f840116840101905092915050565b6060815260006144346060830187896143d7565b82810360208401526144478186886143d7565b9150508260408301529695505050505050565b60006020828403121561446c57600080fd5b5051919050565b600067ffffffffffffffff8381169083168181101561438b5761438b61424c565b600067ffffffffffffffff808416806144af576144af6142ce565b92169190910692915050565b6000602082840312156144cd57600080fd5b815161405481614015565b600067ffffffffffffffff8083168185168083038211156143be576143be61424c565b60007fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff820361452c5761452c61424c565b5060010190565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b60007f7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff6000841360008413858304851182821616156145a3576145a361424c565b7f800000000000000000000000000000000000000000000000000000000000000060008712868205881281841616156145de576145de61424c565b600087129250878205871284841616156145fa576145fa61424c565b878505871281841616156146105761461061424c565b505050929093029392505050565b60008261462d5761462d6142ce565b7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff83147f8000000000000000000000000000000000000000000000000000000000000000831416156146815761468161424c565b50059056fea164736f6c634300080f000a"
 
-
 func init() {
 	if err := json.Unmarshal([]byte(FaultDisputeGameStorageLayoutJSON), FaultDisputeGameStorageLayout); err != nil {
 		panic(err)
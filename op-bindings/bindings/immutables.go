@@ -0,0 +1,68 @@
+package bindings
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// readImmutable returns the 32 byte word an immutable is stored as in the given deployed bytecode.
+// The compiler inlines an immutable at every place it is referenced, so all references must hold the same value.
+func readImmutable(deployedBin []byte, name string, offsets []uint) ([32]byte, error) {
+	var word [32]byte
+	if len(offsets) == 0 {
+		return word, fmt.Errorf("%s: no immutable references", name)
+	}
+	for i, offset := range offsets {
+		if offset+32 > uint(len(deployedBin)) {
+			return word, fmt.Errorf("%s: immutable reference at %d is out of bounds of %d bytes of bytecode", name, offset, len(deployedBin))
+		}
+		var ref [32]byte
+		copy(ref[:], deployedBin[offset:offset+32])
+		if i == 0 {
+			word = ref
+		} else if ref != word {
+			return word, fmt.Errorf("%s: immutable references at %d and %d hold different values", name, offsets[0], offset)
+		}
+	}
+	return word, nil
+}
+
+// immutableAddress decodes an address immutable from deployed bytecode.
+func immutableAddress(deployedBin []byte, name string, offsets []uint) (common.Address, error) {
+	word, err := readImmutable(deployedBin, name, offsets)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if common.BytesToHash(word[:12]) != (common.Hash{}) {
+		return common.Address{}, fmt.Errorf("%s: immutable is not a valid address: %x", name, word)
+	}
+	return common.BytesToAddress(word[12:]), nil
+}
+
+// immutableUint decodes an unsigned integer, or enum, immutable from deployed bytecode.
+func immutableUint(deployedBin []byte, name string, offsets []uint) (*big.Int, error) {
+	word, err := readImmutable(deployedBin, name, offsets)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(word[:]), nil
+}
+
+// immutableBool decodes a boolean immutable from deployed bytecode.
+func immutableBool(deployedBin []byte, name string, offsets []uint) (bool, error) {
+	word, err := readImmutable(deployedBin, name, offsets)
+	if err != nil {
+		return false, err
+	}
+	if common.BytesToHash(word[:31]) != (common.Hash{}) || word[31] > 1 {
+		return false, fmt.Errorf("%s: immutable is not a valid bool: %x", name, word)
+	}
+	return word[31] == 1, nil
+}
+
+// immutableBytes32 decodes a bytes32 immutable from deployed bytecode.
+func immutableBytes32(deployedBin []byte, name string, offsets []uint) ([32]byte, error) {
+	return readImmutable(deployedBin, name, offsets)
+}
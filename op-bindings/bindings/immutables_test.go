@@ -0,0 +1,61 @@
+package bindings
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeImmutables(t *testing.T) {
+	// the immutables of SequencerFeeVault, referenced from multiple places in the bytecode
+	minWithdrawalAmount := new(big.Int).Mul(big.NewInt(10), big.NewInt(params.Ether))
+	recipient := common.HexToAddress("0x4200000000000000000000000000000000000011")
+	minWithdrawalAmountOffsets := []uint{10, 100}
+	recipientOffsets := []uint{50, 150, 200}
+	withdrawalNetworkOffsets := []uint{250}
+
+	deployedBin := make([]byte, 300)
+	for _, offset := range minWithdrawalAmountOffsets {
+		copy(deployedBin[offset:], common.BigToHash(minWithdrawalAmount).Bytes())
+	}
+	for _, offset := range recipientOffsets {
+		copy(deployedBin[offset:], common.BytesToHash(recipient.Bytes()).Bytes())
+	}
+	deployedBin[withdrawalNetworkOffsets[0]+31] = 1
+
+	amount, err := immutableUint(deployedBin, "MIN_WITHDRAWAL_AMOUNT", minWithdrawalAmountOffsets)
+	require.NoError(t, err)
+	require.Equal(t, minWithdrawalAmount, amount)
+
+	addr, err := immutableAddress(deployedBin, "RECIPIENT", recipientOffsets)
+	require.NoError(t, err)
+	require.Equal(t, recipient, addr)
+
+	network, err := immutableUint(deployedBin, "WITHDRAWAL_NETWORK", withdrawalNetworkOffsets)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1), network)
+
+	flag, err := immutableBool(deployedBin, "WITHDRAWAL_NETWORK", withdrawalNetworkOffsets)
+	require.NoError(t, err)
+	require.True(t, flag)
+
+	t.Run("InconsistentReferences", func(t *testing.T) {
+		_, err := immutableUint(deployedBin, "MIN_WITHDRAWAL_AMOUNT", []uint{10, 50})
+		require.ErrorContains(t, err, "hold different values")
+	})
+
+	t.Run("OutOfBounds", func(t *testing.T) {
+		_, err := immutableAddress(deployedBin, "RECIPIENT", []uint{290})
+		require.ErrorContains(t, err, "out of bounds")
+	})
+
+	t.Run("InvalidAddress", func(t *testing.T) {
+		word := make([]byte, 32)
+		word[0] = 1
+		_, err := immutableAddress(word, "RECIPIENT", []uint{0})
+		require.ErrorContains(t, err, "not a valid address")
+	})
+}
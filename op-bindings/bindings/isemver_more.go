@@ -15,7 +15,6 @@ var ISemverStorageLayout = new(solc.StorageLayout)
 
 var ISemverDeployedBin = "0x"
 
-
 func init() {
 	if err := json.Unmarshal([]byte(ISemverStorageLayoutJSON), ISemverStorageLayout); err != nil {
 		panic(err)
@@ -1,12 +1,17 @@
 package bindings
 
 import (
+	"encoding/json"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/ethereum-optimism/superchain-registry/superchain"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -25,6 +30,14 @@ var deployers = make(map[string]string)
 // in an init function.
 var immutableReferences = make(map[string]bool)
 
+// immutableReferenceOffsets represents the offsets of the immutables in the deployed bytecodes,
+// by contract name and AST ID of the immutable. It is populated in an init function.
+var immutableReferenceOffsets = make(map[string]map[string][]solc.LinkReferenceOffset)
+
+// abis represents the set of ABIs, by contract name, as the metadata of the bindings,
+// which parse their ABI on first use. It is populated in an init function.
+var abis = make(map[string]*bind.MetaData)
+
 // Create2DeployerCodeHash represents the codehash of the Create2Deployer contract.
 var Create2DeployerCodeHash = common.HexToHash("0xb0550b5b431e30d38000efb7107aaa0ade03d48a7198a140edda9d27134468b2")
 
@@ -36,6 +49,33 @@ func init() {
 	deployedBytecodes["Create2Deployer"] = common.Bytes2Hex(code)
 }
 
+// ListContracts returns the sorted names of the contracts with a storage layout or deployed bytecode.
+// Not every listed contract has a storage layout, e.g. the Create2Deployer only has deployed bytecode.
+func ListContracts() []string {
+	names := make(map[string]struct{}, len(deployedBytecodes))
+	for name := range layouts {
+		names[name] = struct{}{}
+	}
+	for name := range deployedBytecodes {
+		names[name] = struct{}{}
+	}
+	out := make([]string, 0, len(names))
+	for name := range names {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// ABIByName returns the parsed ABI of a contract by name.
+func ABIByName(name string) (*abi.ABI, error) {
+	metadata, ok := abis[name]
+	if !ok {
+		return nil, fmt.Errorf("%s: ABI not found", name)
+	}
+	return metadata.GetAbi()
+}
+
 // GetStorageLayout returns the storage layout of a contract by name.
 func GetStorageLayout(name string) (*solc.StorageLayout, error) {
 	layout := layouts[name]
@@ -68,6 +108,77 @@ func HasImmutableReferences(name string) (bool, error) {
 	return has, nil
 }
 
+// GetImmutableReferences returns the offsets of the immutables in the deployed bytecode of a contract by name,
+// keyed by the AST ID of the immutable. The returned offsets are a copy, so they may be modified by the caller.
+func GetImmutableReferences(name string) (map[string][]solc.LinkReferenceOffset, error) {
+	refs, ok := immutableReferenceOffsets[name]
+	if !ok {
+		return nil, fmt.Errorf("%s: immutable references not found", name)
+	}
+	out := make(map[string][]solc.LinkReferenceOffset, len(refs))
+	for id, offsets := range refs {
+		out[id] = slices.Clone(offsets)
+	}
+	return out, nil
+}
+
+// registerImmutableReferences registers the immutable references of a contract, given as the solc JSON output,
+// which maps the AST IDs of the immutables to their offsets in the deployed bytecode.
+// It panics if any reference is out of bounds of the deployed bytecode, so bad generations are caught at init.
+func registerImmutableReferences(name string, deployedBin string, refsJSON string) {
+	var refs map[string][]solc.LinkReferenceOffset
+	if err := json.Unmarshal([]byte(refsJSON), &refs); err != nil {
+		panic(fmt.Errorf("%s: invalid immutable references: %w", name, err))
+	}
+	size := uint(len(common.FromHex(deployedBin)))
+	for id, offsets := range refs {
+		for _, offset := range offsets {
+			if offset.Start+offset.Length > size {
+				panic(fmt.Errorf("%s: immutable reference %s at %d with length %d is out of bounds of %d bytes of deployed bytecode",
+					name, id, offset.Start, offset.Length, size))
+			}
+		}
+	}
+	immutableReferenceOffsets[name] = refs
+}
+
+// PatchImmutables returns the deployed bytecode of a contract by name, with the given immutable values patched in.
+// The values are keyed by the AST ID of the immutable, like the immutable references of the contract,
+// and must be as long as the references, commonly a 32 byte word, e.g. a left-padded address.
+// A value must be given for every immutable of the contract, and only for those.
+func PatchImmutables(name string, values map[string][]byte) ([]byte, error) {
+	code, err := GetDeployedBytecode(name)
+	if err != nil {
+		return nil, err
+	}
+	refs, ok := immutableReferenceOffsets[name]
+	if !ok {
+		if immutableReferences[name] {
+			return nil, fmt.Errorf("%s: immutable references not found, its metadata must be regenerated to register them", name)
+		}
+		return nil, fmt.Errorf("%s: immutable references not found", name)
+	}
+	for id := range values {
+		if _, ok := refs[id]; !ok {
+			return nil, fmt.Errorf("%s: no immutable with AST ID %s", name, id)
+		}
+	}
+	for id, offsets := range refs {
+		value, ok := values[id]
+		if !ok {
+			return nil, fmt.Errorf("%s: missing value of immutable with AST ID %s", name, id)
+		}
+		for _, offset := range offsets {
+			if uint(len(value)) != offset.Length {
+				return nil, fmt.Errorf("%s: value of immutable with AST ID %s is %d bytes, but its reference at %d is %d bytes",
+					name, id, len(value), offset.Start, offset.Length)
+			}
+			copy(code[offset.Start:offset.Start+offset.Length], value)
+		}
+	}
+	return code, nil
+}
+
 func GetInitBytecode(name string) ([]byte, error) {
 	bc := initBytecodes[name]
 	if bc == "" {
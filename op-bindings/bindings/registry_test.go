@@ -0,0 +1,160 @@
+package bindings
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+)
+
+func TestListContracts(t *testing.T) {
+	names := ListContracts()
+	require.True(t, sort.StringsAreSorted(names))
+	require.Contains(t, names, "AddressManager")
+	require.Contains(t, names, "Create2Deployer")
+
+	for _, name := range names {
+		_, err := GetDeployedBytecode(name)
+		require.NoError(t, err, name)
+	}
+	layout, err := GetStorageLayout("AddressManager")
+	require.NoError(t, err)
+	require.NotEmpty(t, layout.Storage)
+	_, err = GetStorageLayout("Create2Deployer")
+	require.Error(t, err)
+}
+
+func TestValidateImmutableReferences(t *testing.T) {
+	deployedBin := "0x" + strings.Repeat("00", 64)
+	require.NotPanics(t, func() {
+		registerImmutableReferences("Test", deployedBin, `{"101":[{"start":0,"length":32},{"start":32,"length":32}]}`)
+	})
+	require.NotPanics(t, func() {
+		registerImmutableReferences("Test", deployedBin, `{}`)
+	})
+	require.PanicsWithError(t, "Test: immutable reference 102 at 40 with length 32 is out of bounds of 64 bytes of deployed bytecode", func() {
+		registerImmutableReferences("Test", deployedBin, `{"101":[{"start":0,"length":32}],"102":[{"start":40,"length":32}]}`)
+	})
+	require.Panics(t, func() {
+		registerImmutableReferences("Test", deployedBin, `not json`)
+	})
+}
+
+func TestPatchImmutables(t *testing.T) {
+	deployedBin := "0x" + strings.Repeat("00", 96)
+	deployedBytecodes["PatchTest"] = deployedBin
+	registerImmutableReferences("PatchTest", deployedBin, `{"101":[{"start":0,"length":32},{"start":64,"length":32}],"102":[{"start":32,"length":32}]}`)
+	t.Cleanup(func() {
+		delete(deployedBytecodes, "PatchTest")
+		delete(immutableReferenceOffsets, "PatchTest")
+	})
+
+	owner := common.HexToAddress("0x4200000000000000000000000000000000000011")
+	amount := common.BigToHash(big.NewInt(42))
+	code, err := PatchImmutables("PatchTest", map[string][]byte{
+		"101": common.BytesToHash(owner.Bytes()).Bytes(),
+		"102": amount.Bytes(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, owner, common.BytesToAddress(code[12:32]))
+	require.Equal(t, amount.Bytes(), code[32:64])
+	require.Equal(t, owner, common.BytesToAddress(code[76:96]))
+	// the registered bytecode is not modified
+	require.Equal(t, deployedBin, deployedBytecodes["PatchTest"])
+
+	_, err = PatchImmutables("PatchTest", map[string][]byte{"101": make([]byte, 32)})
+	require.ErrorContains(t, err, "missing value of immutable with AST ID 102")
+	_, err = PatchImmutables("PatchTest", map[string][]byte{"101": make([]byte, 32), "102": make([]byte, 32), "103": make([]byte, 32)})
+	require.ErrorContains(t, err, "no immutable with AST ID 103")
+	_, err = PatchImmutables("PatchTest", map[string][]byte{"101": make([]byte, 20), "102": make([]byte, 32)})
+	require.ErrorContains(t, err, "is 20 bytes, but its reference at 0 is 32 bytes")
+	_, err = PatchImmutables("AddressManager", nil)
+	require.ErrorContains(t, err, "immutable references not found")
+
+	// a contract with immutables whose references were not registered by its metadata
+	deployedBytecodes["StaleTest"] = deployedBin
+	immutableReferences["StaleTest"] = true
+	t.Cleanup(func() {
+		delete(deployedBytecodes, "StaleTest")
+		delete(immutableReferences, "StaleTest")
+	})
+	_, err = PatchImmutables("StaleTest", nil)
+	require.ErrorContains(t, err, "its metadata must be regenerated")
+}
+
+func TestPatchImmutablesOfBindings(t *testing.T) {
+	// the bindings with immutables include SequencerFeeVault, whose immutables are set in the L2 genesis
+	require.True(t, immutableReferences["SequencerFeeVault"])
+	for _, name := range ListContracts() {
+		if !immutableReferences[name] {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			refs, err := GetImmutableReferences(name)
+			if err != nil {
+				t.Skipf("immutable references are not registered, regenerate the metadata with make bindgen-storage-layouts: %v", err)
+			}
+			values := make(map[string][]byte, len(refs))
+			for id, offsets := range refs {
+				values[id] = bytes.Repeat([]byte{0xff}, int(offsets[0].Length))
+			}
+			code, err := PatchImmutables(name, values)
+			require.NoError(t, err)
+			deployed, err := GetDeployedBytecode(name)
+			require.NoError(t, err)
+			require.Len(t, code, len(deployed))
+			for id, offsets := range refs {
+				for _, offset := range offsets {
+					require.Equal(t, values[id], code[offset.Start:offset.Start+offset.Length])
+				}
+			}
+		})
+	}
+}
+
+func TestGetImmutableReferences(t *testing.T) {
+	deployedBin := "0x" + strings.Repeat("00", 64)
+	deployedBytecodes["RefsTest"] = deployedBin
+	registerImmutableReferences("RefsTest", deployedBin, `{"101":[{"start":0,"length":32}],"102":[{"start":32,"length":32}]}`)
+	t.Cleanup(func() {
+		delete(deployedBytecodes, "RefsTest")
+		delete(immutableReferenceOffsets, "RefsTest")
+	})
+
+	refs, err := GetImmutableReferences("RefsTest")
+	require.NoError(t, err)
+	require.Equal(t, map[string][]solc.LinkReferenceOffset{
+		"101": {{Start: 0, Length: 32}},
+		"102": {{Start: 32, Length: 32}},
+	}, refs)
+
+	// the returned references are a copy, modifying them does not affect the registry
+	refs["101"][0].Start = 32
+	delete(refs, "102")
+	again, err := GetImmutableReferences("RefsTest")
+	require.NoError(t, err)
+	require.Equal(t, uint(0), again["101"][0].Start)
+	require.Contains(t, again, "102")
+
+	_, err = GetImmutableReferences("AddressManager")
+	require.ErrorContains(t, err, "immutable references not found")
+}
+
+func TestABIByName(t *testing.T) {
+	l1Block, err := ABIByName("L1Block")
+	require.NoError(t, err)
+	require.Contains(t, l1Block.Methods, "setL1BlockValues")
+
+	safe, err := ABIByName("Safe_v130")
+	require.NoError(t, err)
+	require.Contains(t, safe.Methods, "execTransaction")
+
+	_, err = ABIByName("Unknown")
+	require.ErrorContains(t, err, "Unknown: ABI not found")
+}
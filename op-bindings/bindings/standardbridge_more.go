@@ -15,7 +15,6 @@ var StandardBridgeStorageLayout = new(solc.StorageLayout)
 
 var StandardBridgeDeployedBin = "0x"
 
-
 func init() {
 	if err := json.Unmarshal([]byte(StandardBridgeStorageLayoutJSON), StandardBridgeStorageLayout); err != nil {
 		panic(err)
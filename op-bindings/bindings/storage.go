@@ -0,0 +1,53 @@
+package bindings
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// storageSlot returns the storage slot with the given number, as assigned in a storage layout.
+func storageSlot(slot uint64) common.Hash {
+	return common.BigToHash(new(big.Int).SetUint64(slot))
+}
+
+// mappingSlot returns the storage slot of the value of the given encoded key, in the mapping stored at the given slot.
+// Solidity stores the value at keccak256(key . slot), see storageKeyAddress and friends for the key encodings.
+func mappingSlot(key []byte, slot common.Hash) common.Hash {
+	return crypto.Keccak256Hash(key, slot.Bytes())
+}
+
+// storageKeyAddress encodes an address mapping key, left-padded to 32 bytes.
+func storageKeyAddress(key common.Address) []byte {
+	return common.BytesToHash(key.Bytes()).Bytes()
+}
+
+// storageKeyUint encodes an unsigned integer, or enum, mapping key, as a 32 byte big-endian word.
+func storageKeyUint(key *big.Int) []byte {
+	return common.BigToHash(key).Bytes()
+}
+
+// storageKeyBool encodes a boolean mapping key, as a 32 byte word.
+func storageKeyBool(key bool) []byte {
+	var word common.Hash
+	if key {
+		word[31] = 1
+	}
+	return word.Bytes()
+}
+
+// storageKeyBytes32 encodes a bytes32 mapping key, which is used as-is.
+func storageKeyBytes32(key common.Hash) []byte {
+	return key.Bytes()
+}
+
+// storageKeyBytes encodes a bytes mapping key, which is not padded.
+func storageKeyBytes(key []byte) []byte {
+	return key
+}
+
+// storageKeyString encodes a string mapping key, which is not padded.
+func storageKeyString(key string) []byte {
+	return []byte(key)
+}
@@ -0,0 +1,28 @@
+package bindings
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMappingSlot(t *testing.T) {
+	slot := storageSlot(3)
+	require.Equal(t, common.HexToHash("0x03"), slot)
+
+	// value-type keys are padded to 32 bytes, and prepended to the slot
+	owner := common.HexToAddress("0x4200000000000000000000000000000000000011")
+	expected := crypto.Keccak256Hash(common.FromHex("0x0000000000000000000000004200000000000000000000000000000000000011" +
+		"0000000000000000000000000000000000000000000000000000000000000003"))
+	require.Equal(t, expected, mappingSlot(storageKeyAddress(owner), slot))
+
+	require.Equal(t, common.HexToHash("0x2a").Bytes(), storageKeyUint(big.NewInt(42)))
+	require.Equal(t, common.HexToHash("0x01").Bytes(), storageKeyBool(true))
+	require.Equal(t, common.Hash{}.Bytes(), storageKeyBool(false))
+
+	// dynamic keys are not padded
+	require.Equal(t, crypto.Keccak256Hash([]byte("abc"), slot.Bytes()), mappingSlot(storageKeyString("abc"), slot))
+}
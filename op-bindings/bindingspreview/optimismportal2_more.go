@@ -15,7 +15,6 @@ var OptimismPortal2StorageLayout = new(solc.StorageLayout)
 
 var OptimismPortal2DeployedBin = "0x6080604052600436106101c65760003560e01c80637fc48504116100f7578063a3860f4811610095578063cff0ab9611610064578063cff0ab9614610689578063e9e05c421461072a578063f04987501461073d578063f2b4e6171461076857600080fd5b8063a3860f481461056f578063bb2c727e1461058f578063bf653a5c14610636578063c0c53b8b1461066957600080fd5b8063952b2797116100d1578063952b2797146104bf5780639bf62d82146104f2578063a14238e71461051f578063a35d99df1461054f57600080fd5b80637fc485041461047f5780638b4c40b0146101eb5780638c3152e91461049f57600080fd5b80634fd0434c116101645780635c975abb1161013e5780635c975abb1461041557806371c1566e1461042a578063724c184c1461044a5780637d6be8dc1461045f57600080fd5b80634fd0434c14610342578063513747ab1461038457806354fd4d50146103bf57600080fd5b806343ca1c50116101a057806343ca1c50146102ad578063452a9320146102cd57806345884d32146102e25780634870496f1461032257600080fd5b806333d7e2bd146101f257806335e80ab3146102495780633c9f397c1461027b57600080fd5b366101ed576101eb3334620186a0600060405180602001604052806000815250610795565b005b600080fd5b3480156101fe57600080fd5b5060375461021f9073ffffffffffffffffffffffffffffffffffffffff1681565b60405173ffffffffffffffffffffffffffffffffffffffff90911681526020015b60405180910390f35b34801561025557600080fd5b5060355461021f90610100900473ffffffffffffffffffffffffffffffffffffffff1681565b34801561028757600080fd5b50603b546102989063ffffffff1681565b60405163ffffffff9091168152602001610240565b3480156102b957600080fd5b506101eb6102c83660046153c1565b610a30565b3480156102d957600080fd5b5061021f610d0f565b3480156102ee57600080fd5b506103126102fd366004615413565b603a6020526000908152604090205460ff1681565b6040519015158152602001610240565b34801561032e57600080fd5b506101eb61033d366004615430565b610da7565b34801561034e57600080fd5b50603b5461036b90640100000000900467ffffffffffffffff1681565b60405167ffffffffffffffff9091168152602001610240565b34801561039057600080fd5b506103b161039f36600461550c565b6000908152603c602052604090205490565b604051908152602001610240565b3480156103cb57600080fd5b506104086040518060400160405280600581526020017f332e352e3000000000000000000000000000000000000000000000000000000081525081565b604051610240919061559b565b34801561042157600080fd5b506103126117bb565b34801561043657600080fd5b506101eb6104453660046155ae565b61184e565b34801561045657600080fd5b5061021f6120ac565b34801561046b57600080fd5b506101eb61047a366004615413565b6120b6565b34801561048b57600080fd5b506101eb61049a3660046155e5565b6121c7565b3480156104ab57600080fd5b506101eb6104ba366004615602565b6122fd565b3480156104cb57600080fd5b507f00000000000000000000000000000000000000000000000000000000000000006103b1565b3480156104fe57600080fd5b5060325461021f9073ffffffffffffffffffffffffffffffffffffffff1681565b34801561052b57600080fd5b5061031261053a36600461550c565b60336020526000908152604090205460ff1681565b34801561055b57600080fd5b5061036b61056a366004615655565b612379565b34801561057b57600080fd5b5061021f61058a366004615672565b612398565b34801561059b57600080fd5b506106016105aa3660046155ae565b603960209081526000928352604080842090915290825290205473ffffffffffffffffffffffffffffffffffffffff81169074010000000000000000000000000000000000000000900467ffffffffffffffff1682565b6040805173ffffffffffffffffffffffffffffffffffffffff909316835267ffffffffffffffff909116602083015201610240565b34801561064257600080fd5b507f00000000000000000000000000000000000000000000000000000000000000006103b1565b34801561067557600080fd5b506101eb610684366004615694565b6123dd565b34801561069557600080fd5b506001546106f1906fffffffffffffffffffffffffffffffff81169067ffffffffffffffff7001000000000000000000000000000000008204811691780100000000000000000000000000000000000000000000000090041683565b604080516fffffffffffffffffffffffffffffffff909416845267ffffffffffffffff9283166020850152911690820152606001610240565b6101eb6107383660046156ed565b610795565b34801561074957600080fd5b5060375473ffffffffffffffffffffffffffffffffffffffff1661021f565b34801561077457600080fd5b5060385461021f9073ffffffffffffffffffffffffffffffffffffffff1681565b8260005a9050831561084c5773ffffffffffffffffffffffffffffffffffffffff87161561084c57604080517f08c379a00000000000000000000000000000000000000000000000000000000081526020600482015260248101919091527f4f7074696d69736d506f7274616c3a206d7573742073656e6420746f2061646460448201527f72657373283029207768656e206372656174696e67206120636f6e747261637460648201526084015b60405180910390fd5b6108568351612379565b67ffffffffffffffff168567ffffffffffffffff1610156108f9576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152602360248201527f4f7074696d69736d506f7274616c3a20676173206c696d697420746f6f20736d60448201527f616c6c00000000000000000000000000000000000000000000000000000000006064820152608401610843565b6201d4c083511115610967576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601e60248201527f4f7074696d69736d506f7274616c3a206461746120746f6f206c6172676500006044820152606401610843565b33328114610988575033731111000000000000000000000000000000001111015b600034888888886040516020016109a395949392919061576c565b604051602081830303815290604052905060008973ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff167fb3813568d9991fc951961fcb4c784893574240a28925604d09fc577c55bb7c3284604051610a13919061559b565b60405180910390a45050610a278282612646565b50505050505050565b610a386117bb565b15610a9f576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601660248201527f4f7074696d69736d506f7274616c3a20706175736564000000000000000000006044820152606401610843565b60325473ffffffffffffffffffffffffffffffffffffffff1661dead14610b48576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603f60248201527f4f7074696d69736d506f7274616c3a2063616e206f6e6c79207472696767657260448201527f206f6e65207769746864726177616c20706572207472616e73616374696f6e006064820152608401610843565b6000610b5383612973565b9050610b5f818361184e565b600081815260336020908152604080832080547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00166001179055908501516032805473ffffffffffffffffffffffffffffffffffffffff9092167fffffffffffffffffffffffff00000000000000000000000000000000000000009092169190911790558401516080850151606086015160a0870151610c01939291906129c0565b603280547fffffffffffffffffffffffff00000000000000000000000000000000000000001661dead17905560405190915082907fdb5c7652857aa163daadd670e116628fb42e869d8ac4251ef8971d9e5727df1b90610c6690841515815260200190565b60405180910390a280158015610c7c5750326001145b15610d09576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152602160248201527f4f7074696d69736d506f7274616c3a207769746864726177616c206661696c6560448201527f64000000000000000000000000000000000000000000000000000000000000006064820152608401610843565b50505050565b6000603560019054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1663452a93206040518163ffffffff1660e01b8152600401602060405180830381865afa158015610d7e573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610da291906157d1565b905090565b610daf6117bb565b15610e16576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601660248201527f4f7074696d69736d506f7274616c3a20706175736564000000000000000000006044820152606401610843565b3073ffffffffffffffffffffffffffffffffffffffff16856040015173ffffffffffffffffffffffffffffffffffffffff1603610ed5576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603f60248201527f4f7074696d69736d506f7274616c3a20796f752063616e6e6f742073656e642060448201527f6d6573736167657320746f2074686520706f7274616c20636f6e7472616374006064820152608401610843565b6038546040517fbb8aa1fc00000000000000000000000000000000000000000000000000000000815260048101869052600091829173ffffffffffffffffffffffffffffffffffffffff9091169063bb8aa1fc90602401606060405180830381865afa158015610f49573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610f6d91906157ee565b925050915060008173ffffffffffffffffffffffffffffffffffffffff1663bcef3b556040518163ffffffff1660e01b8152600401602060405180830381865afa158015610fbf573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610fe39190615830565b603b5490915060ff84811691161461107d576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152602160248201527f4f7074696d69736d506f7274616c3a20696e76616c69642067616d652074797060448201527f65000000000000000000000000000000000000000000000000000000000000006064820152608401610843565b61109461108f36889003880188615849565b612a1e565b8114611122576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152602960248201527f4f7074696d69736d506f7274616c3a20696e76616c6964206f7574707574207260448201527f6f6f742070726f6f6600000000000000000000000000000000000000000000006064820152608401610843565b600061112d89612973565b600081815260396020908152604080832033845282529182902082518084019093525473ffffffffffffffffffffffffffffffffffffffff8116835274010000000000000000000000000000000000000000900467ffffffffffffffff169082015290915060018473ffffffffffffffffffffffffffffffffffffffff1663200d2ed26040518163ffffffff1660e01b8152600401602060405180830381865afa1580156111df573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061120391906158de565b6002811115611214576112146158af565b036112a1576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603a60248201527f4f7074696d69736d506f7274616c3a2063616e6e6f742070726f76652061676160448201527f696e737420696e76616c696420646973707574652067616d65730000000000006064820152608401610843565b8051602082015167ffffffffffffffff16158061133d575060018173ffffffffffffffffffffffffffffffffffffffff1663200d2ed26040518163ffffffff1660e01b8152600401602060405180830381865afa158015611306573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061132a91906158de565b600281111561133b5761133b6158af565b145b8061136d575073ffffffffffffffffffffffffffffffffffffffff81166000908152603a602052604090205460ff165b806114005750603b5463ffffffff1660ff166113fa8273ffffffffffffffffffffffffffffffffffffffff1663bbdc02db6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156113cd573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906113f191906158ff565b63ffffffff1690565b60ff1614155b6114b2576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152606060248201527f4f7074696d69736d506f7274616c3a207769746864726177616c20686173682060448201527f68617320616c7265616479206265656e2070726f76656e2c20616e642074686560648201527f206f6c6420646973707574652067616d65206973206e6f7420696e76616c6964608482015260a401610843565b60408051602081018590526000918101829052606001604080517fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe08184030181528282528051602091820120908301819052925061157b9101604080517fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0818403018152828201909152600182527f01000000000000000000000000000000000000000000000000000000000000006020830152906115718b8d61591c565b8d60400135612a5d565b611607576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603260248201527f4f7074696d69736d506f7274616c3a20696e76616c696420776974686472617760448201527f616c20696e636c7573696f6e2070726f6f6600000000000000000000000000006064820152608401610843565b60405180604001604052808773ffffffffffffffffffffffffffffffffffffffff1681526020014267ffffffffffffffff168152506039600086815260200190815260200160002060003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008201518160000160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555060208201518160000160146101000a81548167ffffffffffffffff021916908367ffffffffffffffff1602179055509050508b6040015173ffffffffffffffffffffffffffffffffffffffff168c6020015173ffffffffffffffffffffffffffffffffffffffff16857f67a6208cfcc0801d50f6cbe764733f4fddf66ac0b04442061a8a8c0cb6b63f6260405160405180910390a45050506000908152603c602090815260408220805460018101825590835291200180547fffffffffffffffffffffffff000000000000000000000000000000000000000016331790555050505050505050565b6000603560019054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16635c975abb6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561182a573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610da291906159a0565b600082815260396020908152604080832073ffffffffffffffffffffffffffffffffffffffff85811685529083528184208251808401845290549182168082527401000000000000000000000000000000000000000090920467ffffffffffffffff1681850152818552603a90935292205490919060ff1615611953576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603160248201527f4f7074696d69736d506f7274616c3a20646973707574652067616d652068617360448201527f206265656e20626c61636b6c69737465640000000000000000000000000000006064820152608401610843565b816020015167ffffffffffffffff16600003611a17576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604d60248201527f4f7074696d69736d506f7274616c3a207769746864726177616c20686173206e60448201527f6f74206265656e2070726f76656e2062792070726f6f66207375626d6974746560648201527f7220616464726573732079657400000000000000000000000000000000000000608482015260a401610843565b6000611a988273ffffffffffffffffffffffffffffffffffffffff1663cf09e0d06040518163ffffffff1660e01b8152600401602060405180830381865afa158015611a67573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611a8b91906159bd565b67ffffffffffffffff1690565b90508067ffffffffffffffff16836020015167ffffffffffffffff1611611b67576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604e60248201527f4f7074696d69736d506f7274616c3a207769746864726177616c2074696d657360448201527f74616d70206c657373207468616e20646973707574652067616d65206372656160648201527f74696f6e2074696d657374616d70000000000000000000000000000000000000608482015260a401610843565b7f0000000000000000000000000000000000000000000000000000000000000000836020015167ffffffffffffffff1642611ba29190615a09565b11611c2f576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603560248201527f4f7074696d69736d506f7274616c3a2070726f76656e2077697468647261776160448201527f6c20686173206e6f74206d6174757265642079657400000000000000000000006064820152608401610843565b60028273ffffffffffffffffffffffffffffffffffffffff1663200d2ed26040518163ffffffff1660e01b8152600401602060405180830381865afa158015611c7c573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611ca091906158de565b6002811115611cb157611cb16158af565b14611d3e576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603660248201527f4f7074696d69736d506f7274616c3a206f75747075742070726f706f73616c2060448201527f686173206e6f74206265656e2076616c696461746564000000000000000000006064820152608401610843565b603b5463ffffffff1660ff16611d988373ffffffffffffffffffffffffffffffffffffffff1663bbdc02db6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156113cd573d6000803e3d6000fd5b60ff1614611e28576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152602160248201527f4f7074696d69736d506f7274616c3a20696e76616c69642067616d652074797060448201527f65000000000000000000000000000000000000000000000000000000000000006064820152608401610843565b603b5467ffffffffffffffff64010000000090910481169082161015611ef6576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604b60248201527f4f7074696d69736d506f7274616c3a20646973707574652067616d652063726560448201527f61746564206265666f7265207265737065637465642067616d6520747970652060648201527f7761732075706461746564000000000000000000000000000000000000000000608482015260a401610843565b7f0000000000000000000000000000000000000000000000000000000000000000611f658373ffffffffffffffffffffffffffffffffffffffff166319effeb46040518163ffffffff1660e01b8152600401602060405180830381865afa158015611a67573d6000803e3d6000fd5b611f799067ffffffffffffffff1642615a09565b11612006576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152602a60248201527f4f7074696d69736d506f7274616c3a206f75747075742070726f706f73616c2060448201527f696e206169722d676170000000000000000000000000000000000000000000006064820152608401610843565b60008581526033602052604090205460ff16156120a5576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603560248201527f4f7074696d69736d506f7274616c3a207769746864726177616c20686173206160448201527f6c7265616479206265656e2066696e616c697a656400000000000000000000006064820152608401610843565b5050505050565b6000610da2610d0f565b6120be610d0f565b73ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff1614612178576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603d60248201527f4f7074696d69736d506f7274616c3a206f6e6c7920746865206775617264696160448201527f6e2063616e20626c61636b6c69737420646973707574652067616d65730000006064820152608401610843565b73ffffffffffffffffffffffffffffffffffffffff166000908152603a6020526040902080547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00166001179055565b6121cf610d0f565b73ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff16146122af576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604160248201527f4f7074696d69736d506f7274616c3a206f6e6c7920746865206775617264696160448201527f6e2063616e2073657420746865207265737065637465642067616d652074797060648201527f6500000000000000000000000000000000000000000000000000000000000000608482015260a401610843565b603b805463ffffffff929092167fffffffffffffffffffffffffffffffffffffffff000000000000000000000000909216919091176401000000004267ffffffffffffffff1602179055565b565b6123056117bb565b1561236c576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601660248201527f4f7074696d69736d506f7274616c3a20706175736564000000000000000000006044820152606401610843565b6123768133610a30565b50565b6000612386826010615a20565b61239290615208615a50565b92915050565b603c60205281600052604060002081815481106123b457600080fd5b60009182526020909120015473ffffffffffffffffffffffffffffffffffffffff169150829050565b600054610100900460ff16158080156123fd5750600054600160ff909116105b806124175750303b158015612417575060005460ff166001145b6124a3576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152602e60248201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160448201527f647920696e697469616c697a65640000000000000000000000000000000000006064820152608401610843565b600080547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00166001179055801561250157600080547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff166101001790555b603880547fffffffffffffffffffffffff000000000000000000000000000000000000000090811673ffffffffffffffffffffffffffffffffffffffff8781169190911790925560378054909116858316179055603580547fffffffffffffffffffffff0000000000000000000000000000000000000000ff1661010085841602179055603254166125ba57603280547fffffffffffffffffffffffff00000000000000000000000000000000000000001661dead1790555b6125c2612a81565b8015610d0957600080547fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff169055604051600181527f7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb38474024989060200160405180910390a150505050565b73ffffffffffffffffffffffffffffffffffffffff163b151590565b60015460009061267c907801000000000000000000000000000000000000000000000000900467ffffffffffffffff1643615a09565b90506000612688612b94565b90506000816020015160ff16826000015163ffffffff166126a99190615aab565b905082156127e0576001546000906126e0908390700100000000000000000000000000000000900467ffffffffffffffff16615b13565b90506000836040015160ff16836126f79190615b87565b6001546127179084906fffffffffffffffffffffffffffffffff16615b87565b6127219190615aab565b6001549091506000906127729061274b9084906fffffffffffffffffffffffffffffffff16615c43565b866060015163ffffffff168760a001516fffffffffffffffffffffffffffffffff16612c55565b905060018611156127a15761279e61274b82876040015160ff1660018a6127999190615a09565b612c74565b90505b6fffffffffffffffffffffffffffffffff16780100000000000000000000000000000000000000000000000067ffffffffffffffff4316021760015550505b60018054869190601090612813908490700100000000000000000000000000000000900467ffffffffffffffff16615a50565b92506101000a81548167ffffffffffffffff021916908367ffffffffffffffff160217905550816000015163ffffffff16600160000160109054906101000a900467ffffffffffffffff1667ffffffffffffffff1613156128f6576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603e60248201527f5265736f757263654d65746572696e673a2063616e6e6f7420627579206d6f7260448201527f6520676173207468616e20617661696c61626c6520676173206c696d697400006064820152608401610843565b600154600090612922906fffffffffffffffffffffffffffffffff1667ffffffffffffffff8816615cb7565b9050600061293448633b9aca00612cc9565b61293e9083615cf4565b905060005a61294d9088615a09565b905080821115612969576129696129648284615a09565b612ce0565b5050505050505050565b80516020808301516040808501516060860151608087015160a088015193516000976129a3979096959101615d08565b604051602081830303815290604052805190602001209050919050565b60008060006129d0866000612d0e565b905080612a06576308c379a06000526020805278185361666543616c6c3a204e6f7420656e6f756768206761736058526064601cfd5b600080855160208701888b5af1979650505050505050565b600081600001518260200151836040015184606001516040516020016129a3949392919093845260208401929092526040830152606082015260800190565b600080612a6986612d2c565b9050612a7781868686612d5e565b9695505050505050565b600054610100900460ff16612b18576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152602b60248201527f496e697469616c697a61626c653a20636f6e7472616374206973206e6f74206960448201527f6e697469616c697a696e670000000000000000000000000000000000000000006064820152608401610843565b6001547801000000000000000000000000000000000000000000000000900467ffffffffffffffff166000036122fb5760408051606081018252633b9aca00808252600060208301524367ffffffffffffffff169190920181905278010000000000000000000000000000000000000000000000000217600155565b6040805160c08082018352600080835260208301819052828401819052606083018190526080830181905260a083015260375483517fcc731b020000000000000000000000000000000000000000000000000000000081529351929373ffffffffffffffffffffffffffffffffffffffff9091169263cc731b02926004808401939192918290030181865afa158015612c31573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610da29190615d75565b6000612c6a612c648585612d8e565b83612d9e565b90505b9392505050565b6000670de0b6b3a7640000612cb5612c8c8583615aab565b612c9e90670de0b6b3a7640000615b13565b612cb085670de0b6b3a7640000615b87565b612dad565b612cbf9086615b87565b612c6a9190615aab565b600081831015612cd95781612c6d565b5090919050565b6000805a90505b825a612cf39083615a09565b1015612d0957612d0282615e31565b9150612ce7565b505050565b600080603f83619c4001026040850201603f5a021015949350505050565b60608180519060200120604051602001612d4891815260200190565b6040516020818303038152906040529050919050565b6000612d8584612d6f878686612dde565b8051602091820120825192909101919091201490565b95945050505050565b600081831215612cd95781612c6d565b6000818312612cd95781612c6d565b6000612c6d670de0b6b3a764000083612dc58661385c565b612dcf9190615b87565b612dd99190615aab565b613aa0565b60606000845111612e4b576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601560248201527f4d65726b6c65547269653a20656d707479206b657900000000000000000000006044820152606401610843565b6000612e5684613cdf565b90506000612e6386613dcb565b9050600084604051602001612e7a91815260200190565b60405160208183030381529060405290506000805b84518110156137d3576000858281518110612eac57612eac615e69565b602002602001015190508451831115612f47576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152602e60248201527f4d65726b6c65547269653a206b657920696e646578206578636565647320746f60448201527f74616c206b6579206c656e6774680000000000000000000000000000000000006064820152608401610843565b826000036130005780518051602091820120604051612f9592612f6f92910190815260200190565b604051602081830303815290604052858051602091820120825192909101919091201490565b612ffb576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601d60248201527f4d65726b6c65547269653a20696e76616c696420726f6f7420686173680000006044820152606401610843565b613157565b8051516020116130b6578051805160209182012060405161302a92612f6f92910190815260200190565b612ffb576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152602760248201527f4d65726b6c65547269653a20696e76616c6964206c6172676520696e7465726e60448201527f616c2068617368000000000000000000000000000000000000000000000000006064820152608401610843565b805184516020808701919091208251919092012014613157576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152602660248201527f4d65726b6c65547269653a20696e76616c696420696e7465726e616c206e6f6460448201527f65206861736800000000000000000000000000000000000000000000000000006064820152608401610843565b61316360106001615e98565b8160200151510361333f57845183036132d75761319d816020015160108151811061319057613190615e69565b6020026020010151613e2e565b96506000875111613230576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603b60248201527f4d65726b6c65547269653a2076616c7565206c656e677468206d75737420626560448201527f2067726561746572207468616e207a65726f20286272616e63682900000000006064820152608401610843565b6001865161323e9190615a09565b82146132cc576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603a60248201527f4d65726b6c65547269653a2076616c7565206e6f6465206d757374206265206c60448201527f617374206e6f646520696e2070726f6f6620286272616e6368290000000000006064820152608401610843565b505050505050612c6d565b60008584815181106132eb576132eb615e69565b602001015160f81c60f81b60f81c9050600082602001518260ff168151811061331657613316615e69565b6020026020010151905061332981613f8e565b9550613336600186615e98565b945050506137c0565b60028160200151510361373857600061335782613fb3565b905060008160008151811061336e5761336e615e69565b016020015160f81c90506000613385600283615eb0565b613390906002615ed2565b905060006133a1848360ff16613fd7565b905060006133af8a89613fd7565b905060006133bd838361400d565b90508083511461344f576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603a60248201527f4d65726b6c65547269653a20706174682072656d61696e646572206d7573742060448201527f736861726520616c6c206e6962626c65732077697468206b65790000000000006064820152608401610843565b60ff851660021480613464575060ff85166003145b1561365357808251146134f9576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603d60248201527f4d65726b6c65547269653a206b65792072656d61696e646572206d757374206260448201527f65206964656e746963616c20746f20706174682072656d61696e6465720000006064820152608401610843565b613513876020015160018151811061319057613190615e69565b9c5060008d51116135a6576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603960248201527f4d65726b6c65547269653a2076616c7565206c656e677468206d75737420626560448201527f2067726561746572207468616e207a65726f20286c65616629000000000000006064820152608401610843565b60018c516135b49190615a09565b8814613642576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603860248201527f4d65726b6c65547269653a2076616c7565206e6f6465206d757374206265206c60448201527f617374206e6f646520696e2070726f6f6620286c6561662900000000000000006064820152608401610843565b505050505050505050505050612c6d565b60ff85161580613666575060ff85166001145b156136a557613692876020015160018151811061368557613685615e69565b6020026020010151613f8e565b995061369e818a615e98565b985061372d565b6040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603260248201527f4d65726b6c65547269653a2072656365697665642061206e6f6465207769746860448201527f20616e20756e6b6e6f776e2070726566697800000000000000000000000000006064820152608401610843565b5050505050506137c0565b6040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152602860248201527f4d65726b6c65547269653a20726563656976656420616e20756e70617273656160448201527f626c65206e6f64650000000000000000000000000000000000000000000000006064820152608401610843565b50806137cb81615e31565b915050612e8f565b506040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152602560248201527f4d65726b6c65547269653a2072616e206f7574206f662070726f6f6620656c6560448201527f6d656e74730000000000000000000000000000000000000000000000000000006064820152608401610843565b60008082136138c7576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152600960248201527f554e444546494e454400000000000000000000000000000000000000000000006044820152606401610843565b600060606138d4846140c1565b03609f8181039490941b90931c6c465772b2bbbb5f824b15207a3081018102606090811d6d0388eaa27412d5aca026815d636e018202811d6d0df99ac502031bf953eff472fdcc018202811d6d13cdffb29d51d99322bdff5f2211018202811d6d0a0f742023def783a307a986912e018202811d6d01920d8043ca89b5239253284e42018202811d6c0b7a86d7375468fac667a0a527016c29508e458543d8aa4df2abee7883018302821d6d0139601a2efabe717e604cbb4894018302821d6d02247f7a7b6594320649aa03aba1018302821d7fffffffffffffffffffffffffffffffffffffff73c0c716a594e00d54e3c4cbc9018302821d7ffffffffffffffffffffffffffffffffffffffdc7b88c420e53a9890533129f6f01830290911d7fffffffffffffffffffffffffffffffffffffff465fda27eb4d63ded474e5f832019091027ffffffffffffffff5f6af8f7b3396644f18e157960000000000000000000000000105711340daa0d5f769dba1915cef59f0815a5506027d0267a36c0c95b3975ab3ee5b203a7614a3f75373f047d803ae7b6687f2b393909302929092017d57115e47018c7177eebf7cd370a3356a1b7863008a5ae8028c72b88642840160ae1d92915050565b60007ffffffffffffffffffffffffffffffffffffffffffffffffdb731c958f34d94c18213613ad157506000919050565b680755bf798b4a1bf1e58212613b43576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152600c60248201527f4558505f4f564552464c4f5700000000000000000000000000000000000000006044820152606401610843565b6503782dace9d9604e83901b059150600060606bb17217f7d1cf79abc9e3b39884821b056b80000000000000000000000001901d6bb17217f7d1cf79abc9e3b39881029093037fffffffffffffffffffffffffffffffffffffffdbf3ccf1604d263450f02a550481018102606090811d6d0277594991cfc85f6e2461837cd9018202811d7fffffffffffffffffffffffffffffffffffffe5adedaa1cb095af9e4da10e363c018202811d6db1bbb201f443cf962f1a1d3db4a5018202811d7ffffffffffffffffffffffffffffffffffffd38dc772608b0ae56cce01296c0eb018202811d6e05180bb14799ab47a8a8cb2a527d57016d02d16720577bd19bf614176fe9ea6c10fe68e7fd37d0007b713f765084018402831d9081019084017ffffffffffffffffffffffffffffffffffffffe2c69812cf03b0763fd454a8f7e010290911d6e0587f503bb6ea29d25fcb7401964500190910279d835ebba824c98fb31b83b2ca45c000000000000000000000000010574029d9dc38563c32e5c2f6dc192ee70ef65f9978af30260c3939093039290921c92915050565b80516060908067ffffffffffffffff811115613cfd57613cfd6151e4565b604051908082528060200260200182016040528015613d4257816020015b6040805180820190915260608082526020820152815260200190600190039081613d1b5790505b50915060005b81811015613dc4576040518060400160405280858381518110613d6d57613d6d615e69565b60200260200101518152602001613d9c868481518110613d8f57613d8f615e69565b6020026020010151614197565b815250838281518110613db157613db1615e69565b6020908102919091010152600101613d48565b5050919050565b606080604051905082518060011b603f8101601f1916830160405280835250602084016020830160005b83811015613e23578060011b82018184015160001a8060041c8253600f811660018301535050600101613df5565b509295945050505050565b60606000806000613e3e856141aa565b919450925090506000816001811115613e5957613e596158af565b14613ee6576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603960248201527f524c505265616465723a206465636f646564206974656d207479706520666f7260448201527f206279746573206973206e6f7420612064617461206974656d000000000000006064820152608401610843565b613ef08284615e98565b855114613f7f576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603460248201527f524c505265616465723a2062797465732076616c756520636f6e7461696e732060448201527f616e20696e76616c69642072656d61696e6465720000000000000000000000006064820152608401610843565b612d8585602001518484614c17565b60606020826000015110613faa57613fa582613e2e565b612392565b61239282614cab565b6060612392613fd2836020015160008151811061319057613190615e69565b613dcb565b606082518210613ff65750604080516020810190915260008152612392565b612c6d83838486516140089190615a09565b614cc1565b6000808251845110614020578251614023565b83515b90505b80821080156140aa575082828151811061404257614042615e69565b602001015160f81c60f81b7effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff191684838151811061408157614081615e69565b01602001517fff0000000000000000000000000000000000000000000000000000000000000016145b156140ba57816001019150614026565b5092915050565b600080821161412c576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152600960248201527f554e444546494e454400000000000000000000000000000000000000000000006044820152606401610843565b5060016fffffffffffffffffffffffffffffffff821160071b82811c67ffffffffffffffff1060061b1782811c63ffffffff1060051b1782811c61ffff1060041b1782811c60ff10600390811b90911783811c600f1060021b1783811c909110821b1791821c111790565b60606123926141a583614e99565b614f82565b600080600080846000015111614268576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604a60248201527f524c505265616465723a206c656e677468206f6620616e20524c50206974656d60448201527f206d7573742062652067726561746572207468616e207a65726f20746f20626560648201527f206465636f6461626c6500000000000000000000000000000000000000000000608482015260a401610843565b6020840151805160001a607f811161428d576000600160009450945094505050614c10565b60b7811161449b5760006142a2608083615a09565b90508087600001511161435d576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604e60248201527f524c505265616465723a206c656e677468206f6620636f6e74656e74206d757360448201527f742062652067726561746572207468616e20737472696e67206c656e6774682060648201527f2873686f727420737472696e6729000000000000000000000000000000000000608482015260a401610843565b6001838101517fff000000000000000000000000000000000000000000000000000000000000001690821415806143d657507f80000000000000000000000000000000000000000000000000000000000000007fff00000000000000000000000000000000000000000000000000000000000000821610155b614488576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604d60248201527f524c505265616465723a20696e76616c6964207072656669782c2073696e676c60448201527f652062797465203c203078383020617265206e6f74207072656669786564202860648201527f73686f727420737472696e672900000000000000000000000000000000000000608482015260a401610843565b5060019550935060009250614c10915050565b60bf81116147e95760006144b060b783615a09565b90508087600001511161456b576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152605160248201527f524c505265616465723a206c656e677468206f6620636f6e74656e74206d757360448201527f74206265203e207468616e206c656e677468206f6620737472696e67206c656e60648201527f67746820286c6f6e6720737472696e6729000000000000000000000000000000608482015260a401610843565b60018301517fff00000000000000000000000000000000000000000000000000000000000000166000819003614649576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604a60248201527f524c505265616465723a206c656e677468206f6620636f6e74656e74206d757360448201527f74206e6f74206861766520616e79206c656164696e67207a65726f7320286c6f60648201527f6e6720737472696e672900000000000000000000000000000000000000000000608482015260a401610843565b600184015160088302610100031c6037811161470d576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604860248201527f524c505265616465723a206c656e677468206f6620636f6e74656e74206d757360448201527f742062652067726561746572207468616e20353520627974657320286c6f6e6760648201527f20737472696e6729000000000000000000000000000000000000000000000000608482015260a401610843565b6147178184615e98565b8951116147cc576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604c60248201527f524c505265616465723a206c656e677468206f6620636f6e74656e74206d757360448201527f742062652067726561746572207468616e20746f74616c206c656e677468202860648201527f6c6f6e6720737472696e67290000000000000000000000000000000000000000608482015260a401610843565b6147d7836001615e98565b9750955060009450614c109350505050565b60f781116148ca5760006147fe60c083615a09565b9050808760000151116148b9576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604a60248201527f524c505265616465723a206c656e677468206f6620636f6e74656e74206d757360448201527f742062652067726561746572207468616e206c697374206c656e67746820287360648201527f686f7274206c6973742900000000000000000000000000000000000000000000608482015260a401610843565b600195509350849250614c10915050565b60006148d760f783615a09565b905080876000015111614992576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604d60248201527f524c505265616465723a206c656e677468206f6620636f6e74656e74206d757360448201527f74206265203e207468616e206c656e677468206f66206c697374206c656e677460648201527f6820286c6f6e67206c6973742900000000000000000000000000000000000000608482015260a401610843565b60018301517fff00000000000000000000000000000000000000000000000000000000000000166000819003614a70576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604860248201527f524c505265616465723a206c656e677468206f6620636f6e74656e74206d757360448201527f74206e6f74206861766520616e79206c656164696e67207a65726f7320286c6f60648201527f6e67206c69737429000000000000000000000000000000000000000000000000608482015260a401610843565b600184015160088302610100031c60378111614b34576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604660248201527f524c505265616465723a206c656e677468206f6620636f6e74656e74206d757360448201527f742062652067726561746572207468616e20353520627974657320286c6f6e6760648201527f206c697374290000000000000000000000000000000000000000000000000000608482015260a401610843565b614b3e8184615e98565b895111614bf3576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604a60248201527f524c505265616465723a206c656e677468206f6620636f6e74656e74206d757360448201527f742062652067726561746572207468616e20746f74616c206c656e677468202860648201527f6c6f6e67206c6973742900000000000000000000000000000000000000000000608482015260a401610843565b614bfe836001615e98565b9750955060019450614c109350505050565b9193909250565b60608167ffffffffffffffff811115614c3257614c326151e4565b6040519080825280601f01601f191660200182016040528015614c5c576020820181803683370190505b5090508115612c6d576000614c718486615e98565b90506020820160005b84811015614c92578281015182820152602001614c7a565b84811115614ca1576000858301525b5050509392505050565b6060612392826020015160008460000151614c17565b60608182601f011015614d30576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152600e60248201527f736c6963655f6f766572666c6f770000000000000000000000000000000000006044820152606401610843565b828284011015614d9c576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152600e60248201527f736c6963655f6f766572666c6f770000000000000000000000000000000000006044820152606401610843565b81830184511015614e09576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152601160248201527f736c6963655f6f75744f66426f756e64730000000000000000000000000000006044820152606401610843565b606082158015614e285760405191506000825260208201604052614e90565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015614e61578051835260209283019201614e49565b5050858452601f017fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe016604052505b50949350505050565b60408051808201909152600080825260208201526000825111614f64576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152604a60248201527f524c505265616465723a206c656e677468206f6620616e20524c50206974656d60448201527f206d7573742062652067726561746572207468616e207a65726f20746f20626560648201527f206465636f6461626c6500000000000000000000000000000000000000000000608482015260a401610843565b50604080518082019091528151815260209182019181019190915290565b60606000806000614f92856141aa565b919450925090506001816001811115614fad57614fad6158af565b1461503a576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603860248201527f524c505265616465723a206465636f646564206974656d207479706520666f7260448201527f206c697374206973206e6f742061206c697374206974656d00000000000000006064820152608401610843565b84516150468385615e98565b146150d3576040517f08c379a000000000000000000000000000000000000000000000000000000000815260206004820152603260248201527f524c505265616465723a206c697374206974656d2068617320616e20696e766160448201527f6c696420646174612072656d61696e64657200000000000000000000000000006064820152608401610843565b604080516020808252610420820190925290816020015b60408051808201909152600080825260208201528152602001906001900390816150ea5790505093506000835b86518110156151d85760008061515d6040518060400160405280858c600001516151419190615a09565b8152602001858c602001516151569190615e98565b90526141aa565b5091509150604051806040016040528083836151799190615e98565b8152602001848b6020015161518e9190615e98565b8152508885815181106151a3576151a3615e69565b60209081029190910101526151b9600185615e98565b93506151c58183615e98565b6151cf9084615e98565b92505050615117565b50845250919392505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b604051601f82017fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe016810167ffffffffffffffff8111828210171561525a5761525a6151e4565b604052919050565b73ffffffffffffffffffffffffffffffffffffffff8116811461237657600080fd5b600082601f83011261529557600080fd5b813567ffffffffffffffff8111156152af576152af6151e4565b6152e060207fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f84011601615213565b8181528460208386010111156152f557600080fd5b816020850160208301376000918101602001919091529392505050565b600060c0828403121561532457600080fd5b60405160c0810167ffffffffffffffff8282108183111715615348576153486151e4565b81604052829350843583526020850135915061536382615262565b8160208401526040850135915061537982615262565b816040840152606085013560608401526080850135608084015260a08501359150808211156153a757600080fd5b506153b485828601615284565b60a0830152505092915050565b600080604083850312156153d457600080fd5b823567ffffffffffffffff8111156153eb57600080fd5b6153f785828601615312565b925050602083013561540881615262565b809150509250929050565b60006020828403121561542557600080fd5b8135612c6d81615262565b600080600080600085870360e081121561544957600080fd5b863567ffffffffffffffff8082111561546157600080fd5b61546d8a838b01615312565b97506020890135965060807fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc0840112156154a657600080fd5b60408901955060c08901359250808311156154c057600080fd5b828901925089601f8401126154d457600080fd5b82359150808211156154e557600080fd5b508860208260051b84010111156154fb57600080fd5b959894975092955050506020019190565b60006020828403121561551e57600080fd5b5035919050565b60005b83811015615540578181015183820152602001615528565b83811115610d095750506000910152565b60008151808452615569816020860160208601615525565b601f017fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0169290920160200192915050565b602081526000612c6d6020830184615551565b600080604083850312156155c157600080fd5b82359150602083013561540881615262565b63ffffffff8116811461237657600080fd5b6000602082840312156155f757600080fd5b8135612c6d816155d3565b60006020828403121561561457600080fd5b813567ffffffffffffffff81111561562b57600080fd5b61563784828501615312565b949350505050565b67ffffffffffffffff8116811461237657600080fd5b60006020828403121561566757600080fd5b8135612c6d8161563f565b6000806040838503121561568557600080fd5b50508035926020909101359150565b6000806000606084860312156156a957600080fd5b83356156b481615262565b925060208401356156c481615262565b915060408401356156d481615262565b809150509250925092565b801515811461237657600080fd5b600080600080600060a0868803121561570557600080fd5b853561571081615262565b94506020860135935060408601356157278161563f565b92506060860135615737816156df565b9150608086013567ffffffffffffffff81111561575357600080fd5b61575f88828901615284565b9150509295509295909350565b8581528460208201527fffffffffffffffff0000000000000000000000000000000000000000000000008460c01b16604082015282151560f81b6048820152600082516157c0816049850160208701615525565b919091016049019695505050505050565b6000602082840312156157e357600080fd5b8151612c6d81615262565b60008060006060848603121561580357600080fd5b835161580e816155d3565b602085015190935061581f8161563f565b60408501519092506156d481615262565b60006020828403121561584257600080fd5b5051919050565b60006080828403121561585b57600080fd5b6040516080810181811067ffffffffffffffff8211171561587e5761587e6151e4565b8060405250823581526020830135602082015260408301356040820152606083013560608201528091505092915050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b6000602082840312156158f057600080fd5b815160038110612c6d57600080fd5b60006020828403121561591157600080fd5b8151612c6d816155d3565b600067ffffffffffffffff80841115615937576159376151e4565b8360051b6020615948818301615213565b86815291850191818101903684111561596057600080fd5b865b848110156159945780358681111561597a5760008081fd5b61598636828b01615284565b845250918301918301615962565b50979650505050505050565b6000602082840312156159b257600080fd5b8151612c6d816156df565b6000602082840312156159cf57600080fd5b8151612c6d8161563f565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b600082821015615a1b57615a1b6159da565b500390565b600067ffffffffffffffff80831681851681830481118215151615615a4757615a476159da565b02949350505050565b600067ffffffffffffffff808316818516808303821115615a7357615a736159da565b01949350505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b600082615aba57615aba615a7c565b7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff83147f800000000000000000000000000000000000000000000000000000000000000083141615615b0e57615b0e6159da565b500590565b6000808312837f800000000000000000000000000000000000000000000000000000000000000001831281151615615b4d57615b4d6159da565b837f7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff018313811615615b8157615b816159da565b50500390565b60007f7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff600084136000841385830485118282161615615bc857615bc86159da565b7f80000000000000000000000000000000000000000000000000000000000000006000871286820588128184161615615c0357615c036159da565b60008712925087820587128484161615615c1f57615c1f6159da565b87850587128184161615615c3557615c356159da565b505050929093029392505050565b6000808212827f7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff03841381151615615c7d57615c7d6159da565b827f8000000000000000000000000000000000000000000000000000000000000000038412811615615cb157615cb16159da565b50500190565b6000817fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0483118215151615615cef57615cef6159da565b500290565b600082615d0357615d03615a7c565b500490565b868152600073ffffffffffffffffffffffffffffffffffffffff808816602084015280871660408401525084606083015283608083015260c060a0830152615d5360c0830184615551565b98975050505050505050565b805160ff81168114615d7057600080fd5b919050565b600060c08284031215615d8757600080fd5b60405160c0810181811067ffffffffffffffff82111715615daa57615daa6151e4565b6040528251615db8816155d3565b8152615dc660208401615d5f565b6020820152615dd760408401615d5f565b60408201526060830151615dea816155d3565b60608201526080830151615dfd816155d3565b608082015260a08301516fffffffffffffffffffffffffffffffff81168114615e2557600080fd5b60a08201529392505050565b60007fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8203615e6257615e626159da565b5060010190565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b60008219821115615eab57615eab6159da565b500190565b600060ff831680615ec357615ec3615a7c565b8060ff84160691505092915050565b600060ff821660ff841680821015615eec57615eec6159da565b9003939250505056fea164736f6c634300080f000a"
 
-
 func init() {
 	if err := json.Unmarshal([]byte(OptimismPortal2StorageLayoutJSON), OptimismPortal2StorageLayout); err != nil {
 		panic(err)
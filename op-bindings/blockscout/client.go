@@ -0,0 +1,239 @@
+package blockscout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/etherscan"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+)
+
+const (
+	EthereumApiUrl = "https://eth.blockscout.com"
+	OptimismApiUrl = "https://optimism.blockscout.com"
+)
+
+const apiMaxRetries = 3
+const apiRetryDelay = time.Duration(2) * time.Second
+
+// ErrNotVerified is returned when Blockscout has no verified contract at the requested address.
+var ErrNotVerified = errors.New("contract is not verified on Blockscout")
+
+// ErrNotFound is returned when Blockscout does not know the requested address or transaction.
+var ErrNotFound = errors.New("not found on Blockscout")
+
+// ErrNotProxy is returned when Blockscout does not know of an implementation for the requested address.
+var ErrNotProxy = errors.New("contract is not a proxy on Blockscout")
+
+// client sources contract data from the v2 API of a Blockscout instance.
+// Unlike Etherscan, Blockscout does not require an API key.
+type client struct {
+	baseUrl    string
+	httpClient *http.Client
+
+	// contracts caches the fetched smart contracts by address, since the ABI and
+	// the deployed bytecode are served by the same Blockscout endpoint.
+	contracts map[string]smartContractResponse
+	mu        sync.Mutex
+}
+
+// implementationResponse is an implementation of a proxy, newer Blockscout versions
+// name the address field address_hash instead of address.
+type implementationResponse struct {
+	Address     string `json:"address"`
+	AddressHash string `json:"address_hash"`
+}
+
+type smartContractResponse struct {
+	IsVerified       bool                     `json:"is_verified"`
+	Abi              json.RawMessage          `json:"abi"`
+	DeployedBytecode string                   `json:"deployed_bytecode"`
+	Implementations  []implementationResponse `json:"implementations"`
+	// ImplementationAddress is how Blockscout versions before the implementations list reported a proxy's implementation
+	ImplementationAddress string `json:"implementation_address"`
+}
+
+type addressResponse struct {
+	CreationTxHash string `json:"creation_tx_hash"`
+	// CreationTransactionHash is what newer Blockscout versions name creation_tx_hash
+	CreationTransactionHash string `json:"creation_transaction_hash"`
+}
+
+type addressRef struct {
+	Hash string `json:"hash"`
+}
+
+type transactionResponse struct {
+	Hash     string      `json:"hash"`
+	RawInput string      `json:"raw_input"`
+	To       *addressRef `json:"to"`
+}
+
+func NewClient(baseUrl string) *client {
+	return &client{
+		baseUrl: strings.TrimSuffix(baseUrl, "/"),
+		httpClient: &http.Client{
+			Timeout: time.Second * 10,
+		},
+		contracts: make(map[string]smartContractResponse),
+	}
+}
+
+func NewEthereumClient() *client {
+	return NewClient(EthereumApiUrl)
+}
+
+func NewOptimismClient() *client {
+	return NewClient(OptimismApiUrl)
+}
+
+func (c *client) fetch(ctx context.Context, url string) (int, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, body, nil
+}
+
+// fetchApi requests the given path of the v2 API and unmarshals the response into result.
+// A 404 response is returned as notFound, wrapped with the path.
+func (c *client) fetchApi(ctx context.Context, path string, notFound error, result any) error {
+	url := fmt.Sprintf("%s/api/v2/%s", c.baseUrl, path)
+	status, body, err := retry.Do2[int, []byte](ctx, apiMaxRetries, retry.Fixed(apiRetryDelay), func() (int, []byte, error) {
+		status, body, err := c.fetch(ctx, url)
+		if err != nil {
+			return 0, nil, err
+		}
+		if status >= http.StatusInternalServerError || status == http.StatusTooManyRequests {
+			return 0, nil, fmt.Errorf("request to %s failed with status %d", url, status)
+		}
+		return status, body, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if status == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", notFound, path)
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("there was an issue with the Blockscout request to %s, received status %d: %s", url, status, body)
+	}
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("failed to unmarshal Blockscout response of %s: %w", url, err)
+	}
+	return nil
+}
+
+func (c *client) fetchSmartContract(ctx context.Context, address string) (smartContractResponse, error) {
+	address = strings.ToLower(address)
+	c.mu.Lock()
+	contract, ok := c.contracts[address]
+	c.mu.Unlock()
+	if ok {
+		return contract, nil
+	}
+
+	if err := c.fetchApi(ctx, "smart-contracts/"+address, ErrNotVerified, &contract); err != nil {
+		return smartContractResponse{}, err
+	}
+	if !contract.IsVerified {
+		return smartContractResponse{}, fmt.Errorf("%w: %s", ErrNotVerified, address)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.contracts[address] = contract
+	return contract, nil
+}
+
+func (c *client) FetchAbi(ctx context.Context, address string) (string, error) {
+	contract, err := c.fetchSmartContract(ctx, address)
+	if err != nil {
+		return "", err
+	}
+	if len(contract.Abi) == 0 {
+		return "", fmt.Errorf("API response for %s does not contain an ABI", address)
+	}
+	return string(contract.Abi), nil
+}
+
+func (c *client) FetchDeployedBytecode(ctx context.Context, address string) (string, error) {
+	contract, err := c.fetchSmartContract(ctx, address)
+	if err != nil {
+		return "", fmt.Errorf("error fetching deployed bytecode: %w", err)
+	}
+	if contract.DeployedBytecode == "" {
+		return "", fmt.Errorf("API response for %s does not contain deployed bytecode", address)
+	}
+	return contract.DeployedBytecode, nil
+}
+
+// FetchImplementationAddress returns the address of the implementation Blockscout detected for a proxy.
+// Blockscout reports it in the smart contract itself, rather than in a separate Implementation field
+// of the source code like Etherscan, either as a list of implementations or, on older versions, as a single address.
+func (c *client) FetchImplementationAddress(ctx context.Context, address string) (string, error) {
+	contract, err := c.fetchSmartContract(ctx, address)
+	if err != nil {
+		return "", err
+	}
+	for _, implementation := range contract.Implementations {
+		if implementation.AddressHash != "" {
+			return implementation.AddressHash, nil
+		}
+		if implementation.Address != "" {
+			return implementation.Address, nil
+		}
+	}
+	if contract.ImplementationAddress != "" {
+		return contract.ImplementationAddress, nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrNotProxy, address)
+}
+
+func (c *client) FetchDeploymentTxHash(ctx context.Context, address string) (string, error) {
+	var addr addressResponse
+	if err := c.fetchApi(ctx, "addresses/"+strings.ToLower(address), ErrNotFound, &addr); err != nil {
+		return "", err
+	}
+	if addr.CreationTransactionHash != "" {
+		return addr.CreationTransactionHash, nil
+	}
+	if addr.CreationTxHash != "" {
+		return addr.CreationTxHash, nil
+	}
+	return "", fmt.Errorf("API response for %s does not contain a deployment transaction", address)
+}
+
+func (c *client) FetchDeploymentTx(ctx context.Context, txHash string) (etherscan.Transaction, error) {
+	var tx transactionResponse
+	if err := c.fetchApi(ctx, "transactions/"+strings.ToLower(txHash), ErrNotFound, &tx); err != nil {
+		return etherscan.Transaction{}, err
+	}
+	if tx.RawInput == "" {
+		return etherscan.Transaction{}, fmt.Errorf("API response for transaction %s does not contain its input", txHash)
+	}
+	result := etherscan.Transaction{Hash: tx.Hash, Input: tx.RawInput}
+	// contract creations have no to address, which Blockscout reports as null
+	if tx.To != nil {
+		result.To = tx.To.Hash
+	}
+	return result, nil
+}
@@ -0,0 +1,99 @@
+package blockscout
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testAddress = "0xca11bde05977b3631167028862be2a173976ca11"
+
+// newTestServer serves the given responses by request path, and 404 for any other path.
+func newTestServer(t *testing.T, responses map[string]string) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestClient_VerifiedContract(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/api/v2/smart-contracts/" + testAddress: `{
+			"is_verified": true,
+			"abi": [{"type":"function","name":"foo","inputs":[],"outputs":[],"stateMutability":"view"}],
+			"deployed_bytecode": "0x6001",
+			"creation_bytecode": "0x6002"
+		}`,
+		"/api/v2/addresses/" + testAddress: `{"hash": "` + testAddress + `", "creation_tx_hash": "0xabcd"}`,
+		"/api/v2/transactions/0xabcd":      `{"hash": "0xabcd", "raw_input": "0x6002", "to": null, "created_contract": {"hash": "` + testAddress + `"}}`,
+		"/api/v2/transactions/0xef01":      `{"hash": "0xef01", "raw_input": "0x1234", "to": {"hash": "0x4e59b44847b379578588920ca78fbf26c0b4956c"}}`,
+	})
+	c := NewClient(srv.URL + "/")
+	ctx := context.Background()
+
+	abi, err := c.FetchAbi(ctx, testAddress)
+	require.NoError(t, err)
+	require.Contains(t, abi, `"name":"foo"`)
+
+	bytecode, err := c.FetchDeployedBytecode(ctx, testAddress)
+	require.NoError(t, err)
+	require.Equal(t, "0x6001", bytecode)
+
+	txHash, err := c.FetchDeploymentTxHash(ctx, testAddress)
+	require.NoError(t, err)
+	require.Equal(t, "0xabcd", txHash)
+
+	tx, err := c.FetchDeploymentTx(ctx, txHash)
+	require.NoError(t, err)
+	require.Equal(t, "0x6002", tx.Input)
+	require.Empty(t, tx.To)
+
+	tx, err = c.FetchDeploymentTx(ctx, "0xef01")
+	require.NoError(t, err)
+	require.Equal(t, "0x4e59b44847b379578588920ca78fbf26c0b4956c", tx.To)
+
+	_, err = c.FetchImplementationAddress(ctx, testAddress)
+	require.ErrorIs(t, err, ErrNotProxy)
+}
+
+func TestClient_ImplementationAddress(t *testing.T) {
+	const implementation = "0x4200000000000000000000000000000000000015"
+	for name, response := range map[string]string{
+		"Implementations":       `{"is_verified": true, "implementations": [{"address": "` + implementation + `", "name": "L1Block"}]}`,
+		"ImplementationsHash":   `{"is_verified": true, "implementations": [{"address_hash": "` + implementation + `", "name": "L1Block"}]}`,
+		"ImplementationAddress": `{"is_verified": true, "implementation_address": "` + implementation + `"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			srv := newTestServer(t, map[string]string{"/api/v2/smart-contracts/" + testAddress: response})
+			address, err := NewClient(srv.URL).FetchImplementationAddress(context.Background(), testAddress)
+			require.NoError(t, err)
+			require.Equal(t, implementation, address)
+		})
+	}
+}
+
+func TestClient_NotVerified(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/api/v2/smart-contracts/" + testAddress: `{"is_verified": false, "deployed_bytecode": "0x6001"}`,
+	})
+	c := NewClient(srv.URL)
+
+	_, err := c.FetchDeployedBytecode(context.Background(), testAddress)
+	require.ErrorIs(t, err, ErrNotVerified)
+
+	_, err = c.FetchAbi(context.Background(), "0x0000000000000000000000000000000000000001")
+	require.ErrorIs(t, err, ErrNotVerified)
+
+	_, err = c.FetchDeploymentTxHash(context.Background(), testAddress)
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// chainConfig configures where remote contract data is sourced from for a single chain.
+type chainConfig struct {
+	Name string
+	// Source overrides --source.kind for this chain, if set
+	Source           string
+	EtherscanApiUrl  string
+	EtherscanApiKey  string
+	BlockscoutApiUrl string
+	RpcUrl           string
+}
+
+// sourceKinds are the kinds of contract data sources, selected with --source.kind or per chain.
+var sourceKinds = []string{"etherscan", "sourcify", "blockscout"}
+
+func isSourceKind(kind string) bool {
+	for _, k := range sourceKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// chainConfigs is a repeatable flag value, where each occurrence of the flag configures one chain as a
+// comma-separated list of key=value pairs, e.g.:
+//
+//	--chain name=base,etherscan-api-url=https://api.basescan.org,etherscan-api-key=KEY,rpc-url=https://mainnet.base.org
+//	--chain name=devnet,source=blockscout,blockscout-api-url=http://localhost:4000,rpc-url=http://localhost:8545
+type chainConfigs []chainConfig
+
+func (c *chainConfigs) Set(value string) error {
+	var chain chainConfig
+	for _, pair := range strings.Split(value, ",") {
+		key, val, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return fmt.Errorf("invalid chain config entry %q, expected key=value", pair)
+		}
+		switch key {
+		case "name":
+			chain.Name = val
+		case "source":
+			if !isSourceKind(val) {
+				return fmt.Errorf("unknown contract data source kind %q for chain, expected one of %s", val, strings.Join(sourceKinds, ", "))
+			}
+			chain.Source = val
+		case "etherscan-api-url":
+			chain.EtherscanApiUrl = strings.TrimSuffix(val, "/")
+		case "etherscan-api-key":
+			chain.EtherscanApiKey = val
+		case "blockscout-api-url":
+			chain.BlockscoutApiUrl = strings.TrimSuffix(val, "/")
+		case "rpc-url":
+			chain.RpcUrl = val
+		default:
+			return fmt.Errorf("unknown chain config key %q, expected one of name, source, etherscan-api-url, etherscan-api-key, blockscout-api-url, rpc-url", key)
+		}
+	}
+	if chain.Name == "" {
+		return fmt.Errorf("chain config %q is missing a name", value)
+	}
+	if chain.RpcUrl == "" {
+		return fmt.Errorf("chain config for %s is missing an rpc-url", chain.Name)
+	}
+	*c = append(*c, chain)
+	return nil
+}
+
+func (c *chainConfigs) String() string {
+	if c == nil {
+		return ""
+	}
+	names := make([]string, 0, len(*c))
+	for _, chain := range *c {
+		names = append(names, chain.Name)
+	}
+	return strings.Join(names, ",")
+}
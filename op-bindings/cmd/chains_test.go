@@ -0,0 +1,28 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainConfigsSet(t *testing.T) {
+	var chains chainConfigs
+	require.NoError(t, chains.Set("name=base,etherscan-api-url=https://api.basescan.org/,etherscan-api-key=KEY,rpc-url=https://mainnet.base.org"))
+	require.NoError(t, chains.Set("name=settlement, rpc-url=http://localhost:8545"))
+	require.NoError(t, chains.Set("name=devnet,source=blockscout,blockscout-api-url=http://localhost:4000/,rpc-url=http://localhost:8545"))
+
+	require.Equal(t, chainConfigs{
+		{Name: "base", EtherscanApiUrl: "https://api.basescan.org", EtherscanApiKey: "KEY", RpcUrl: "https://mainnet.base.org"},
+		{Name: "settlement", RpcUrl: "http://localhost:8545"},
+		{Name: "devnet", Source: "blockscout", BlockscoutApiUrl: "http://localhost:4000", RpcUrl: "http://localhost:8545"},
+	}, chains)
+	require.Equal(t, "base,settlement,devnet", chains.String())
+
+	require.ErrorContains(t, chains.Set("rpc-url=http://localhost:8545"), "missing a name")
+	require.ErrorContains(t, chains.Set("name=base"), "missing an rpc-url")
+	require.ErrorContains(t, chains.Set("name=base,rpc"), "expected key=value")
+	require.ErrorContains(t, chains.Set("name=base,chain-id=1"), "unknown chain config key")
+	require.ErrorContains(t, chains.Set("name=base,source=routescan,rpc-url=http://localhost:8545"), "unknown contract data source kind")
+	require.Len(t, chains, 3)
+}
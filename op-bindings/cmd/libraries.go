@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// libraryAddresses is a repeatable flag value, where each occurrence of the flag gives the address an external
+// library is linked at, as name=address. The name may be qualified with its source, as <source>:<name>=address,
+// to disambiguate libraries of the same name, e.g.:
+//
+//	--library SafeCall=0x4200000000000000000000000000000000000042
+//	--library src/libraries/Encoding.sol:Encoding=0x4200000000000000000000000000000000000043
+type libraryAddresses map[string]common.Address
+
+func (l *libraryAddresses) Set(value string) error {
+	name, address, ok := strings.Cut(strings.TrimSpace(value), "=")
+	if !ok || name == "" {
+		return fmt.Errorf("invalid library %q, expected name=address", value)
+	}
+	if !common.IsHexAddress(address) {
+		return fmt.Errorf("invalid address %q of library %s", address, name)
+	}
+	if *l == nil {
+		*l = make(libraryAddresses)
+	}
+	if _, ok := (*l)[name]; ok {
+		return fmt.Errorf("library %s is given more than once", name)
+	}
+	(*l)[name] = common.HexToAddress(address)
+	return nil
+}
+
+func (l *libraryAddresses) String() string {
+	if l == nil {
+		return ""
+	}
+	libraries := make([]string, 0, len(*l))
+	for name, address := range *l {
+		libraries = append(libraries, name+"="+address.Hex())
+	}
+	sort.Strings(libraries)
+	return strings.Join(libraries, ",")
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibraryAddressesSet(t *testing.T) {
+	var libraries libraryAddresses
+	require.NoError(t, libraries.Set("SafeCall=0x4200000000000000000000000000000000000042"))
+	require.NoError(t, libraries.Set(" src/libraries/Encoding.sol:Encoding=0x4200000000000000000000000000000000000043"))
+
+	require.Equal(t, libraryAddresses{
+		"SafeCall":                            common.HexToAddress("0x4200000000000000000000000000000000000042"),
+		"src/libraries/Encoding.sol:Encoding": common.HexToAddress("0x4200000000000000000000000000000000000043"),
+	}, libraries)
+	require.Equal(t, "SafeCall=0x4200000000000000000000000000000000000042,src/libraries/Encoding.sol:Encoding=0x4200000000000000000000000000000000000043", libraries.String())
+
+	require.ErrorContains(t, libraries.Set("SafeCall"), "expected name=address")
+	require.ErrorContains(t, libraries.Set("=0x4200000000000000000000000000000000000042"), "expected name=address")
+	require.ErrorContains(t, libraries.Set("Bytes=0x42"), "invalid address")
+	require.ErrorContains(t, libraries.Set("SafeCall=0x4200000000000000000000000000000000000044"), "given more than once")
+	require.Len(t, libraries, 2)
+}
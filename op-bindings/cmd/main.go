@@ -1,16 +1,26 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindgen"
+	"github.com/ethereum-optimism/optimism/op-bindings/blockscout"
 	"github.com/ethereum-optimism/optimism/op-bindings/etherscan"
+	"github.com/ethereum-optimism/optimism/op-bindings/sourcify"
 	op_service "github.com/ethereum-optimism/optimism/op-service"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/opio"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/urfave/cli/v2"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -18,22 +28,73 @@ const (
 	MetadataOutFlagName         = "metadata-out"
 	BindingsPackageNameFlagName = "bindings-package"
 	ContractsListFlagName       = "contracts-list"
+	SpdxFlagName                = "spdx"
+	TsOutFlagName               = "ts-out"
+	EventTopicsFlagName         = "event-topics"
+	CheckFlagName               = "check"
+	ManifestFlagName            = "manifest"
+	OnlyFlagName                = "only"
+	SkipFlagName                = "skip"
+	ContinueOnErrorFlagName     = "continue-on-error"
+	OutputFormatFlagName        = "output-format"
+	TimeoutFlagName             = "timeout"
+
+	// All Contracts Flags
+	AllowNameOverrideFlagName = "allow-name-override"
 
 	// Local Contracts Flags
-	SourceMapsListFlagName = "source-maps-list"
-	ForgeArtifactsFlagName = "forge-artifacts"
+	SourceMapsListFlagName     = "source-maps-list"
+	SourceMapsOptionalFlagName = "source-maps-optional"
+	ForgeArtifactsFlagName     = "forge-artifacts"
+	AbiOverlayFlagName         = "abi-overlay"
+	ImmutableGettersFlagName   = "immutable-getters"
+	StorageSlotsFlagName       = "storage-slots"
+	LibraryFlagName            = "library"
+
+	// Inspect Flags
+	ContractFlagName = "contract"
+	StorageFlagName  = "storage"
 
 	// Remote Contracts Flags
-	EtherscanApiKeyEthFlagName = "etherscan.apikey.eth"
-	EtherscanApiKeyOpFlagName  = "etherscan.apikey.op"
-	RpcUrlEthFlagName          = "rpc.url.eth"
-	RpcUrlOpFlagName           = "rpc.url.op"
+	SourceKindFlagName                  = "source.kind"
+	SourcifyUrlFlagName                 = "sourcify.url"
+	EtherscanApiKeyEthFlagName          = "etherscan.apikey.eth"
+	EtherscanApiKeyOpFlagName           = "etherscan.apikey.op"
+	RpcUrlEthFlagName                   = "rpc.url.eth"
+	RpcUrlOpFlagName                    = "rpc.url.op"
+	ChainFlagName                       = "chain"
+	EtherscanMaxRetriesFlagName         = "etherscan.max-retries"
+	EtherscanRetryBaseDelayFlagName     = "etherscan.retry-base-delay"
+	EtherscanRetryMaxElapsedFlagName    = "etherscan.retry-max-elapsed"
+	EtherscanRpsFlagName                = "etherscan.rps"
+	EtherscanBurstFlagName              = "etherscan.burst"
+	RemoteCacheDirFlagName              = "remote-cache-dir"
+	RemoteCacheTtlFlagName              = "remote-cache-ttl"
+	RemoteConcurrencyFlagName           = "remote-concurrency"
+	RemoteRateLimitFlagName             = "remote-rate-limit"
+	VerifyBytecodeFlagName              = "verify-bytecode"
+	VerifyBytecodeStripMetadataFlagName = "verify-bytecode-strip-metadata"
+)
+
+const (
+	OutputFormatText = "text"
+	OutputFormatJson = "json"
 )
 
 func main() {
 	oplog.SetupDefaults()
 
-	app := &cli.App{
+	// interrupts cancel the context, aborting the in-flight requests of the remote generator
+	ctx := opio.CancelOnInterrupt(context.Background())
+	if err := newApp().RunContext(ctx, os.Args); err != nil {
+		log.Crit("BindGen error", "error", err.Error())
+	}
+}
+
+// newApp creates the bindgen CLI app. Invalid log flag values, like an unknown --log.level,
+// are rejected when the flags are parsed, before any bindings are generated.
+func newApp() *cli.App {
+	return &cli.App{
 		Name:  "BindGen",
 		Usage: "Generate contract bindings using Foundry artifacts and/or remotely sourced contract data",
 		Commands: []*cli.Command{
@@ -45,7 +106,7 @@ func main() {
 					{
 						Name:   "all",
 						Usage:  "Generate bindings for local and remote contracts",
-						Flags:  append(localFlags(), remoteFlags()...),
+						Flags:  allFlags(),
 						Action: generateBindings,
 					},
 					{
@@ -54,6 +115,12 @@ func main() {
 						Flags:  localFlags(),
 						Action: generateBindings,
 					},
+					{
+						Name:   "storage-layouts",
+						Usage:  "Regenerate only the metadata files (<contract>_more.go) of locally sourced contracts, leaving their bindings untouched",
+						Flags:  localFlags(),
+						Action: generateBindings,
+					},
 					{
 						Name:   "remote",
 						Usage:  "Generate bindings for remotely sourced contracts",
@@ -62,63 +129,233 @@ func main() {
 					},
 				},
 			},
+			{
+				Name:   "inspect",
+				Usage:  "Print the ABI of a contract from its forge artifact as JSON, without generating bindings",
+				Flags:  inspectFlags(),
+				Action: inspectContract,
+			},
 		},
 	}
-
-	if err := app.Run(os.Args); err != nil {
-		log.Crit("BindGen error", "error", err.Error())
-	}
 }
 
 func setupLogger(c *cli.Context) log.Logger {
-	logger := oplog.NewLogger(oplog.AppOut(c), oplog.ReadCLIConfig(c))
+	out := oplog.AppOut(c)
+	// The JSON report is written to the app output, so the logs do not get mixed into it
+	if c.String(OutputFormatFlagName) == OutputFormatJson {
+		out = errWriter(c)
+	}
+	logger := oplog.NewLogger(out, oplog.ReadCLIConfig(c))
 	oplog.SetGlobalLogHandler(logger.Handler())
 	return logger
 }
 
-func generateBindings(c *cli.Context) error {
+// errWriter returns the error output of the app, to write logs to when the app output is JSON.
+func errWriter(c *cli.Context) io.Writer {
+	if c.App != nil && c.App.ErrWriter != nil {
+		return c.App.ErrWriter
+	}
+	return os.Stderr
+}
+
+// inspectContract prints the ABI of a contract from its forge artifact, with the logs written to stderr.
+func inspectContract(c *cli.Context) error {
+	logger := oplog.NewLogger(errWriter(c), oplog.ReadCLIConfig(c))
+	generator := bindgen.BindGenGeneratorLocal{
+		BindGenGeneratorBase: bindgen.BindGenGeneratorBase{Logger: logger},
+		ForgeArtifactsPath:   c.String(ForgeArtifactsFlagName),
+	}
+	return generator.Inspect(c.App.Writer, c.String(ContractFlagName), c.Bool(StorageFlagName))
+}
+
+func generateBindings(c *cli.Context) (err error) {
+	outputFormat := c.String(OutputFormatFlagName)
+	if outputFormat != OutputFormatText && outputFormat != OutputFormatJson {
+		return fmt.Errorf("unknown --%s %q, expected %s or %s", OutputFormatFlagName, outputFormat, OutputFormatText, OutputFormatJson)
+	}
 	logger := setupLogger(c)
 
+	ctx := c.Context
+	if timeout := c.Duration(TimeoutFlagName); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var report *bindgen.Report
+	if outputFormat == OutputFormatJson {
+		report = bindgen.NewReport(oplog.AppOut(c))
+		defer func() {
+			if summaryErr := report.WriteSummary(err); summaryErr != nil && err == nil {
+				err = summaryErr
+			}
+		}()
+	}
+
+	var checkDir string
+	var checkedDirs []bindgen.CheckedDir
+	if c.Bool(CheckFlagName) {
+		var err error
+		checkDir, err = os.MkdirTemp("", "bindgen-check-*")
+		if err != nil {
+			return fmt.Errorf("error creating check directory: %w", err)
+		}
+		defer os.RemoveAll(checkDir)
+	}
+	continueOnError := c.Bool(ContinueOnErrorFlagName)
+	// failures collects the errors of the generators, there may be more than one with --continue-on-error
+	var failures []error
+
+	var manifest *bindgen.Manifest
+	if c.Bool(ManifestFlagName) {
+		manifest = bindgen.NewManifest()
+	}
+	abiRegistry := bindgen.NewAbiRegistry()
+	changes := bindgen.NewChanges()
+	var metadataOut, bindingsPackage, spdxLicense string
+	// setupGenerator points the outputs of a generator into the check directory, if checking,
+	// and shares the manifest, ABI registry, report and changes between the generators, so they describe all bindings of the run
+	setupGenerator := func(base *bindgen.BindGenGeneratorBase) error {
+		base.Manifest = manifest
+		base.AbiRegistry = abiRegistry
+		base.Report = report
+		base.Changes = changes
+		base.ContinueOnError = continueOnError
+		if checkDir != "" {
+			dirs, err := base.RedirectOutputs(checkDir)
+			if err != nil {
+				return err
+			}
+			if checkedDirs == nil {
+				checkedDirs = dirs
+			}
+		}
+		metadataOut = base.MetadataOut
+		bindingsPackage = base.BindingsPackageName
+		spdxLicense = base.SpdxLicense
+		return nil
+	}
+
 	switch c.Command.Name {
 	case "all":
 		localBindingsGenerator, err := parseConfigLocal(logger, c)
 		if err != nil {
 			return err
 		}
-		if err := localBindingsGenerator.GenerateBindings(); err != nil {
-			return fmt.Errorf("error generating local bindings: %w", err)
+		if err := setupGenerator(&localBindingsGenerator.BindGenGeneratorBase); err != nil {
+			return err
 		}
-
-		remoteBindingsGenerator, err := parseConfigRemote(logger, c)
+		collisions, err := localBindingsGenerator.NameCollisions()
 		if err != nil {
 			return err
 		}
-		if err := remoteBindingsGenerator.GenerateBindings(); err != nil {
-			return fmt.Errorf("error generating remote bindings: %w", err)
+		if len(collisions) != 0 {
+			if !c.Bool(AllowNameOverrideFlagName) {
+				return fmt.Errorf("contracts %s are both local and remote, and their bindings would overwrite each other, rename them or pass --%s to have the remote bindings overwrite the local ones",
+					strings.Join(collisions, ", "), AllowNameOverrideFlagName)
+			}
+			logger.Warn("Remote bindings overwrite the local bindings of the same name", "contracts", collisions)
+		}
+		if err := localBindingsGenerator.GenerateBindings(ctx); err != nil {
+			if !continueOnError {
+				return fmt.Errorf("error generating local bindings: %w", err)
+			}
+			failures = append(failures, fmt.Errorf("error generating local bindings: %w", err))
 		}
 
-		return nil
+		remoteBindingsGenerator, err := parseConfigRemote(ctx, logger, c)
+		if err != nil {
+			return err
+		}
+		if err := setupGenerator(&remoteBindingsGenerator.BindGenGeneratorBase); err != nil {
+			return err
+		}
+		if err := remoteBindingsGenerator.GenerateBindings(ctx); err != nil {
+			failures = append(failures, fmt.Errorf("error generating remote bindings: %w", err))
+		}
 	case "local":
 		localBindingsGenerator, err := parseConfigLocal(logger, c)
 		if err != nil {
 			return err
 		}
-		if err := localBindingsGenerator.GenerateBindings(); err != nil {
-			return fmt.Errorf("error generating local bindings: %w", err)
+		if err := setupGenerator(&localBindingsGenerator.BindGenGeneratorBase); err != nil {
+			return err
+		}
+		if err := localBindingsGenerator.GenerateBindings(ctx); err != nil {
+			failures = append(failures, fmt.Errorf("error generating local bindings: %w", err))
+		}
+	case "storage-layouts":
+		localBindingsGenerator, err := parseConfigLocal(logger, c)
+		if err != nil {
+			return err
+		}
+		localBindingsGenerator.MetadataOnly = true
+		if err := setupGenerator(&localBindingsGenerator.BindGenGeneratorBase); err != nil {
+			return err
+		}
+		if err := localBindingsGenerator.GenerateBindings(ctx); err != nil {
+			failures = append(failures, fmt.Errorf("error generating local metadata: %w", err))
 		}
-		return nil
 	case "remote":
-		remoteBindingsGenerator, err := parseConfigRemote(logger, c)
+		remoteBindingsGenerator, err := parseConfigRemote(ctx, logger, c)
 		if err != nil {
 			return err
 		}
-		if err := remoteBindingsGenerator.GenerateBindings(); err != nil {
-			return fmt.Errorf("error generating remote bindings: %w", err)
+		if err := setupGenerator(&remoteBindingsGenerator.BindGenGeneratorBase); err != nil {
+			return err
+		}
+		if err := remoteBindingsGenerator.GenerateBindings(ctx); err != nil {
+			failures = append(failures, fmt.Errorf("error generating remote bindings: %w", err))
 		}
-		return nil
 	default:
 		return fmt.Errorf("unknown command: %s", c.Command.Name)
 	}
+
+	if err := errors.Join(failures...); err != nil {
+		if continueOnError {
+			logFailureSummary(logger, err)
+		}
+		return err
+	}
+
+	if manifest != nil {
+		if err := manifest.Write(metadataOut); err != nil {
+			return err
+		}
+	}
+	// the contracts that are not selected by --only or --skip remain registered
+	keepRegistered := len(c.StringSlice(OnlyFlagName)) > 0 || len(c.StringSlice(SkipFlagName)) > 0
+	if err := abiRegistry.Write(metadataOut, bindingsPackage, spdxLicense, keepRegistered); err != nil {
+		return err
+	}
+
+	if checkDir == "" {
+		// files with unchanged content are not rewritten, so only the changed contracts show up in version control
+		if changedContracts := changes.Contracts(); len(changedContracts) != 0 {
+			logger.Info("Bindings changed", "contracts", len(changedContracts), "changed", changedContracts)
+		} else {
+			logger.Info("Bindings are unchanged")
+		}
+		return nil
+	}
+	changed, err := bindgen.DiffOutputs(checkedDirs)
+	if err != nil {
+		return err
+	}
+	if len(changed) != 0 {
+		return fmt.Errorf("bindings are out of date, %d file(s) differ from the generated output:\n\t%s", len(changed), strings.Join(changed, "\n\t"))
+	}
+	logger.Info("Bindings are up to date")
+	return nil
+}
+
+// logFailureSummary logs every contract that failed to generate, along with the chains it was sourced from.
+func logFailureSummary(logger log.Logger, err error) {
+	contractErrs := bindgen.ContractErrors(err)
+	logger.Error("Failed to generate bindings", "failedContracts", len(contractErrs))
+	for _, contractErr := range contractErrs {
+		logger.Error("Failed contract", "contract", contractErr.Contract, "chains", contractErr.Chains, "err", contractErr.Err)
+	}
 }
 
 func parseConfigBase(logger log.Logger, c *cli.Context) (bindgen.BindGenGeneratorBase, error) {
@@ -137,6 +374,11 @@ func parseConfigBase(logger log.Logger, c *cli.Context) (bindgen.BindGenGenerato
 		BindingsPackageName: c.String(BindingsPackageNameFlagName),
 		MonorepoBasePath:    monoRepoPath,
 		ContractsListPath:   c.String(ContractsListFlagName),
+		SpdxLicense:         c.String(SpdxFlagName),
+		TsOut:               c.String(TsOutFlagName),
+		EventTopics:         c.Bool(EventTopicsFlagName),
+		Only:                c.StringSlice(OnlyFlagName),
+		Skip:                c.StringSlice(SkipFlagName),
 		Logger:              logger,
 	}, nil
 }
@@ -146,30 +388,139 @@ func parseConfigLocal(logger log.Logger, c *cli.Context) (bindgen.BindGenGenerat
 	if err != nil {
 		return bindgen.BindGenGeneratorLocal{}, err
 	}
+	var libraries map[string]common.Address
+	if l, ok := c.Generic(LibraryFlagName).(*libraryAddresses); ok && l != nil {
+		libraries = *l
+	}
 	return bindgen.BindGenGeneratorLocal{
 		BindGenGeneratorBase: baseConfig,
 		SourceMapsList:       c.String(SourceMapsListFlagName),
+		SourceMapsOptional:   c.Bool(SourceMapsOptionalFlagName),
 		ForgeArtifactsPath:   c.String(ForgeArtifactsFlagName),
+		AbiOverlayPath:       c.String(AbiOverlayFlagName),
+		ImmutableGetters:     c.Bool(ImmutableGettersFlagName),
+		StorageSlots:         c.Bool(StorageSlotsFlagName),
+		Libraries:            libraries,
 	}, nil
 }
 
-func parseConfigRemote(logger log.Logger, c *cli.Context) (bindgen.BindGenGeneratorRemote, error) {
+func parseConfigRemote(ctx context.Context, logger log.Logger, c *cli.Context) (bindgen.BindGenGeneratorRemote, error) {
 	baseConfig, err := parseConfigBase(logger, c)
 	if err != nil {
 		return bindgen.BindGenGeneratorRemote{}, err
 	}
 	generator := bindgen.BindGenGeneratorRemote{
-		BindGenGeneratorBase: baseConfig,
+		BindGenGeneratorBase:        baseConfig,
+		ContractDataClients:         make(map[string]bindgen.ContractDataClient),
+		RpcClients:                  make(map[string]*ethclient.Client),
+		Concurrency:                 c.Int(RemoteConcurrencyFlagName),
+		VerifyBytecode:              c.Bool(VerifyBytecodeFlagName),
+		VerifyBytecodeStripMetadata: c.Bool(VerifyBytecodeStripMetadataFlagName),
+	}
+	if generator.Concurrency < 1 {
+		return bindgen.BindGenGeneratorRemote{}, fmt.Errorf("--%s must be at least 1, was %d", RemoteConcurrencyFlagName, generator.Concurrency)
+	}
+
+	var chains []chainConfig
+	if c.IsSet(RpcUrlEthFlagName) {
+		chains = append(chains, chainConfig{
+			Name:             "eth",
+			EtherscanApiUrl:  etherscan.EthereumApiUrl,
+			EtherscanApiKey:  c.String(EtherscanApiKeyEthFlagName),
+			BlockscoutApiUrl: blockscout.EthereumApiUrl,
+			RpcUrl:           c.String(RpcUrlEthFlagName),
+		})
+	}
+	if c.IsSet(RpcUrlOpFlagName) {
+		chains = append(chains, chainConfig{
+			Name:             "op",
+			EtherscanApiUrl:  etherscan.OptimismApiUrl,
+			EtherscanApiKey:  c.String(EtherscanApiKeyOpFlagName),
+			BlockscoutApiUrl: blockscout.OptimismApiUrl,
+			RpcUrl:           c.String(RpcUrlOpFlagName),
+		})
+	}
+	if extraChains, ok := c.Generic(ChainFlagName).(*chainConfigs); ok && extraChains != nil {
+		chains = append(chains, *extraChains...)
+	}
+	if len(chains) == 0 {
+		return bindgen.BindGenGeneratorRemote{}, fmt.Errorf("no chains configured, provide --%s and --%s, and/or --%s", RpcUrlEthFlagName, RpcUrlOpFlagName, ChainFlagName)
 	}
 
-	generator.ContractDataClients.Eth = etherscan.NewEthereumClient(c.String(EtherscanApiKeyEthFlagName))
-	generator.ContractDataClients.Op = etherscan.NewOptimismClient(c.String(EtherscanApiKeyOpFlagName))
+	retryConfig := etherscan.DefaultRetryConfig
+	retryConfig.MaxRetries = c.Int(EtherscanMaxRetriesFlagName)
+	retryConfig.BaseDelay = c.Duration(EtherscanRetryBaseDelayFlagName)
+	retryConfig.MaxElapsed = c.Duration(EtherscanRetryMaxElapsedFlagName)
 
-	if generator.RpcClients.Eth, err = ethclient.Dial(c.String(RpcUrlEthFlagName)); err != nil {
-		return bindgen.BindGenGeneratorRemote{}, fmt.Errorf("error initializing Ethereum client: %w", err)
+	// Etherscan enforces its request limit per API key, so a single limiter is shared by the etherscan clients of all chains.
+	etherscanRps := c.Float64(EtherscanRpsFlagName)
+	if etherscanRps < 0 {
+		return bindgen.BindGenGeneratorRemote{}, fmt.Errorf("--%s must not be negative, was %v", EtherscanRpsFlagName, etherscanRps)
 	}
-	if generator.RpcClients.Op, err = ethclient.Dial(c.String(RpcUrlOpFlagName)); err != nil {
-		return bindgen.BindGenGeneratorRemote{}, fmt.Errorf("error initializing Optimism client: %w", err)
+	etherscanBurst := c.Int(EtherscanBurstFlagName)
+	if etherscanBurst < 1 {
+		return bindgen.BindGenGeneratorRemote{}, fmt.Errorf("--%s must be at least 1, was %d", EtherscanBurstFlagName, etherscanBurst)
+	}
+	var etherscanLimiter *rate.Limiter
+	if etherscanRps > 0 {
+		etherscanLimiter = rate.NewLimiter(rate.Limit(etherscanRps), etherscanBurst)
+	}
+	// nothing is logged if no chain is sourced from etherscan
+	etherscanStats := etherscan.NewRequestStats()
+	generator.EtherscanStats = etherscanStats
+
+	sourceKind := c.String(SourceKindFlagName)
+	if !isSourceKind(sourceKind) {
+		return bindgen.BindGenGeneratorRemote{}, fmt.Errorf("unknown contract data source kind: %s, expected one of %s", sourceKind, strings.Join(sourceKinds, ", "))
+	}
+
+	// A single limiter is shared by the clients of all chains, so concurrent workers
+	// stay under the request limit of the account used for all of them.
+	// Etherscan clients are throttled by the etherscan limiter instead, so their requests are not limited twice.
+	rateLimit := c.Float64(RemoteRateLimitFlagName)
+	if rateLimit <= 0 {
+		return bindgen.BindGenGeneratorRemote{}, fmt.Errorf("--%s must be positive, was %v", RemoteRateLimitFlagName, rateLimit)
+	}
+	limiter := rate.NewLimiter(rate.Limit(rateLimit), 1)
+
+	for _, chain := range chains {
+		if _, ok := generator.RpcClients[chain.Name]; ok {
+			return bindgen.BindGenGeneratorRemote{}, fmt.Errorf("chain %s is configured more than once", chain.Name)
+		}
+		rpcClient, err := ethclient.DialContext(ctx, chain.RpcUrl)
+		if err != nil {
+			return bindgen.BindGenGeneratorRemote{}, fmt.Errorf("error initializing RPC client for chain %s: %w", chain.Name, err)
+		}
+		generator.RpcClients[chain.Name] = rpcClient
+
+		chainSourceKind := sourceKind
+		if chain.Source != "" {
+			chainSourceKind = chain.Source
+		}
+		switch chainSourceKind {
+		case "etherscan":
+			if chain.EtherscanApiUrl == "" || chain.EtherscanApiKey == "" {
+				return bindgen.BindGenGeneratorRemote{}, fmt.Errorf("an etherscan API URL and API key are required for chain %s when sourcing contract data from etherscan", chain.Name)
+			}
+			generator.ContractDataClients[chain.Name] = etherscan.NewClientWithMetrics(chain.EtherscanApiUrl, chain.EtherscanApiKey, retryConfig, etherscanLimiter, etherscanStats)
+		case "sourcify":
+			chainId, err := rpcClient.ChainID(ctx)
+			if err != nil {
+				return bindgen.BindGenGeneratorRemote{}, fmt.Errorf("error fetching chain ID of chain %s for Sourcify: %w", chain.Name, err)
+			}
+			generator.ContractDataClients[chain.Name] = bindgen.NewRateLimitedContractDataClient(sourcify.NewClient(c.String(SourcifyUrlFlagName), chainId.Uint64()), limiter)
+		case "blockscout":
+			if chain.BlockscoutApiUrl == "" {
+				return bindgen.BindGenGeneratorRemote{}, fmt.Errorf("a blockscout API URL is required for chain %s when sourcing contract data from blockscout", chain.Name)
+			}
+			generator.ContractDataClients[chain.Name] = bindgen.NewRateLimitedContractDataClient(blockscout.NewClient(chain.BlockscoutApiUrl), limiter)
+		}
+
+		if cacheDir := c.String(RemoteCacheDirFlagName); cacheDir != "" {
+			generator.ContractDataClients[chain.Name] = bindgen.NewCachingContractDataClient(
+				logger, generator.ContractDataClients[chain.Name], cacheDir, chain.Name, c.Duration(RemoteCacheTtlFlagName),
+			)
+		}
 	}
 	return generator, nil
 }
@@ -188,49 +539,203 @@ func baseFlags() []cli.Flag {
 		},
 		&cli.StringFlag{
 			Name:     ContractsListFlagName,
-			Usage:    "Path to file containing list of contract names to generate bindings for",
+			Usage:    "Path to file containing list of contract names to generate bindings for, - to read it from stdin, or a directory or glob pattern of files to merge",
 			Required: true,
 		},
+		&cli.StringFlag{
+			Name:  SpdxFlagName,
+			Usage: "SPDX license identifier to inject at the top of every generated file, e.g. MIT",
+		},
+		&cli.StringFlag{
+			Name:  TsOutFlagName,
+			Usage: "Optional output directory to write the ABI of every contract to as a TypeScript file",
+		},
+		&cli.BoolFlag{
+			Name:  EventTopicsFlagName,
+			Usage: "Generate a <ContractName><Event>EventTopic variable holding the topic0 of each event of every contract, next to its binding",
+		},
+		&cli.StringSliceFlag{
+			Name:  OnlyFlagName,
+			Usage: "Only generate the contracts whose name matches any of these glob patterns, e.g. L1* or Safe_v130. Can be repeated",
+		},
+		&cli.StringSliceFlag{
+			Name:  SkipFlagName,
+			Usage: "Skip generating the contracts whose name matches any of these glob patterns. Can be repeated",
+		},
+		&cli.BoolFlag{
+			Name:  ManifestFlagName,
+			Usage: "Write a manifest.json describing all generated bindings into the metadata output directory",
+		},
+		&cli.BoolFlag{
+			Name:  ContinueOnErrorFlagName,
+			Usage: "Keep generating the remaining contracts when one fails, and report all failures at the end",
+		},
+		&cli.StringFlag{
+			Name:  OutputFormatFlagName,
+			Usage: "Output format, text for logs, or json for a JSON object per generated contract and a final summary, with the logs written to stderr",
+			Value: OutputFormatText,
+		},
+		&cli.BoolFlag{
+			Name:  CheckFlagName,
+			Usage: "Generate into a temporary directory and fail if the existing output differs, without writing to it",
+		},
+		&cli.DurationFlag{
+			Name:  TimeoutFlagName,
+			Usage: "Abort generating bindings after this duration, including the in-flight requests to contract data sources and RPCs, 0 for no timeout",
+		},
 	}
 
 	return append(baseFlags, oplog.CLIFlags("bindgen")...)
 }
 
+func allFlags() []cli.Flag {
+	return append(append(localFlags(), remoteFlags()...), &cli.BoolFlag{
+		Name:  AllowNameOverrideFlagName,
+		Usage: "Allow local and remote contracts of the same name, the remote bindings overwrite the local ones",
+	})
+}
+
 func localFlags() []cli.Flag {
 	return []cli.Flag{
 		&cli.StringFlag{
 			Name:  SourceMapsListFlagName,
 			Usage: "Comma-separated list of contracts to generate source-maps for",
 		},
+		&cli.BoolFlag{
+			Name:  SourceMapsOptionalFlagName,
+			Usage: "Generate contracts of the source-maps list without a source map if their forge artifact has none, instead of failing",
+		},
 		&cli.StringFlag{
 			Name:     ForgeArtifactsFlagName,
 			Usage:    "Path to forge-artifacts directory, containing compiled contract artifacts",
 			Required: true,
 		},
+		&cli.StringFlag{
+			Name:  AbiOverlayFlagName,
+			Usage: "Path to directory containing per-contract ABI fragments (<ContractName>.json) to merge onto the artifact ABI",
+		},
+		&cli.BoolFlag{
+			Name:  ImmutableGettersFlagName,
+			Usage: "Generate typed getters which decode each contract's immutables from its deployed bytecode",
+		},
+		&cli.BoolFlag{
+			Name:  StorageSlotsFlagName,
+			Usage: "Generate functions which compute the storage slot of each variable of each contract's storage layout, including the slots of mapping values",
+		},
+		&cli.GenericFlag{
+			Name: LibraryFlagName,
+			Usage: "Address to link an external library at in the bytecode of contracts, as name=address, or <source>:<name>=address " +
+				"to disambiguate libraries of the same name. Contracts referencing libraries without an address fail to generate. Can be repeated",
+			Value: new(libraryAddresses),
+		},
 	}
 }
 
-func remoteFlags() []cli.Flag {
-	return []cli.Flag{
+func inspectFlags() []cli.Flag {
+	flags := []cli.Flag{
 		&cli.StringFlag{
-			Name:     EtherscanApiKeyEthFlagName,
-			Usage:    "API key to make queries to Etherscan for Ethereum",
+			Name:     ForgeArtifactsFlagName,
+			Usage:    "Path to forge-artifacts directory, containing compiled contract artifacts",
 			Required: true,
 		},
 		&cli.StringFlag{
-			Name:     EtherscanApiKeyOpFlagName,
-			Usage:    "API key to make queries to Etherscan for Optimism",
+			Name:     ContractFlagName,
+			Usage:    "Name of the contract to print the ABI of",
 			Required: true,
 		},
+		&cli.BoolFlag{
+			Name:  StorageFlagName,
+			Usage: "Print an object of the ABI and the storage layout of the contract instead",
+		},
+	}
+	return append(flags, oplog.CLIFlags("bindgen")...)
+}
+
+func remoteFlags() []cli.Flag {
+	return []cli.Flag{
 		&cli.StringFlag{
-			Name:     RpcUrlEthFlagName,
-			Usage:    "RPC URL (with API key if required) to query Ethereum",
-			Required: true,
+			Name:  SourceKindFlagName,
+			Usage: "Where to source remote contract data from: etherscan, sourcify or blockscout. Can be overridden per chain with --chain",
+			Value: "etherscan",
 		},
 		&cli.StringFlag{
-			Name:     RpcUrlOpFlagName,
-			Usage:    "RPC URL (with API key if required) to query Optimism",
-			Required: true,
+			Name:  SourcifyUrlFlagName,
+			Usage: "URL of the Sourcify server to query when --source.kind is sourcify",
+			Value: sourcify.DefaultServerUrl,
+		},
+		&cli.StringFlag{
+			Name:  EtherscanApiKeyEthFlagName,
+			Usage: "API key to make queries to Etherscan for Ethereum, required for the \"eth\" chain when --source.kind is etherscan",
+		},
+		&cli.StringFlag{
+			Name:  EtherscanApiKeyOpFlagName,
+			Usage: "API key to make queries to Etherscan for Optimism, required for the \"op\" chain when --source.kind is etherscan",
+		},
+		&cli.IntFlag{
+			Name:  EtherscanMaxRetriesFlagName,
+			Usage: "Number of times a rate limited or otherwise transiently failing Etherscan request is retried",
+			Value: etherscan.DefaultRetryConfig.MaxRetries,
+		},
+		&cli.DurationFlag{
+			Name:  EtherscanRetryBaseDelayFlagName,
+			Usage: "Delay before retrying a failed Etherscan request, doubled with every retry",
+			Value: etherscan.DefaultRetryConfig.BaseDelay,
+		},
+		&cli.DurationFlag{
+			Name:  EtherscanRetryMaxElapsedFlagName,
+			Usage: "Total time after which a failing Etherscan request is no longer retried, 0 for no limit",
+			Value: etherscan.DefaultRetryConfig.MaxElapsed,
+		},
+		&cli.Float64Flag{
+			Name:  EtherscanRpsFlagName,
+			Usage: "Maximum number of requests per second sent to Etherscan, including retries, shared across all chains. 0 disables throttling",
+			Value: 5,
+		},
+		&cli.IntFlag{
+			Name:  EtherscanBurstFlagName,
+			Usage: "Number of requests that may be sent to Etherscan at once, before being throttled to --" + EtherscanRpsFlagName,
+			Value: 1,
+		},
+		&cli.StringFlag{
+			Name:  RemoteCacheDirFlagName,
+			Usage: "Directory to cache remotely sourced contract data in, to skip refetching it on reruns. Caching is disabled if not set",
+		},
+		&cli.IntFlag{
+			Name:  RemoteConcurrencyFlagName,
+			Usage: "Maximum number of contracts to fetch remote data for at once",
+			Value: 4,
+		},
+		&cli.Float64Flag{
+			Name:  RemoteRateLimitFlagName,
+			Usage: "Maximum number of requests per second to send to Sourcify and Blockscout, shared across all chains. Etherscan requests are throttled by --" + EtherscanRpsFlagName + " instead",
+			Value: 5,
+		},
+		&cli.BoolFlag{
+			Name:  VerifyBytecodeFlagName,
+			Usage: "Verify the fetched deployed bytecode of every contract matches the code on-chain, modulo immutables",
+		},
+		&cli.BoolFlag{
+			Name:  VerifyBytecodeStripMetadataFlagName,
+			Usage: "Strip the CBOR metadata solc appends to bytecode before verifying it, to ignore differences in metadata hashes",
+		},
+		&cli.DurationFlag{
+			Name:  RemoteCacheTtlFlagName,
+			Usage: "How long cached remote contract data is used before it is refetched, 0 to never refetch",
+			Value: 24 * time.Hour,
+		},
+		&cli.StringFlag{
+			Name:  RpcUrlEthFlagName,
+			Usage: "RPC URL (with API key if required) to query Ethereum, configures the \"eth\" chain",
+		},
+		&cli.StringFlag{
+			Name:  RpcUrlOpFlagName,
+			Usage: "RPC URL (with API key if required) to query Optimism, configures the \"op\" chain",
+		},
+		&cli.GenericFlag{
+			Name: ChainFlagName,
+			Usage: "Additional chain to source contract data from, as name=<name>,etherscan-api-url=<url>,etherscan-api-key=<key>,rpc-url=<url>, " +
+				"optionally with source=<kind> and blockscout-api-url=<url>. The name is used to reference the chain in the contracts list. Can be repeated",
+			Value: new(chainConfigs),
 		},
 	}
 }
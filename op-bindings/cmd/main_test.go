@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindgen"
+)
+
+func TestInvalidLogLevel(t *testing.T) {
+	run := func(args ...string) error {
+		app := newApp()
+		app.Writer = io.Discard
+		app.ErrWriter = io.Discard
+		return app.Run(append([]string{"bindgen"}, args...))
+	}
+	require.ErrorContains(t, run("generate", "--log.level=verbose", "local"), "unknown level: verbose")
+
+	t.Setenv("bindgen_LOG_LEVEL", "verbose")
+	require.ErrorContains(t, run("generate", "local"), "unknown level: verbose")
+}
+
+func TestInspect(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "L1Block.sol", "L1Block.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(`{"abi": [{"type":"event","name":"Set","inputs":[],"anonymous":false}]}`), 0o600))
+
+	var out bytes.Buffer
+	app := newApp()
+	app.Writer = &out
+	app.ErrWriter = io.Discard
+	require.NoError(t, app.Run([]string{"bindgen", "inspect", "--forge-artifacts", dir, "--contract", "L1Block"}))
+	// the logs are not mixed into the ABI
+	require.JSONEq(t, `[{"type":"event","name":"Set","inputs":[],"anonymous":false}]`, out.String())
+}
+
+func TestParseConfigRemoteRateLimits(t *testing.T) {
+	var generator bindgen.BindGenGeneratorRemote
+	app := &cli.App{
+		Flags: append(baseFlags(), remoteFlags()...),
+		Action: func(c *cli.Context) error {
+			var err error
+			generator, err = parseConfigRemote(c.Context, nil, c)
+			return err
+		},
+	}
+	app.Writer = io.Discard
+	app.ErrWriter = io.Discard
+	require.NoError(t, app.Run([]string{"bindgen",
+		"--metadata-out", t.TempDir(), "--bindings-package", "bindings", "--contracts-list", "contracts.json",
+		"--source.kind", "etherscan",
+		"--chain", "name=l1,etherscan-api-url=http://localhost:1,etherscan-api-key=KEY,rpc-url=http://localhost:2",
+		"--chain", "name=devnet,source=blockscout,blockscout-api-url=http://localhost:3,rpc-url=http://localhost:4",
+	}))
+
+	// etherscan clients are throttled by the etherscan limiter only, other sources by --remote-rate-limit
+	require.Equal(t, "*etherscan.client", fmt.Sprintf("%T", generator.ContractDataClients["l1"]))
+	require.Equal(t, "*bindgen.rateLimitedContractDataClient", fmt.Sprintf("%T", generator.ContractDataClients["devnet"]))
+}
@@ -8,14 +8,20 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
-	"github.com/ethereum-optimism/optimism/op-service/retry"
+	"golang.org/x/time/rate"
 )
 
 type client struct {
-	baseUrl    string
-	httpClient *http.Client
+	baseUrl     string
+	httpClient  *http.Client
+	retryConfig RetryConfig
+	// limiter gates every outbound request, including retries, it may be shared by multiple clients.
+	// Requests are not throttled if nil.
+	limiter *rate.Limiter
+	metrics Metrics
 }
 
 type apiResponse struct {
@@ -36,27 +42,101 @@ type Transaction struct {
 	To    string `json:"to"`
 }
 
-const apiMaxRetries = 3
-const apiRetryDelay = time.Duration(2) * time.Second
-const errRateLimited = "Max rate limit reached"
+const (
+	EthereumApiUrl = "https://api.etherscan.io"
+	OptimismApiUrl = "https://api-optimistic.etherscan.io"
+)
+
+// ErrRateLimited is returned when Etherscan kept rate limiting requests until all retries were exhausted.
+var ErrRateLimited = errors.New("etherscan rate limit reached")
+
+// RetryConfig configures how requests that fail transiently, e.g. due to rate limiting, are retried.
+// The delay between retries starts at BaseDelay, and doubles with every retry up to MaxDelay.
+type RetryConfig struct {
+	// MaxRetries is the number of times a request is retried after the initial attempt.
+	MaxRetries int
+	// BaseDelay is the delay before the first retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts.
+	MaxDelay time.Duration
+	// MaxElapsed is the total time after which no more retries are attempted. Zero means no limit.
+	MaxElapsed time.Duration
+}
+
+var DefaultRetryConfig = RetryConfig{
+	MaxRetries: 5,
+	BaseDelay:  time.Second,
+	MaxDelay:   30 * time.Second,
+	MaxElapsed: 2 * time.Minute,
+}
 
 func NewClient(baseUrl, apiKey string) *client {
+	return NewClientWithRetryConfig(baseUrl, apiKey, DefaultRetryConfig)
+}
+
+func NewClientWithRetryConfig(baseUrl, apiKey string, retryConfig RetryConfig) *client {
+	return NewClientWithRateLimiter(baseUrl, apiKey, retryConfig, nil)
+}
+
+// NewClientWithRateLimiter creates a client whose requests are throttled by the given limiter. Sharing a limiter
+// between the clients of multiple chains keeps them under the request limit of the API key they have in common.
+// Rate limited responses are still retried with backoff, should the limiter allow more requests than Etherscan.
+func NewClientWithRateLimiter(baseUrl, apiKey string, retryConfig RetryConfig, limiter *rate.Limiter) *client {
+	return NewClientWithMetrics(baseUrl, apiKey, retryConfig, limiter, nil)
+}
+
+// NewClientWithMetrics creates a client like NewClientWithRateLimiter, which records its requests with the given metrics.
+// The metrics may be nil, to not record any.
+func NewClientWithMetrics(baseUrl, apiKey string, retryConfig RetryConfig, limiter *rate.Limiter, metrics Metrics) *client {
+	if metrics == nil {
+		metrics = NoopMetrics{}
+	}
 	return &client{
 		baseUrl: baseUrl + "/api?apikey=" + apiKey + "&",
 		httpClient: &http.Client{
 			Timeout: time.Second * 10,
 		},
+		retryConfig: retryConfig,
+		limiter:     limiter,
+		metrics:     metrics,
 	}
 }
 
-func NewEthereumClient(apiKey string) *client {
-	return NewClient("https://api.etherscan.io", apiKey)
+// NewEthereumClient creates a client of Etherscan for Ethereum Mainnet, recording its requests with the given metrics, if not nil.
+func NewEthereumClient(apiKey string, metrics Metrics) *client {
+	return NewClientWithMetrics(EthereumApiUrl, apiKey, DefaultRetryConfig, nil, metrics)
 }
 
-func NewOptimismClient(apiKey string) *client {
-	return NewClient("https://api-optimistic.etherscan.io", apiKey)
+// NewOptimismClient creates a client of Etherscan for Optimism Mainnet, recording its requests with the given metrics, if not nil.
+func NewOptimismClient(apiKey string, metrics Metrics) *client {
+	return NewClientWithMetrics(OptimismApiUrl, apiKey, DefaultRetryConfig, nil, metrics)
 }
 
+// request runs op, an attempt to request the given endpoint, until it succeeds or no more retries are allowed.
+// Every attempt is throttled by the limiter of the client, and recorded with its metrics. The time spent waiting
+// for the limiter is not recorded, so the recorded latency is that of Etherscan.
+func request[T any](ctx context.Context, c *client, endpoint string, op func() (T, error)) (T, error) {
+	attempts := 0
+	return withRetries(ctx, c.retryConfig, func() (T, error) {
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx); err != nil {
+				var empty T
+				return empty, err
+			}
+		}
+		if attempts > 0 {
+			c.metrics.RecordRetry(endpoint)
+		}
+		attempts++
+		start := time.Now()
+		ret, err := op()
+		c.metrics.RecordRequest(endpoint, time.Since(start), err)
+		return ret, err
+	})
+}
+
+// fetch requests the given url, and returns the response body of a successful request.
+// Failures that are expected to be resolved by retrying the request are marked as retryable.
 func (c *client) fetch(ctx context.Context, url string) ([]byte, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
@@ -64,19 +144,34 @@ func (c *client) fetch(ctx context.Context, url string) ([]byte, error) {
 	}
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, retryableError{err}
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, retryableError{err}
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return nil, retryableError{fmt.Errorf("%w: received status %d", ErrRateLimited, resp.StatusCode)}
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return nil, retryableError{fmt.Errorf("request failed with status %d: %s", resp.StatusCode, body)}
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, body)
 	}
 	return body, nil
 }
 
-func (c *client) fetchEtherscanApi(ctx context.Context, url string) (apiResponse, error) {
-	return retry.Do[apiResponse](ctx, apiMaxRetries, retry.Fixed(apiRetryDelay), func() (apiResponse, error) {
+// isRateLimitMessage reports whether an Etherscan message or result describes a rate limit,
+// e.g. "Max rate limit reached" or "Max calls per sec rate limit reached (5/sec)".
+func isRateLimitMessage(msg string) bool {
+	return strings.Contains(strings.ToLower(msg), "rate limit")
+}
+
+func (c *client) fetchEtherscanApi(ctx context.Context, endpoint, url string) (apiResponse, error) {
+	return request(ctx, c, endpoint, func() (apiResponse, error) {
 		body, err := c.fetch(ctx, url)
 		if err != nil {
 			return apiResponse{}, err
@@ -91,14 +186,13 @@ func (c *client) fetchEtherscanApi(ctx context.Context, url string) (apiResponse
 		if response.Message != "OK" {
 			var resultString string
 			err = json.Unmarshal(response.Result, &resultString)
+			if isRateLimitMessage(response.Message) || (err == nil && isRateLimitMessage(resultString)) {
+				return apiResponse{}, retryableError{fmt.Errorf("%w: %s %s", ErrRateLimited, response.Message, resultString)}
+			}
 			if err != nil {
 				return apiResponse{}, fmt.Errorf("response for %s not OK, returned message: %s", url, response.Message)
 			}
 
-			if resultString == errRateLimited {
-				return apiResponse{}, errors.New(errRateLimited)
-			}
-
 			return apiResponse{}, fmt.Errorf("there was an issue with the Etherscan request to %s, received response: %v", url, response)
 		}
 
@@ -106,8 +200,8 @@ func (c *client) fetchEtherscanApi(ctx context.Context, url string) (apiResponse
 	})
 }
 
-func (c *client) fetchEtherscanRpc(ctx context.Context, url string) (rpcResponse, error) {
-	return retry.Do[rpcResponse](ctx, apiMaxRetries, retry.Fixed(apiRetryDelay), func() (rpcResponse, error) {
+func (c *client) fetchEtherscanRpc(ctx context.Context, endpoint, url string) (rpcResponse, error) {
+	return request(ctx, c, endpoint, func() (rpcResponse, error) {
 		body, err := c.fetch(ctx, url)
 		if err != nil {
 			return rpcResponse{}, err
@@ -119,10 +213,13 @@ func (c *client) fetchEtherscanRpc(ctx context.Context, url string) (rpcResponse
 			return rpcResponse{}, fmt.Errorf("failed to unmarshal as rpcResponse: %w", err)
 		}
 
+		// rate limited proxy requests are answered with an API response, rather than a JSON-RPC response
+		var rateLimited apiResponse
+		_ = json.Unmarshal(body, &rateLimited)
 		var resultString string
 		_ = json.Unmarshal(response.Result, &resultString)
-		if resultString == errRateLimited {
-			return rpcResponse{}, errors.New(errRateLimited)
+		if isRateLimitMessage(resultString) || (rateLimited.Message != "OK" && isRateLimitMessage(rateLimited.Message)) {
+			return rpcResponse{}, retryableError{fmt.Errorf("%w: %s %s", ErrRateLimited, rateLimited.Message, resultString)}
 		}
 
 		return response, nil
@@ -133,7 +230,7 @@ func (c *client) FetchAbi(ctx context.Context, address string) (string, error) {
 	params := url.Values{}
 	params.Set("address", address)
 	url := constructUrl(c.baseUrl, "getabi", "contract", params)
-	response, err := c.fetchEtherscanApi(ctx, url)
+	response, err := c.fetchEtherscanApi(ctx, "getabi", url)
 	if err != nil {
 		return "", err
 	}
@@ -151,7 +248,7 @@ func (c *client) FetchDeployedBytecode(ctx context.Context, address string) (str
 	params := url.Values{}
 	params.Set("address", address)
 	url := constructUrl(c.baseUrl, "eth_getCode", "proxy", params)
-	response, err := c.fetchEtherscanRpc(ctx, url)
+	response, err := c.fetchEtherscanRpc(ctx, "eth_getCode", url)
 	if err != nil {
 		return "", fmt.Errorf("error fetching deployed bytecode: %w", err)
 	}
@@ -169,7 +266,7 @@ func (c *client) FetchDeploymentTxHash(ctx context.Context, address string) (str
 	params := url.Values{}
 	params.Set("contractaddresses", address)
 	url := constructUrl(c.baseUrl, "getcontractcreation", "contract", params)
-	response, err := c.fetchEtherscanApi(ctx, url)
+	response, err := c.fetchEtherscanApi(ctx, "getcontractcreation", url)
 	if err != nil {
 		return "", err
 	}
@@ -194,7 +291,7 @@ func (c *client) FetchDeploymentTx(ctx context.Context, txHash string) (Transact
 	params.Set("txHash", txHash)
 	params.Set("tag", "latest")
 	url := constructUrl(c.baseUrl, "eth_getTransactionByHash", "proxy", params)
-	response, err := c.fetchEtherscanRpc(ctx, url)
+	response, err := c.fetchEtherscanRpc(ctx, "eth_getTransactionByHash", url)
 	if err != nil {
 		return Transaction{}, err
 	}
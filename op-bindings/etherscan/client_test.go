@@ -0,0 +1,197 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+var testRetryConfig = RetryConfig{
+	MaxRetries: 3,
+	BaseDelay:  time.Millisecond,
+	MaxDelay:   10 * time.Millisecond,
+}
+
+// newTestServer serves the given responses in order, repeating the last response once exhausted.
+func newTestServer(t *testing.T, calls *int, responses ...func(w http.ResponseWriter)) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respond := responses[min(*calls, len(responses)-1)]
+		*calls++
+		respond(w)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func respondWith(status int, body string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+const abiOK = `{"status":"1","message":"OK","result":"[]"}`
+
+func TestClient_RetriesRateLimits(t *testing.T) {
+	t.Run("HTTPStatus", func(t *testing.T) {
+		var calls int
+		srv := newTestServer(t, &calls,
+			respondWith(http.StatusTooManyRequests, "slow down"),
+			respondWith(http.StatusTooManyRequests, "slow down"),
+			respondWith(http.StatusOK, abiOK))
+		c := NewClientWithRetryConfig(srv.URL, "key", testRetryConfig)
+
+		abi, err := c.FetchAbi(context.Background(), "0x01")
+		require.NoError(t, err)
+		require.Equal(t, "[]", abi)
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("JSONMessage", func(t *testing.T) {
+		var calls int
+		srv := newTestServer(t, &calls,
+			respondWith(http.StatusOK, `{"status":"0","message":"NOTOK","result":"Max calls per sec rate limit reached (5/sec)"}`),
+			respondWith(http.StatusOK, abiOK))
+		c := NewClientWithRetryConfig(srv.URL, "key", testRetryConfig)
+
+		_, err := c.FetchAbi(context.Background(), "0x01")
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("RPCProxy", func(t *testing.T) {
+		var calls int
+		srv := newTestServer(t, &calls,
+			respondWith(http.StatusOK, `{"status":"0","message":"NOTOK","result":"Max rate limit reached"}`),
+			respondWith(http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":"0x6001"}`))
+		c := NewClientWithRetryConfig(srv.URL, "key", testRetryConfig)
+
+		bytecode, err := c.FetchDeployedBytecode(context.Background(), "0x01")
+		require.NoError(t, err)
+		require.Equal(t, "0x6001", bytecode)
+		require.Equal(t, 2, calls)
+	})
+}
+
+func TestClient_GivesUpAfterMaxRetries(t *testing.T) {
+	var calls int
+	srv := newTestServer(t, &calls, respondWith(http.StatusTooManyRequests, "slow down"))
+	c := NewClientWithRetryConfig(srv.URL, "key", testRetryConfig)
+
+	_, err := c.FetchAbi(context.Background(), "0x01")
+	require.ErrorIs(t, err, ErrRateLimited)
+	require.Equal(t, testRetryConfig.MaxRetries+1, calls)
+}
+
+func TestClient_GivesUpAfterMaxElapsed(t *testing.T) {
+	var calls int
+	srv := newTestServer(t, &calls, respondWith(http.StatusServiceUnavailable, "unavailable"))
+	cfg := testRetryConfig
+	cfg.MaxRetries = 100
+	cfg.MaxElapsed = 20 * time.Millisecond
+	c := NewClientWithRetryConfig(srv.URL, "key", cfg)
+
+	_, err := c.FetchAbi(context.Background(), "0x01")
+	require.ErrorContains(t, err, "exceeding max elapsed time")
+	require.Less(t, calls, 100)
+}
+
+func TestClient_DoesNotRetryPermanentErrors(t *testing.T) {
+	var calls int
+	srv := newTestServer(t, &calls, respondWith(http.StatusOK, `{"status":"0","message":"NOTOK","result":"Contract source code not verified"}`))
+	c := NewClientWithRetryConfig(srv.URL, "key", testRetryConfig)
+
+	_, err := c.FetchAbi(context.Background(), "0x01")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrRateLimited)
+	require.Equal(t, 1, calls)
+}
+
+func TestRetryConfigBackoff(t *testing.T) {
+	cfg := RetryConfig{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	require.Equal(t, time.Second, cfg.backoff(0))
+	require.Equal(t, 2*time.Second, cfg.backoff(1))
+	require.Equal(t, 4*time.Second, cfg.backoff(2))
+	require.Equal(t, 5*time.Second, cfg.backoff(3))
+	require.Equal(t, 5*time.Second, cfg.backoff(60))
+}
+
+func TestClient_RateLimiter(t *testing.T) {
+	var calls int
+	srv := newTestServer(t, &calls,
+		respondWith(http.StatusTooManyRequests, "slow down"),
+		respondWith(http.StatusOK, abiOK))
+	// the limiter is shared, so both clients are throttled together
+	limiter := rate.NewLimiter(rate.Every(50*time.Millisecond), 1)
+	eth := NewClientWithRateLimiter(srv.URL, "key", testRetryConfig, limiter)
+	op := NewClientWithRateLimiter(srv.URL, "key", testRetryConfig, limiter)
+
+	start := time.Now()
+	// the rate limited response is retried, even though the limiter allowed the request
+	_, err := eth.FetchAbi(context.Background(), "0x01")
+	require.NoError(t, err)
+	_, err = op.FetchAbi(context.Background(), "0x02")
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+	// the retry and the request of the second client each waited for the limiter
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+	// waiting for the limiter is aborted with the context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = op.FetchAbi(ctx, "0x03")
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 3, calls)
+}
+
+func TestClient_Metrics(t *testing.T) {
+	var calls int
+	srv := newTestServer(t, &calls,
+		respondWith(http.StatusTooManyRequests, "slow down"),
+		respondWith(http.StatusOK, abiOK),
+		respondWith(http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":"0x6001"}`),
+		respondWith(http.StatusOK, `{"status":"0","message":"NOTOK","result":"Contract source code not verified"}`))
+	stats := NewRequestStats()
+	c := NewClientWithMetrics(srv.URL, "key", testRetryConfig, nil, stats)
+
+	_, err := c.FetchAbi(context.Background(), "0x01")
+	require.NoError(t, err)
+	_, err = c.FetchDeployedBytecode(context.Background(), "0x01")
+	require.NoError(t, err)
+	_, err = c.FetchAbi(context.Background(), "0x02")
+	require.Error(t, err)
+
+	endpoints := stats.Endpoints()
+	require.Len(t, endpoints, 2)
+	require.Equal(t, "eth_getCode", endpoints[0].Endpoint)
+	require.Equal(t, 1, endpoints[0].Requests)
+	require.Zero(t, endpoints[0].Retries)
+	require.Zero(t, endpoints[0].Errors)
+
+	getAbi := endpoints[1]
+	require.Equal(t, "getabi", getAbi.Endpoint)
+	require.Equal(t, 3, getAbi.Requests)
+	require.Equal(t, 1, getAbi.Retries)
+	require.Equal(t, 2, getAbi.Errors)
+	require.Positive(t, getAbi.Duration)
+	// the requests to the local server are fast
+	require.Equal(t, 3, getAbi.Latency[0])
+	require.Len(t, getAbi.Latency, len(LatencyBuckets)+1)
+}
+
+func TestRequestStatsLatency(t *testing.T) {
+	stats := NewRequestStats()
+	stats.RecordRequest("getabi", time.Second, nil)
+	stats.RecordRequest("getabi", time.Minute, nil)
+	latency := stats.Endpoints()[0].Latency
+	require.Equal(t, 1, latency[2])
+	require.Equal(t, 1, latency[len(LatencyBuckets)])
+	// the stats returned are a copy
+	latency[0] = 100
+	require.Zero(t, stats.Endpoints()[0].Latency[0])
+}
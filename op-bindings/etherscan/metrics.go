@@ -0,0 +1,102 @@
+package etherscan
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Metrics records the requests of a client per endpoint, which is the Etherscan action requested,
+// e.g. getabi or eth_getCode.
+type Metrics interface {
+	// RecordRequest records a single attempt of a request, retries included, with the time it took,
+	// and the error it failed with, if any.
+	RecordRequest(endpoint string, duration time.Duration, err error)
+	// RecordRetry records that a failed request is retried.
+	RecordRetry(endpoint string)
+}
+
+// NoopMetrics discards all metrics, it is used by clients created without metrics.
+type NoopMetrics struct{}
+
+func (NoopMetrics) RecordRequest(string, time.Duration, error) {}
+
+func (NoopMetrics) RecordRetry(string) {}
+
+// LatencyBuckets are the upper bounds of the latency histogram of RequestStats.
+// Requests taking longer than the last bound are counted in an additional bucket.
+var LatencyBuckets = []time.Duration{
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// EndpointStats are the requests recorded for an endpoint.
+type EndpointStats struct {
+	Endpoint string
+	// Requests is the number of attempts, including retries.
+	Requests int
+	Retries  int
+	// Errors is the number of failed attempts, including those that were retried.
+	Errors int
+	// Duration is the total time spent on all attempts.
+	Duration time.Duration
+	// Latency counts the attempts per bucket of LatencyBuckets, with one more bucket for slower attempts.
+	Latency []int
+}
+
+// RequestStats is a Metrics implementation which collects the requests in memory, to summarize them after a run.
+// It is safe for concurrent use, and may be shared by multiple clients.
+type RequestStats struct {
+	mu        sync.Mutex
+	endpoints map[string]*EndpointStats
+}
+
+func NewRequestStats() *RequestStats {
+	return &RequestStats{endpoints: make(map[string]*EndpointStats)}
+}
+
+func (s *RequestStats) RecordRequest(endpoint string, duration time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := s.endpoint(endpoint)
+	stats.Requests++
+	if err != nil {
+		stats.Errors++
+	}
+	stats.Duration += duration
+	bucket := sort.Search(len(LatencyBuckets), func(i int) bool { return duration <= LatencyBuckets[i] })
+	stats.Latency[bucket]++
+}
+
+func (s *RequestStats) RecordRetry(endpoint string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.endpoint(endpoint).Retries++
+}
+
+func (s *RequestStats) endpoint(endpoint string) *EndpointStats {
+	stats, ok := s.endpoints[endpoint]
+	if !ok {
+		stats = &EndpointStats{Endpoint: endpoint, Latency: make([]int, len(LatencyBuckets)+1)}
+		s.endpoints[endpoint] = stats
+	}
+	return stats
+}
+
+// Endpoints returns a copy of the stats of every endpoint requested so far, sorted by endpoint.
+func (s *RequestStats) Endpoints() []EndpointStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	endpoints := make([]EndpointStats, 0, len(s.endpoints))
+	for _, stats := range s.endpoints {
+		stats := *stats
+		stats.Latency = append([]int(nil), stats.Latency...)
+		endpoints = append(endpoints, stats)
+	}
+	sort.Slice(endpoints, func(i, j int) bool { return endpoints[i].Endpoint < endpoints[j].Endpoint })
+	return endpoints
+}
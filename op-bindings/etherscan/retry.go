@@ -0,0 +1,58 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// retryableError marks an error as transient, so the request that caused it is retried.
+type retryableError struct {
+	error
+}
+
+func (e retryableError) Unwrap() error {
+	return e.error
+}
+
+// backoff returns the delay before the given retry, starting at zero for the first retry.
+func (cfg RetryConfig) backoff(retry int) time.Duration {
+	delay := cfg.BaseDelay
+	for i := 0; i < retry && (cfg.MaxDelay <= 0 || delay < cfg.MaxDelay); i++ {
+		delay *= 2
+	}
+	if cfg.MaxDelay > 0 && delay > cfg.MaxDelay {
+		return cfg.MaxDelay
+	}
+	return delay
+}
+
+// withRetries runs op, retrying it with exponential backoff for as long as it fails with a retryable error,
+// and the retry policy allows for more attempts.
+func withRetries[T any](ctx context.Context, cfg RetryConfig, op func() (T, error)) (T, error) {
+	var empty T
+	start := time.Now()
+	for retry := 0; ; retry++ {
+		ret, err := op()
+		if err == nil {
+			return ret, nil
+		}
+		var retryable retryableError
+		if !errors.As(err, &retryable) {
+			return empty, err
+		}
+		if retry >= cfg.MaxRetries {
+			return empty, fmt.Errorf("giving up after %d retries: %w", retry, err)
+		}
+		delay := cfg.backoff(retry)
+		if cfg.MaxElapsed > 0 && time.Since(start)+delay > cfg.MaxElapsed {
+			return empty, fmt.Errorf("giving up after %d retries, exceeding max elapsed time of %s: %w", retry, cfg.MaxElapsed, err)
+		}
+		select {
+		case <-ctx.Done():
+			return empty, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
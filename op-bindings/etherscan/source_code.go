@@ -0,0 +1,144 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrMalformedSourceCode is returned when the verified sources of a contract cannot be parsed,
+// e.g. when the JSON of multi-file sources was truncated.
+var ErrMalformedSourceCode = errors.New("malformed source code")
+
+// ErrSourceCodeNotVerified is returned when the source code of a contract is not verified on Etherscan.
+var ErrSourceCodeNotVerified = errors.New("source code not verified")
+
+// SourceCode is the verified source code of a contract.
+type SourceCode struct {
+	ContractName    string
+	CompilerVersion string
+	// Sources maps the path of every source file to its content. The source of a contract verified as a single file
+	// is keyed by the contract name, with the file extension of its language.
+	Sources map[string]string
+	// Settings are the compiler settings of contracts verified with a standard JSON input, nil otherwise
+	Settings json.RawMessage
+}
+
+// sourceCodeResult is a result of the getsourcecode endpoint, of which only the fields used are decoded.
+type sourceCodeResult struct {
+	SourceCode      string `json:"SourceCode"`
+	ContractName    string `json:"ContractName"`
+	CompilerVersion string `json:"CompilerVersion"`
+}
+
+// sourceFile is a source file of multi-file sources, or of the sources of a standard JSON input.
+type sourceFile struct {
+	Content *string `json:"content"`
+}
+
+// standardJsonInput is the part of a solc standard JSON input holding the sources and settings.
+type standardJsonInput struct {
+	Sources  map[string]sourceFile `json:"sources"`
+	Settings json.RawMessage       `json:"settings"`
+}
+
+// FetchSourceCode fetches the verified source code of the contract at the given address, whether it was
+// verified as a single file, as multiple files, or with a standard JSON input.
+func (c *client) FetchSourceCode(ctx context.Context, address string) (SourceCode, error) {
+	params := url.Values{}
+	params.Set("address", address)
+	url := constructUrl(c.baseUrl, "getsourcecode", "contract", params)
+	response, err := c.fetchEtherscanApi(ctx, "getsourcecode", url)
+	if err != nil {
+		return SourceCode{}, err
+	}
+
+	var results []sourceCodeResult
+	if err := json.Unmarshal(response.Result, &results); err != nil {
+		return SourceCode{}, fmt.Errorf("API response result is not expected source code array: %w", err)
+	}
+	if len(results) == 0 {
+		return SourceCode{}, errors.New("API response result is an empty array")
+	}
+	result := results[0]
+	if result.SourceCode == "" {
+		return SourceCode{}, fmt.Errorf("%w: %s", ErrSourceCodeNotVerified, address)
+	}
+
+	sources, settings, err := parseSourceCode(result.SourceCode, singleFileName(result.ContractName, result.CompilerVersion))
+	if err != nil {
+		return SourceCode{}, fmt.Errorf("error parsing source code of %s: %w", address, err)
+	}
+	return SourceCode{
+		ContractName:    result.ContractName,
+		CompilerVersion: result.CompilerVersion,
+		Sources:         sources,
+		Settings:        settings,
+	}, nil
+}
+
+// singleFileName returns the file name of the source of a contract verified as a single file.
+func singleFileName(contractName, compilerVersion string) string {
+	if strings.HasPrefix(compilerVersion, "vyper") {
+		return contractName + ".vy"
+	}
+	return contractName + ".sol"
+}
+
+// parseSourceCode parses the SourceCode field of a getsourcecode result into a map of file path to source,
+// and the compiler settings if the contract was verified with a standard JSON input. The field holds either:
+//   - the source of a single file, returned as the only file, named fileName
+//   - the JSON of multiple files, mapping their path to their content
+//   - a standard JSON input, wrapped in an extra pair of braces, i.e. {{...}}
+//
+// Sources that look like JSON, but cannot be parsed, e.g. since they were truncated, fail with ErrMalformedSourceCode,
+// rather than being returned as the source of a single file.
+func parseSourceCode(raw, fileName string) (map[string]string, json.RawMessage, error) {
+	trimmed := strings.TrimSpace(raw)
+	if !strings.HasPrefix(trimmed, "{") {
+		return map[string]string{fileName: raw}, nil, nil
+	}
+
+	var files map[string]sourceFile
+	var settings json.RawMessage
+	if strings.HasPrefix(trimmed, "{{") {
+		if !strings.HasSuffix(trimmed, "}}") {
+			return nil, nil, fmt.Errorf("%w: standard JSON input is not terminated, it may be truncated", ErrMalformedSourceCode)
+		}
+		var input standardJsonInput
+		if err := json.Unmarshal([]byte(trimmed[1:len(trimmed)-1]), &input); err != nil {
+			return nil, nil, fmt.Errorf("%w: invalid standard JSON input: %w", ErrMalformedSourceCode, err)
+		}
+		files, settings = input.Sources, input.Settings
+	} else {
+		// multiple files, which are sometimes returned as an unwrapped standard JSON input as well
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(trimmed), &fields); err != nil {
+			return nil, nil, fmt.Errorf("%w: invalid multi-file sources: %w", ErrMalformedSourceCode, err)
+		}
+		if _, ok := fields["sources"]; ok {
+			var input standardJsonInput
+			if err := json.Unmarshal([]byte(trimmed), &input); err != nil {
+				return nil, nil, fmt.Errorf("%w: invalid standard JSON input: %w", ErrMalformedSourceCode, err)
+			}
+			files, settings = input.Sources, input.Settings
+		} else if err := json.Unmarshal([]byte(trimmed), &files); err != nil {
+			return nil, nil, fmt.Errorf("%w: invalid multi-file sources: %w", ErrMalformedSourceCode, err)
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, nil, fmt.Errorf("%w: no source files", ErrMalformedSourceCode)
+	}
+	sources := make(map[string]string, len(files))
+	for path, file := range files {
+		if file.Content == nil {
+			return nil, nil, fmt.Errorf("%w: source file %s has no content", ErrMalformedSourceCode, path)
+		}
+		sources[path] = *file.Content
+	}
+	return sources, settings, nil
+}
@@ -0,0 +1,146 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSourceCode(t *testing.T) {
+	const standardJsonInput = `{"language":"Solidity","sources":{"src/Permit2.sol":{"content":"contract Permit2 {}"},"src/EIP712.sol":{"content":"contract EIP712 {}"}},"settings":{"optimizer":{"enabled":true,"runs":1000000}}}`
+	permit2Sources := map[string]string{
+		"src/Permit2.sol": "contract Permit2 {}",
+		"src/EIP712.sol":  "contract EIP712 {}",
+	}
+	permit2Settings := `{"optimizer":{"enabled":true,"runs":1000000}}`
+
+	tests := []struct {
+		name     string
+		raw      string
+		sources  map[string]string
+		settings string
+		err      string
+	}{
+		{
+			name:    "SingleFile",
+			raw:     "pragma solidity 0.8.15;\n\ncontract MultiCall3 {}\n",
+			sources: map[string]string{"MultiCall3.sol": "pragma solidity 0.8.15;\n\ncontract MultiCall3 {}\n"},
+		},
+		{
+			name:     "StandardJsonInput",
+			raw:      "{" + standardJsonInput + "}",
+			sources:  permit2Sources,
+			settings: permit2Settings,
+		},
+		{
+			name:     "StandardJsonInputWithWhitespace",
+			raw:      "\r\n{" + standardJsonInput + "}\r\n",
+			sources:  permit2Sources,
+			settings: permit2Settings,
+		},
+		{
+			name:     "UnwrappedStandardJsonInput",
+			raw:      standardJsonInput,
+			sources:  permit2Sources,
+			settings: permit2Settings,
+		},
+		{
+			name:    "MultiFile",
+			raw:     `{"src/Permit2.sol":{"content":"contract Permit2 {}"},"src/EIP712.sol":{"content":"contract EIP712 {}"}}`,
+			sources: permit2Sources,
+		},
+		{
+			name: "TruncatedStandardJsonInput",
+			raw:  "{" + standardJsonInput[:100],
+			err:  "may be truncated",
+		},
+		{
+			name: "MalformedStandardJsonInput",
+			raw:  "{" + standardJsonInput[:100] + "}}",
+			err:  "invalid standard JSON input",
+		},
+		{
+			name: "TruncatedMultiFile",
+			raw:  `{"src/Permit2.sol":{"content":"contract Perm`,
+			err:  "invalid multi-file sources",
+		},
+		{
+			name: "MissingContent",
+			raw:  `{"src/Permit2.sol":{"keccak256":"0x01"}}`,
+			err:  "src/Permit2.sol has no content",
+		},
+		{
+			name: "NoFiles",
+			raw:  `{{"language":"Solidity","sources":{}}}`,
+			err:  "no source files",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sources, settings, err := parseSourceCode(tt.raw, "MultiCall3.sol")
+			if tt.err != "" {
+				require.ErrorIs(t, err, ErrMalformedSourceCode)
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.sources, sources)
+			if tt.settings == "" {
+				require.Nil(t, settings)
+			} else {
+				require.JSONEq(t, tt.settings, string(settings))
+			}
+		})
+	}
+}
+
+func TestClient_FetchSourceCode(t *testing.T) {
+	respondWithSourceCode := func(result sourceCodeResult) func(w http.ResponseWriter) {
+		body, err := json.Marshal(map[string]any{"status": "1", "message": "OK", "result": []sourceCodeResult{result}})
+		require.NoError(t, err)
+		return respondWith(http.StatusOK, string(body))
+	}
+
+	t.Run("StandardJsonInput", func(t *testing.T) {
+		var calls int
+		srv := newTestServer(t, &calls, respondWithSourceCode(sourceCodeResult{
+			SourceCode:      `{{"language":"Solidity","sources":{"src/Permit2.sol":{"content":"contract Permit2 {}"}},"settings":{}}}`,
+			ContractName:    "Permit2",
+			CompilerVersion: "v0.8.17+commit.8df45f5f",
+		}))
+		c := NewClientWithRetryConfig(srv.URL, "key", testRetryConfig)
+
+		source, err := c.FetchSourceCode(context.Background(), "0x01")
+		require.NoError(t, err)
+		require.Equal(t, "Permit2", source.ContractName)
+		require.Equal(t, "v0.8.17+commit.8df45f5f", source.CompilerVersion)
+		require.Equal(t, map[string]string{"src/Permit2.sol": "contract Permit2 {}"}, source.Sources)
+		require.JSONEq(t, `{}`, string(source.Settings))
+	})
+
+	t.Run("Vyper", func(t *testing.T) {
+		var calls int
+		srv := newTestServer(t, &calls, respondWithSourceCode(sourceCodeResult{
+			SourceCode:      "# @version 0.3.7\n",
+			ContractName:    "Vyper_contract",
+			CompilerVersion: "vyper:0.3.7",
+		}))
+		c := NewClientWithRetryConfig(srv.URL, "key", testRetryConfig)
+
+		source, err := c.FetchSourceCode(context.Background(), "0x01")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"Vyper_contract.vy": "# @version 0.3.7\n"}, source.Sources)
+	})
+
+	t.Run("NotVerified", func(t *testing.T) {
+		var calls int
+		srv := newTestServer(t, &calls, respondWithSourceCode(sourceCodeResult{}))
+		c := NewClientWithRetryConfig(srv.URL, "key", testRetryConfig)
+
+		_, err := c.FetchSourceCode(context.Background(), "0x01")
+		require.ErrorIs(t, err, ErrSourceCodeNotVerified)
+	})
+}
@@ -1,7 +1,9 @@
 package foundry
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/solc"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -16,6 +18,17 @@ type Artifact struct {
 	StorageLayout    solc.StorageLayout `json:"storageLayout"`
 	DeployedBytecode DeployedBytecode   `json:"deployedBytecode"`
 	Bytecode         Bytecode           `json:"bytecode"`
+	// Ast is left as a json.RawMessage, since it is only walked
+	// for specific nodes, e.g. to resolve immutable declarations.
+	Ast json.RawMessage `json:"ast"`
+	// Evm holds the bytecode in the layout of solc's standard JSON output, which artifacts of
+	// contracts compiled with via-IR may use instead of the top-level deployedBytecode.
+	Evm *EvmOutput `json:"evm,omitempty"`
+}
+
+// EvmOutput is the evm output of a contract, as laid out in solc's standard JSON output.
+type EvmOutput struct {
+	DeployedBytecode DeployedBytecode `json:"deployedBytecode"`
 }
 
 type DeployedBytecode struct {
@@ -30,3 +43,58 @@ type Bytecode struct {
 	Object         hexutil.Bytes   `json:"object"`
 	LinkReferences json.RawMessage `json:"linkReferences"`
 }
+
+// DeployedSourceMap returns the source map of the deployed bytecode, falling back to the evm output of
+// via-IR artifacts if the top-level deployedBytecode has none.
+func (a *Artifact) DeployedSourceMap() string {
+	if a.DeployedBytecode.SourceMap == "" && a.Evm != nil {
+		return a.Evm.DeployedBytecode.SourceMap
+	}
+	return a.DeployedBytecode.SourceMap
+}
+
+// ImmutableReferences returns the immutable references of the deployed bytecode, keyed by the AST ID of
+// the immutable declaration, or nil if the contract has no immutables.
+//
+// Legacy artifacts omit the references of contracts without immutables, while via-IR artifacts may record
+// an empty object or null instead, list immutables whose reads were optimized away without any offsets,
+// or only record the references in the evm output. All of these shapes are normalized here.
+func (a *Artifact) ImmutableReferences() (json.RawMessage, error) {
+	refs := a.DeployedBytecode.ImmutableReferences
+	if isEmptyJSON(refs) && a.Evm != nil {
+		refs = a.Evm.DeployedBytecode.ImmutableReferences
+	}
+	if isEmptyJSON(refs) {
+		return nil, nil
+	}
+
+	var offsets map[string][]solc.LinkReferenceOffset
+	if err := json.Unmarshal(refs, &offsets); err != nil {
+		return nil, fmt.Errorf("error parsing immutable references: %w", err)
+	}
+	unreferenced := 0
+	for id, o := range offsets {
+		if len(o) == 0 {
+			delete(offsets, id)
+			unreferenced++
+		}
+	}
+	if len(offsets) == 0 {
+		return nil, nil
+	}
+	// the references are kept as-is if possible, so regenerating legacy artifacts does not reorder them
+	if unreferenced == 0 {
+		return refs, nil
+	}
+	return json.Marshal(offsets)
+}
+
+// isEmptyJSON reports whether raw is missing, null or an empty object.
+func isEmptyJSON(raw json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return true
+	}
+	var obj map[string]json.RawMessage
+	return json.Unmarshal(trimmed, &obj) == nil && len(obj) == 0
+}
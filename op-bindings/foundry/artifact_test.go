@@ -0,0 +1,57 @@
+package foundry
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/stretchr/testify/require"
+)
+
+func readTestArtifact(t *testing.T, name string) Artifact {
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	var artifact Artifact
+	require.NoError(t, json.Unmarshal(data, &artifact))
+	return artifact
+}
+
+func TestArtifactImmutableReferences(t *testing.T) {
+	expected := map[string][]solc.LinkReferenceOffset{
+		"101": {{Start: 10, Length: 32}, {Start: 100, Length: 32}},
+		"102": {{Start: 50, Length: 32}},
+	}
+	for _, name := range []string{"legacy.json", "via-ir.json"} {
+		t.Run(name, func(t *testing.T) {
+			artifact := readTestArtifact(t, name)
+			refs, err := artifact.ImmutableReferences()
+			require.NoError(t, err)
+			var offsets map[string][]solc.LinkReferenceOffset
+			require.NoError(t, json.Unmarshal(refs, &offsets))
+			require.Equal(t, expected, offsets)
+			require.Equal(t, "80:120:0:-:0;;;;", artifact.DeployedSourceMap())
+		})
+	}
+
+	t.Run("legacy references are kept as-is", func(t *testing.T) {
+		artifact := readTestArtifact(t, "legacy.json")
+		refs, err := artifact.ImmutableReferences()
+		require.NoError(t, err)
+		require.Equal(t, artifact.DeployedBytecode.ImmutableReferences, refs)
+	})
+
+	t.Run("no immutables", func(t *testing.T) {
+		artifact := readTestArtifact(t, "via-ir-no-immutables.json")
+		refs, err := artifact.ImmutableReferences()
+		require.NoError(t, err)
+		require.Nil(t, refs)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		artifact := Artifact{DeployedBytecode: DeployedBytecode{ImmutableReferences: json.RawMessage(`[1]`)}}
+		_, err := artifact.ImmutableReferences()
+		require.ErrorContains(t, err, "error parsing immutable references")
+	})
+}
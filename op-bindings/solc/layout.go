@@ -0,0 +1,111 @@
+package solc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// StorageLayoutSource provides the storage layouts of contracts by name.
+// It allows verification tooling to compare the layouts generated from solc artifacts
+// against layouts from an alternative source, such as a curated golden layout file.
+type StorageLayoutSource interface {
+	StorageLayout(name string) (*StorageLayout, error)
+}
+
+// StorageLayoutSourceFunc adapts a function to a StorageLayoutSource,
+// e.g. bindings.GetStorageLayout for the generated layouts.
+type StorageLayoutSourceFunc func(name string) (*StorageLayout, error)
+
+func (f StorageLayoutSourceFunc) StorageLayout(name string) (*StorageLayout, error) {
+	return f(name)
+}
+
+// GoldenStorageLayouts is a StorageLayoutSource of approved storage layouts, keyed by contract name.
+type GoldenStorageLayouts map[string]*StorageLayout
+
+func (g GoldenStorageLayouts) StorageLayout(name string) (*StorageLayout, error) {
+	layout, ok := g[name]
+	if !ok || layout == nil {
+		return nil, fmt.Errorf("%s: golden storage layout not found", name)
+	}
+	return layout, nil
+}
+
+// ReadGoldenStorageLayouts reads golden storage layouts from a JSON file,
+// which maps contract names to storage layouts in the solc output format.
+func ReadGoldenStorageLayouts(path string) (GoldenStorageLayouts, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading golden storage layouts: %w", err)
+	}
+	var layouts GoldenStorageLayouts
+	if err := json.Unmarshal(data, &layouts); err != nil {
+		return nil, fmt.Errorf("error parsing golden storage layouts at %s: %w", path, err)
+	}
+	return layouts, nil
+}
+
+// DiffStorageLayout returns a description of every difference between the expected and actual storage layout.
+// Variables are matched by label, and types are compared by their label and size rather than their identifier,
+// since identifiers embed AST IDs that differ between compilations. No differences means the layouts are compatible.
+func DiffStorageLayout(expected, actual *StorageLayout) []string {
+	actualEntries := make(map[string]StorageLayoutEntry, len(actual.Storage))
+	for _, entry := range actual.Storage {
+		actualEntries[entry.Label] = entry
+	}
+
+	var diffs []string
+	seen := make(map[string]bool, len(expected.Storage))
+	for _, exp := range expected.Storage {
+		seen[exp.Label] = true
+		act, ok := actualEntries[exp.Label]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("%s: missing, expected at slot %d offset %d", exp.Label, exp.Slot, exp.Offset))
+			continue
+		}
+		if exp.Slot != act.Slot || exp.Offset != act.Offset {
+			diffs = append(diffs, fmt.Sprintf("%s: expected at slot %d offset %d, but is at slot %d offset %d",
+				exp.Label, exp.Slot, exp.Offset, act.Slot, act.Offset))
+		}
+		expType, actType := expected.Types[exp.Type], actual.Types[act.Type]
+		if expType.Label != actType.Label || expType.NumberOfBytes != actType.NumberOfBytes {
+			diffs = append(diffs, fmt.Sprintf("%s: expected type %s (%d bytes), but is %s (%d bytes)",
+				exp.Label, expType.Label, expType.NumberOfBytes, actType.Label, actType.NumberOfBytes))
+		}
+	}
+	for _, act := range actual.Storage {
+		if !seen[act.Label] {
+			diffs = append(diffs, fmt.Sprintf("%s: unexpected, at slot %d offset %d", act.Label, act.Slot, act.Offset))
+		}
+	}
+	return diffs
+}
+
+// VerifyStorageLayouts compares the storage layouts of the named contracts from the actual source
+// against the expected source, and returns an error describing all differences.
+func VerifyStorageLayouts(names []string, expected, actual StorageLayoutSource) error {
+	names = append([]string(nil), names...)
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		exp, err := expected.StorageLayout(name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		act, err := actual.StorageLayout(name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if diffs := DiffStorageLayout(exp, act); len(diffs) != 0 {
+			errs = append(errs, fmt.Errorf("%s: storage layout differs:\n\t%s", name, strings.Join(diffs, "\n\t")))
+		}
+	}
+	return errors.Join(errs...)
+}
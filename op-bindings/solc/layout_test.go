@@ -0,0 +1,60 @@
+package solc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+)
+
+func TestVerifyStorageLayoutsAgainstGolden(t *testing.T) {
+	golden, err := solc.ReadGoldenStorageLayouts("testdata/golden-layouts.json")
+	require.NoError(t, err)
+	generated := solc.StorageLayoutSourceFunc(bindings.GetStorageLayout)
+
+	require.NoError(t, solc.VerifyStorageLayouts([]string{"L1Block"}, golden, generated))
+
+	err = solc.VerifyStorageLayouts([]string{"L1Block", "Unknown"}, golden, generated)
+	require.ErrorContains(t, err, "Unknown: golden storage layout not found")
+}
+
+func TestDiffStorageLayout(t *testing.T) {
+	golden, err := solc.ReadGoldenStorageLayouts("testdata/golden-layouts.json")
+	require.NoError(t, err)
+	generated, err := bindings.GetStorageLayout("L1Block")
+	require.NoError(t, err)
+
+	expected := golden["L1Block"]
+	require.Empty(t, solc.DiffStorageLayout(expected, generated))
+
+	// approve a layout which moves a variable, changes a type, drops a variable and adds another
+	modified := &solc.StorageLayout{Types: map[string]solc.StorageLayoutType{}}
+	for id, ty := range expected.Types {
+		modified.Types[id] = ty
+	}
+	modified.Types["t_uint128"] = solc.StorageLayoutType{Encoding: "inplace", Label: "uint128", NumberOfBytes: 16}
+	for _, entry := range expected.Storage {
+		switch entry.Label {
+		case "hash":
+			entry.Slot = 8
+		case "basefee":
+			entry.Type = "t_uint128"
+		case "blobBaseFee":
+			continue
+		}
+		modified.Storage = append(modified.Storage, entry)
+	}
+	modified.Storage = append(modified.Storage, solc.StorageLayoutEntry{Label: "gap", Slot: 9, Type: "t_uint256"})
+
+	require.Equal(t, []string{
+		"basefee: expected type uint128 (16 bytes), but is uint256 (32 bytes)",
+		"hash: expected at slot 8 offset 0, but is at slot 2 offset 0",
+		"gap: missing, expected at slot 9 offset 0",
+		"blobBaseFee: unexpected, at slot 7 offset 0",
+	}, solc.DiffStorageLayout(modified, generated))
+
+	err = solc.VerifyStorageLayouts([]string{"L1Block"}, solc.GoldenStorageLayouts{"L1Block": modified}, solc.StorageLayoutSourceFunc(bindings.GetStorageLayout))
+	require.ErrorContains(t, err, "L1Block: storage layout differs:\n\tbasefee: expected type uint128")
+}
@@ -0,0 +1,26 @@
+package solc
+
+import "encoding/binary"
+
+// StripMetadata returns the given bytecode without the CBOR encoded metadata solc appends to it, which
+// includes the hash of the contract's metadata, so bytecode compiled from the same code with different
+// metadata, e.g. source paths or comments, can be compared. The bytecode is returned unchanged if it does
+// not end with a metadata section.
+//
+// The metadata is a CBOR map, followed by its length as a big-endian uint16. Since solc only appends a
+// map of a few string keys, e.g. ipfs and solc, a section which does not start like one is not stripped.
+func StripMetadata(bytecode []byte) []byte {
+	if len(bytecode) < 2 {
+		return bytecode
+	}
+	length := int(binary.BigEndian.Uint16(bytecode[len(bytecode)-2:]))
+	start := len(bytecode) - 2 - length
+	if length < 2 || start < 0 {
+		return bytecode
+	}
+	// a map of 1 to 23 entries, whose first key is a text string of up to 23 bytes
+	if mapHeader, keyHeader := bytecode[start], bytecode[start+1]; mapHeader < 0xa1 || mapHeader > 0xb7 || keyHeader < 0x60 || keyHeader > 0x77 {
+		return bytecode
+	}
+	return bytecode[:start]
+}
@@ -0,0 +1,39 @@
+package solc_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+)
+
+func TestStripMetadata(t *testing.T) {
+	code := common.FromHex("0x6080604052348015600f57600080fd5b50")
+	// {"ipfs": <34 bytes>, "solc": 0x000813}
+	metadata := common.FromHex("0xa2646970667358221220" + "1111111111111111111111111111111111111111111111111111111111111111" + "64736f6c63430008130033")
+
+	require.Equal(t, code, solc.StripMetadata(append(append([]byte{}, code...), metadata...)))
+
+	// bytecode compiled with a different metadata hash compares equal once stripped
+	other := common.FromHex("0xa2646970667358221220" + "2222222222222222222222222222222222222222222222222222222222222222" + "64736f6c63430008130033")
+	require.Equal(t, solc.StripMetadata(append(append([]byte{}, code...), metadata...)), solc.StripMetadata(append(append([]byte{}, code...), other...)))
+
+	t.Run("NoMetadata", func(t *testing.T) {
+		require.Equal(t, code, solc.StripMetadata(code))
+		require.Empty(t, solc.StripMetadata(nil))
+		require.Equal(t, []byte{0x00}, solc.StripMetadata([]byte{0x00}))
+	})
+
+	t.Run("LengthExceedsBytecode", func(t *testing.T) {
+		bytecode := common.FromHex("0x600100ff")
+		require.Equal(t, bytecode, solc.StripMetadata(bytecode))
+	})
+
+	t.Run("NotAMap", func(t *testing.T) {
+		// ends in a length, but the section it points to is not a CBOR map
+		bytecode := common.FromHex("0x6080604052600000000004")
+		require.Equal(t, bytecode, solc.StripMetadata(bytecode))
+	})
+}
@@ -0,0 +1,201 @@
+package sourcify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/etherscan"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+)
+
+const DefaultServerUrl = "https://sourcify.dev/server"
+
+const apiMaxRetries = 3
+const apiRetryDelay = time.Duration(2) * time.Second
+
+// ErrNotVerified is returned when Sourcify has no verified contract at the requested address.
+var ErrNotVerified = errors.New("contract is not verified on Sourcify")
+
+// ErrPartialMatch is returned when a contract is only partially verified on Sourcify,
+// i.e. its metadata does not exactly match the deployed bytecode.
+var ErrPartialMatch = errors.New("contract is only partially verified on Sourcify")
+
+type client struct {
+	baseUrl    string
+	chainId    uint64
+	httpClient *http.Client
+
+	// contracts caches the fetched contract data by address, since every contract data
+	// request is served by the same Sourcify endpoint.
+	contracts map[string]contractResponse
+	// deployments maps deployment tx hashes to the address of the contract they deployed.
+	deployments map[string]string
+	mu          sync.Mutex
+}
+
+type bytecodeResponse struct {
+	OnchainBytecode string `json:"onchainBytecode"`
+}
+
+type deploymentResponse struct {
+	TransactionHash string `json:"transactionHash"`
+	Deployer        string `json:"deployer"`
+}
+
+type contractResponse struct {
+	Match            string             `json:"match"`
+	Abi              json.RawMessage    `json:"abi"`
+	RuntimeBytecode  bytecodeResponse   `json:"runtimeBytecode"`
+	CreationBytecode bytecodeResponse   `json:"creationBytecode"`
+	Deployment       deploymentResponse `json:"deployment"`
+}
+
+func NewClient(baseUrl string, chainId uint64) *client {
+	return &client{
+		baseUrl: strings.TrimSuffix(baseUrl, "/"),
+		chainId: chainId,
+		httpClient: &http.Client{
+			Timeout: time.Second * 10,
+		},
+		contracts:   make(map[string]contractResponse),
+		deployments: make(map[string]string),
+	}
+}
+
+func NewEthereumClient(baseUrl string) *client {
+	return NewClient(baseUrl, 1)
+}
+
+func NewOptimismClient(baseUrl string) *client {
+	return NewClient(baseUrl, 10)
+}
+
+func (c *client) fetch(ctx context.Context, url string) (int, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, body, nil
+}
+
+func (c *client) fetchContract(ctx context.Context, address string) (contractResponse, error) {
+	address = strings.ToLower(address)
+	c.mu.Lock()
+	contract, ok := c.contracts[address]
+	c.mu.Unlock()
+	if ok {
+		return contract, nil
+	}
+
+	url := fmt.Sprintf("%s/v2/contract/%d/%s?fields=abi,runtimeBytecode.onchainBytecode,creationBytecode.onchainBytecode,deployment", c.baseUrl, c.chainId, address)
+	status, body, err := retry.Do2[int, []byte](ctx, apiMaxRetries, retry.Fixed(apiRetryDelay), func() (int, []byte, error) {
+		status, body, err := c.fetch(ctx, url)
+		if err != nil {
+			return 0, nil, err
+		}
+		if status >= http.StatusInternalServerError || status == http.StatusTooManyRequests {
+			return 0, nil, fmt.Errorf("request to %s failed with status %d", url, status)
+		}
+		return status, body, nil
+	})
+	if err != nil {
+		return contractResponse{}, err
+	}
+
+	if status == http.StatusNotFound {
+		return contractResponse{}, fmt.Errorf("%w: chain %d address %s", ErrNotVerified, c.chainId, address)
+	}
+	if status != http.StatusOK {
+		return contractResponse{}, fmt.Errorf("there was an issue with the Sourcify request to %s, received status %d: %s", url, status, body)
+	}
+
+	if err := json.Unmarshal(body, &contract); err != nil {
+		return contractResponse{}, fmt.Errorf("failed to unmarshal as contractResponse: %w", err)
+	}
+	switch contract.Match {
+	case "exact_match":
+	case "match":
+		return contractResponse{}, fmt.Errorf("%w: chain %d address %s", ErrPartialMatch, c.chainId, address)
+	default:
+		return contractResponse{}, fmt.Errorf("%w: chain %d address %s, match: %q", ErrNotVerified, c.chainId, address, contract.Match)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.contracts[address] = contract
+	if contract.Deployment.TransactionHash != "" {
+		c.deployments[strings.ToLower(contract.Deployment.TransactionHash)] = address
+	}
+	return contract, nil
+}
+
+func (c *client) FetchAbi(ctx context.Context, address string) (string, error) {
+	contract, err := c.fetchContract(ctx, address)
+	if err != nil {
+		return "", err
+	}
+	if len(contract.Abi) == 0 {
+		return "", fmt.Errorf("API response for %s does not contain an ABI", address)
+	}
+	return string(contract.Abi), nil
+}
+
+func (c *client) FetchDeployedBytecode(ctx context.Context, address string) (string, error) {
+	contract, err := c.fetchContract(ctx, address)
+	if err != nil {
+		return "", fmt.Errorf("error fetching deployed bytecode: %w", err)
+	}
+	if contract.RuntimeBytecode.OnchainBytecode == "" {
+		return "", fmt.Errorf("API response for %s does not contain deployed bytecode", address)
+	}
+	return contract.RuntimeBytecode.OnchainBytecode, nil
+}
+
+func (c *client) FetchDeploymentTxHash(ctx context.Context, address string) (string, error) {
+	contract, err := c.fetchContract(ctx, address)
+	if err != nil {
+		return "", err
+	}
+	if contract.Deployment.TransactionHash == "" {
+		return "", fmt.Errorf("API response for %s does not contain a deployment transaction", address)
+	}
+	return contract.Deployment.TransactionHash, nil
+}
+
+// FetchDeploymentTx returns the deployment transaction of a contract previously looked up with
+// FetchDeploymentTxHash. Sourcify does not serve transactions, so the input is the creation
+// bytecode Sourcify recorded for the contract, and the to address is not available.
+func (c *client) FetchDeploymentTx(ctx context.Context, txHash string) (etherscan.Transaction, error) {
+	c.mu.Lock()
+	address, ok := c.deployments[strings.ToLower(txHash)]
+	c.mu.Unlock()
+	if !ok {
+		return etherscan.Transaction{}, fmt.Errorf("unknown deployment transaction %s, fetch the deployment transaction hash first", txHash)
+	}
+
+	contract, err := c.fetchContract(ctx, address)
+	if err != nil {
+		return etherscan.Transaction{}, err
+	}
+	return etherscan.Transaction{
+		Hash:  contract.Deployment.TransactionHash,
+		Input: contract.CreationBytecode.OnchainBytecode,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package sourcify
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testAddress = "0xca11bde05977b3631167028862be2a173976ca11"
+
+func newTestServer(t *testing.T, status int, body string) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.True(t, strings.HasPrefix(r.URL.Path, "/v2/contract/1/"+testAddress))
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestClient_ExactMatch(t *testing.T) {
+	srv := newTestServer(t, http.StatusOK, `{
+		"match": "exact_match",
+		"abi": [{"type":"function","name":"foo","inputs":[],"outputs":[],"stateMutability":"view"}],
+		"runtimeBytecode": {"onchainBytecode": "0x6001"},
+		"creationBytecode": {"onchainBytecode": "0x6002"},
+		"deployment": {"transactionHash": "0xabcd", "deployer": "0x0000000000000000000000000000000000000001"}
+	}`)
+	c := NewEthereumClient(srv.URL)
+	ctx := context.Background()
+
+	abi, err := c.FetchAbi(ctx, testAddress)
+	require.NoError(t, err)
+	require.Contains(t, abi, `"name":"foo"`)
+
+	bytecode, err := c.FetchDeployedBytecode(ctx, testAddress)
+	require.NoError(t, err)
+	require.Equal(t, "0x6001", bytecode)
+
+	txHash, err := c.FetchDeploymentTxHash(ctx, testAddress)
+	require.NoError(t, err)
+	require.Equal(t, "0xabcd", txHash)
+
+	tx, err := c.FetchDeploymentTx(ctx, txHash)
+	require.NoError(t, err)
+	require.Equal(t, "0x6002", tx.Input)
+}
+
+func TestClient_PartialMatch(t *testing.T) {
+	srv := newTestServer(t, http.StatusOK, `{"match": "match", "abi": []}`)
+	c := NewEthereumClient(srv.URL)
+
+	_, err := c.FetchAbi(context.Background(), testAddress)
+	require.ErrorIs(t, err, ErrPartialMatch)
+}
+
+func TestClient_NotVerified(t *testing.T) {
+	srv := newTestServer(t, http.StatusNotFound, `{"customCode": "not_found"}`)
+	c := NewEthereumClient(srv.URL)
+
+	_, err := c.FetchDeployedBytecode(context.Background(), testAddress)
+	require.ErrorIs(t, err, ErrNotVerified)
+}
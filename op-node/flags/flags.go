@@ -180,6 +180,40 @@ var (
 		Value:    20,
 		Category: L1RPCCategory,
 	}
+	L1RPCReceiptsBatchConcurrency = &cli.IntFlag{
+		Name:     "l1.receipts-batch-concurrency",
+		Usage:    "Number of batches of per-transaction receipt requests of an L1 block to make concurrently, when the L1 RPC provider kind has no block-receipts method.",
+		EnvVars:  prefixEnvVars("L1_RECEIPTS_BATCH_CONCURRENCY"),
+		Value:    1,
+		Category: L1RPCCategory,
+	}
+	L1RPCProbeReceiptMethods = &cli.BoolFlag{
+		Name:     "l1.probe-receipt-methods",
+		Usage:    "Probe which receipt fetching methods of the L1 RPC provider kind the L1 RPC supports at startup, and log the supported methods, instead of discovering unsupported methods on the first receipts fetch. Warns if the methods the L1 RPC supports match another provider kind better.",
+		EnvVars:  prefixEnvVars("L1_PROBE_RECEIPT_METHODS"),
+		Value:    false,
+		Category: L1RPCCategory,
+	}
+	L1RPCBatchTimeout = &cli.DurationFlag{
+		Name:     "l1.rpc-batch-timeout",
+		Usage:    "Timeout of a batch of L1 RPC requests, e.g. during L1 blocks receipt fetching. Separate from the timeout of single requests, since large batches may legitimately take longer.",
+		EnvVars:  prefixEnvVars("L1_RPC_BATCH_TIMEOUT"),
+		Value:    time.Second * 20,
+		Category: L1RPCCategory,
+	}
+	L1ReceiptsTraceFile = &cli.StringFlag{
+		Name:     "l1.receipts-trace-file",
+		Usage:    "Optional file to record a trace of L1 receipt fetching attempts to, as JSON lines, for offline analysis. Disabled if empty.",
+		EnvVars:  prefixEnvVars("L1_RECEIPTS_TRACE_FILE"),
+		Category: L1RPCCategory,
+	}
+	L1ReceiptsTraceMaxSize = &cli.Uint64Flag{
+		Name:     "l1.receipts-trace-max-size",
+		Usage:    "Maximum size of the L1 receipts trace file in MiB, before it is rotated. A single rotated file is kept.",
+		EnvVars:  prefixEnvVars("L1_RECEIPTS_TRACE_MAX_SIZE"),
+		Value:    64,
+		Category: L1RPCCategory,
+	}
 	L1HTTPPollInterval = &cli.DurationFlag{
 		Name:     "l1.http-poll-interval",
 		Usage:    "Polling interval for latest-block subscription when using an HTTP RPC provider. Ignored for other types of RPC endpoints.",
@@ -374,7 +408,12 @@ var optionalFlags = []cli.Flag{
 	L1RPCProviderKind,
 	L1RPCRateLimit,
 	L1RPCMaxBatchSize,
+	L1RPCReceiptsBatchConcurrency,
+	L1RPCProbeReceiptMethods,
 	L1RPCMaxConcurrency,
+	L1RPCBatchTimeout,
+	L1ReceiptsTraceFile,
+	L1ReceiptsTraceMaxSize,
 	L1HTTPPollInterval,
 	VerifierL1Confs,
 	SequencerEnabledFlag,
@@ -88,6 +88,8 @@ type Metrics struct {
 	L1SourceCache *metrics.CacheMetrics
 	L2SourceCache *metrics.CacheMetrics
 
+	L1SourceReceipts *metrics.ReceiptsMetrics
+
 	DerivationIdle prometheus.Gauge
 
 	PipelineResets   *metrics.Event
@@ -187,6 +189,8 @@ func NewMetrics(procName string) *Metrics {
 		L1SourceCache: metrics.NewCacheMetrics(factory, ns, "l1_source_cache", "L1 Source cache"),
 		L2SourceCache: metrics.NewCacheMetrics(factory, ns, "l2_source_cache", "L2 Source cache"),
 
+		L1SourceReceipts: metrics.NewReceiptsMetrics(factory, ns, "l1_source_receipts", "L1 Source"),
+
 		DerivationIdle: factory.NewGauge(prometheus.GaugeOpts{
 			Namespace: ns,
 			Name:      "derivation_idle",
@@ -115,6 +115,23 @@ type L1EndpointConfig struct {
 	// MaxConcurrency specifies the maximum number of concurrent requests to the L1 RPC.
 	MaxConcurrency int
 
+	// ReceiptsBatchConcurrency specifies the number of batches of per-tx receipt requests of a block
+	// to make concurrently. 0 makes them one by one.
+	ReceiptsBatchConcurrency int
+
+	// ProbeReceiptMethods probes which receipt fetching methods the L1 RPC supports at startup.
+	ProbeReceiptMethods bool
+
+	// BatchTimeout specifies the timeout of a batch of L1 RPC requests,
+	// separate from the timeout of single requests. 0 uses the client default.
+	BatchTimeout time.Duration
+
+	// ReceiptsTraceFile optionally specifies a file to record receipt fetching attempts to.
+	ReceiptsTraceFile string
+
+	// ReceiptsTraceMaxSize specifies the size in bytes at which the receipts trace file is rotated.
+	ReceiptsTraceMaxSize int64
+
 	// HttpPollInterval specifies the interval between polling for the latest L1 block,
 	// when the RPC is detected to be an HTTP type.
 	// It is recommended to use websockets or IPC for efficient following of the changing block.
@@ -134,6 +151,15 @@ func (cfg *L1EndpointConfig) Check() error {
 	if cfg.MaxConcurrency < 1 {
 		return fmt.Errorf("max concurrent requests cannot be less than 1, was %d", cfg.MaxConcurrency)
 	}
+	if cfg.ReceiptsBatchConcurrency < 0 {
+		return fmt.Errorf("receipts batch concurrency cannot be negative, was %d", cfg.ReceiptsBatchConcurrency)
+	}
+	if cfg.BatchTimeout < 0 {
+		return fmt.Errorf("batch timeout cannot be negative")
+	}
+	if cfg.ReceiptsTraceFile != "" && cfg.ReceiptsTraceMaxSize < 1 {
+		return fmt.Errorf("receipts trace max size must be positive, was %d", cfg.ReceiptsTraceMaxSize)
+	}
 	return nil
 }
 
@@ -145,6 +171,9 @@ func (cfg *L1EndpointConfig) Setup(ctx context.Context, log log.Logger, rollupCf
 	if cfg.RateLimit != 0 {
 		opts = append(opts, client.WithRateLimit(cfg.RateLimit, cfg.BatchSize))
 	}
+	if cfg.BatchTimeout != 0 {
+		opts = append(opts, client.WithBatchCallTimeout(cfg.BatchTimeout))
+	}
 
 	l1Node, err := client.NewRPC(ctx, log, cfg.L1NodeAddr, opts...)
 	if err != nil {
@@ -153,6 +182,11 @@ func (cfg *L1EndpointConfig) Setup(ctx context.Context, log log.Logger, rollupCf
 	rpcCfg := sources.L1ClientDefaultConfig(rollupCfg, cfg.L1TrustRPC, cfg.L1RPCKind)
 	rpcCfg.MaxRequestsPerBatch = cfg.BatchSize
 	rpcCfg.MaxConcurrentRequests = cfg.MaxConcurrency
+	rpcCfg.ReceiptsBatchConcurrency = cfg.ReceiptsBatchConcurrency
+	rpcCfg.ProbeReceiptMethods = cfg.ProbeReceiptMethods
+	if cfg.ReceiptsTraceFile != "" {
+		rpcCfg.ReceiptsTracer = sources.NewReceiptsTraceFile(log, cfg.ReceiptsTraceFile, cfg.ReceiptsTraceMaxSize)
+	}
 	return l1Node, rpcCfg, nil
 }
 
@@ -173,6 +173,7 @@ func (n *OpNode) initL1(ctx context.Context, cfg *Config) error {
 
 	// Set the RethDB path in the EthClientConfig, if there is one configured.
 	rpcCfg.EthClientConfig.RethDBPath = cfg.RethDBPath
+	rpcCfg.EthClientConfig.ReceiptsMetrics = n.metrics.L1SourceReceipts
 
 	n.l1Source, err = sources.NewL1Client(
 		client.NewInstrumentedRPC(l1Node, n.metrics), n.log, n.metrics.L1SourceCache, rpcCfg)
@@ -140,13 +140,18 @@ func NewBeaconEndpointConfig(ctx *cli.Context) node.L1BeaconEndpointSetup {
 
 func NewL1EndpointConfig(ctx *cli.Context) *node.L1EndpointConfig {
 	return &node.L1EndpointConfig{
-		L1NodeAddr:       ctx.String(flags.L1NodeAddr.Name),
-		L1TrustRPC:       ctx.Bool(flags.L1TrustRPC.Name),
-		L1RPCKind:        sources.RPCProviderKind(strings.ToLower(ctx.String(flags.L1RPCProviderKind.Name))),
-		RateLimit:        ctx.Float64(flags.L1RPCRateLimit.Name),
-		BatchSize:        ctx.Int(flags.L1RPCMaxBatchSize.Name),
-		HttpPollInterval: ctx.Duration(flags.L1HTTPPollInterval.Name),
-		MaxConcurrency:   ctx.Int(flags.L1RPCMaxConcurrency.Name),
+		L1NodeAddr:               ctx.String(flags.L1NodeAddr.Name),
+		L1TrustRPC:               ctx.Bool(flags.L1TrustRPC.Name),
+		L1RPCKind:                sources.RPCProviderKind(strings.ToLower(ctx.String(flags.L1RPCProviderKind.Name))),
+		RateLimit:                ctx.Float64(flags.L1RPCRateLimit.Name),
+		BatchSize:                ctx.Int(flags.L1RPCMaxBatchSize.Name),
+		HttpPollInterval:         ctx.Duration(flags.L1HTTPPollInterval.Name),
+		MaxConcurrency:           ctx.Int(flags.L1RPCMaxConcurrency.Name),
+		ReceiptsBatchConcurrency: ctx.Int(flags.L1RPCReceiptsBatchConcurrency.Name),
+		ProbeReceiptMethods:      ctx.Bool(flags.L1RPCProbeReceiptMethods.Name),
+		BatchTimeout:             ctx.Duration(flags.L1RPCBatchTimeout.Name),
+		ReceiptsTraceFile:        ctx.String(flags.L1ReceiptsTraceFile.Name),
+		ReceiptsTraceMaxSize:     int64(ctx.Uint64(flags.L1ReceiptsTraceMaxSize.Name)) << 20,
 	}
 }
 
@@ -13,13 +13,21 @@ import (
 type RateLimitingClient struct {
 	c  RPC
 	rl *rate.Limiter
+
+	callTimeout      time.Duration
+	batchCallTimeout time.Duration
 }
 
 // NewRateLimitingClient implements a global rate-limit for all RPC requests.
 // A limit of N will ensure that over a long enough time-frame the given number of tokens per second is targeted.
 // Burst limits how far off we can be from the target, by specifying how many requests are allowed at once.
 func NewRateLimitingClient(c RPC, limit rate.Limit, burst int) *RateLimitingClient {
-	return &RateLimitingClient{c: c, rl: rate.NewLimiter(limit, burst)}
+	return &RateLimitingClient{
+		c:                c,
+		rl:               rate.NewLimiter(limit, burst),
+		callTimeout:      DefaultCallTimeout,
+		batchCallTimeout: DefaultBatchCallTimeout,
+	}
 }
 
 func (b *RateLimitingClient) Close() {
@@ -30,7 +38,7 @@ func (b *RateLimitingClient) CallContext(ctx context.Context, result any, method
 	if err := b.rl.Wait(ctx); err != nil {
 		return err
 	}
-	cCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	cCtx, cancel := context.WithTimeout(ctx, b.callTimeout)
 	defer cancel()
 	return b.c.CallContext(cCtx, result, method, args...)
 }
@@ -39,7 +47,7 @@ func (b *RateLimitingClient) BatchCallContext(ctx context.Context, batch []rpc.B
 	if err := b.rl.WaitN(ctx, len(batch)); err != nil {
 		return err
 	}
-	cCtx, cancel := context.WithTimeout(ctx, 20*time.Second)
+	cCtx, cancel := context.WithTimeout(ctx, b.batchCallTimeout)
 	defer cancel()
 	return b.c.BatchCallContext(cCtx, batch)
 }
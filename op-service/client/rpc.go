@@ -20,6 +20,14 @@ import (
 
 var httpRegex = regexp.MustCompile("^http(s)?://")
 
+const (
+	// DefaultCallTimeout is the default timeout of a single RPC call.
+	DefaultCallTimeout = 10 * time.Second
+	// DefaultBatchCallTimeout is the default timeout of a batch of RPC calls,
+	// which is larger than that of a single call, since large batches legitimately take longer.
+	DefaultBatchCallTimeout = 20 * time.Second
+)
+
 type RPC interface {
 	Close()
 	CallContext(ctx context.Context, result any, method string, args ...any) error
@@ -33,6 +41,8 @@ type rpcConfig struct {
 	backoffAttempts  int
 	limit            float64
 	burst            int
+	callTimeout      time.Duration
+	batchCallTimeout time.Duration
 }
 
 type RPCOption func(cfg *rpcConfig) error
@@ -72,6 +82,23 @@ func WithRateLimit(rateLimit float64, burst int) RPCOption {
 	}
 }
 
+// WithCallTimeout configures the timeout of a single RPC call. Defaults to DefaultCallTimeout.
+func WithCallTimeout(timeout time.Duration) RPCOption {
+	return func(cfg *rpcConfig) error {
+		cfg.callTimeout = timeout
+		return nil
+	}
+}
+
+// WithBatchCallTimeout configures the timeout of a batch of RPC calls, separately from the timeout of a single call.
+// Defaults to DefaultBatchCallTimeout.
+func WithBatchCallTimeout(timeout time.Duration) RPCOption {
+	return func(cfg *rpcConfig) error {
+		cfg.batchCallTimeout = timeout
+		return nil
+	}
+}
+
 // NewRPC returns the correct client.RPC instance for a given RPC url.
 func NewRPC(ctx context.Context, lgr log.Logger, addr string, opts ...RPCOption) (RPC, error) {
 	var cfg rpcConfig
@@ -84,16 +111,24 @@ func NewRPC(ctx context.Context, lgr log.Logger, addr string, opts ...RPCOption)
 	if cfg.backoffAttempts < 1 { // default to at least 1 attempt, or it always fails to dial.
 		cfg.backoffAttempts = 1
 	}
+	if cfg.callTimeout == 0 {
+		cfg.callTimeout = DefaultCallTimeout
+	}
+	if cfg.batchCallTimeout == 0 {
+		cfg.batchCallTimeout = DefaultBatchCallTimeout
+	}
 
 	underlying, err := dialRPCClientWithBackoff(ctx, lgr, addr, cfg.backoffAttempts, cfg.gethRPCOptions...)
 	if err != nil {
 		return nil, err
 	}
 
-	var wrapped RPC = &BaseRPCClient{c: underlying}
+	var wrapped RPC = &BaseRPCClient{c: underlying, callTimeout: cfg.callTimeout, batchCallTimeout: cfg.batchCallTimeout}
 
 	if cfg.limit != 0 {
-		wrapped = NewRateLimitingClient(wrapped, rate.Limit(cfg.limit), cfg.burst)
+		rl := NewRateLimitingClient(wrapped, rate.Limit(cfg.limit), cfg.burst)
+		rl.callTimeout, rl.batchCallTimeout = cfg.callTimeout, cfg.batchCallTimeout
+		wrapped = rl
 	}
 
 	return NewRPCWithClient(ctx, lgr, addr, wrapped, cfg.httpPollInterval)
@@ -150,13 +185,16 @@ func IsURLAvailable(address string) bool {
 
 // BaseRPCClient is a wrapper around a concrete *rpc.Client instance to make it compliant
 // with the client.RPC interface.
-// It sets a timeout of 10s on CallContext & 20s on BatchCallContext made through it.
+// It sets a timeout on CallContext & BatchCallContext made through it,
+// by default DefaultCallTimeout & DefaultBatchCallTimeout respectively.
 type BaseRPCClient struct {
-	c *rpc.Client
+	c                *rpc.Client
+	callTimeout      time.Duration
+	batchCallTimeout time.Duration
 }
 
 func NewBaseRPCClient(c *rpc.Client) *BaseRPCClient {
-	return &BaseRPCClient{c: c}
+	return &BaseRPCClient{c: c, callTimeout: DefaultCallTimeout, batchCallTimeout: DefaultBatchCallTimeout}
 }
 
 func (b *BaseRPCClient) Close() {
@@ -164,13 +202,13 @@ func (b *BaseRPCClient) Close() {
 }
 
 func (b *BaseRPCClient) CallContext(ctx context.Context, result any, method string, args ...any) error {
-	cCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	cCtx, cancel := context.WithTimeout(ctx, b.callTimeout)
 	defer cancel()
 	return b.c.CallContext(cCtx, result, method, args...)
 }
 
 func (b *BaseRPCClient) BatchCallContext(ctx context.Context, batch []rpc.BatchElem) error {
-	cCtx, cancel := context.WithTimeout(ctx, 20*time.Second)
+	cCtx, cancel := context.WithTimeout(ctx, b.batchCallTimeout)
 	defer cancel()
 	return b.c.BatchCallContext(cCtx, batch)
 }
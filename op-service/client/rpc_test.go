@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type sleepService struct{}
+
+func (s *sleepService) Sleep(ctx context.Context, ms uint64) (bool, error) {
+	select {
+	case <-time.After(time.Duration(ms) * time.Millisecond):
+		return true, nil
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+}
+
+func TestBaseRPCClientTimeouts(t *testing.T) {
+	server := rpc.NewServer()
+	t.Cleanup(server.Stop)
+	require.NoError(t, server.RegisterName("test", new(sleepService)))
+
+	client := &BaseRPCClient{
+		c:                rpc.DialInProc(server),
+		callTimeout:      50 * time.Millisecond,
+		batchCallTimeout: 2 * time.Second,
+	}
+	t.Cleanup(client.Close)
+
+	// a single call is bound by the call timeout
+	var result bool
+	err := client.CallContext(context.Background(), &result, "test_sleep", 200)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// while a batch of the same calls is bound by the larger batch timeout
+	batch := []rpc.BatchElem{
+		{Method: "test_sleep", Args: []any{200}, Result: new(bool)},
+		{Method: "test_sleep", Args: []any{200}, Result: new(bool)},
+	}
+	require.NoError(t, client.BatchCallContext(context.Background(), batch))
+	for _, elem := range batch {
+		require.NoError(t, elem.Error)
+		require.True(t, *elem.Result.(*bool))
+	}
+
+	// and the batch timeout does still apply to batches
+	client.batchCallTimeout = 50 * time.Millisecond
+	err = client.BatchCallContext(context.Background(), []rpc.BatchElem{{Method: "test_sleep", Args: []any{200}, Result: new(bool)}})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -22,17 +22,29 @@ func EncodeReceipts(elems []*types.Receipt) ([]hexutil.Bytes, error) {
 	return out, nil
 }
 
-// DecodeRawReceipts decodes receipts and adds additional blocks metadata.
+// DecodeRawReceipts decodes receipts of all transaction types, including blob transactions, and adds additional blocks metadata.
 // The contract-deployment addresses are not set however (high cost, depends on nonce values, unused by op-node).
+// The blob gas fields of blob-tx receipts are not part of the encoding either, see DeriveBlobReceiptFields.
 func DecodeRawReceipts(block BlockID, rawReceipts []hexutil.Bytes, txHashes []common.Hash) ([]*types.Receipt, error) {
 	result := make([]*types.Receipt, len(rawReceipts))
-	totalIndex := uint(0)
-	prevCumulativeGasUsed := uint64(0)
 	for i, r := range rawReceipts {
 		var x types.Receipt
 		if err := x.UnmarshalBinary(r); err != nil {
 			return nil, fmt.Errorf("failed to decode receipt %d: %w", i, err)
 		}
+		result[i] = &x
+	}
+	DeriveReceiptsMetadata(block, result, txHashes)
+	return result, nil
+}
+
+// DeriveReceiptsMetadata sets the block and transaction metadata of decoded receipts and their logs,
+// which is not part of the encoding of receipts. There must be a transaction hash for each receipt.
+// The contract-deployment addresses are not set, like with DecodeRawReceipts.
+func DeriveReceiptsMetadata(block BlockID, receipts []*types.Receipt, txHashes []common.Hash) {
+	totalIndex := uint(0)
+	prevCumulativeGasUsed := uint64(0)
+	for i, x := range receipts {
 		x.TxHash = txHashes[i]
 		x.BlockHash = block.Hash
 		x.BlockNumber = new(big.Int).SetUint64(block.Number)
@@ -48,7 +60,31 @@ func DecodeRawReceipts(block BlockID, rawReceipts []hexutil.Bytes, txHashes []co
 			l.Index = totalIndex
 			totalIndex += 1
 		}
-		result[i] = &x
 	}
-	return result, nil
+}
+
+// DeriveBlobReceiptFields derives the blob gas fields of the receipts of blob transactions, which are not part of
+// the encoding of receipts: the blob gas price from the blob base fee of the block, which is nil before Cancun,
+// and, if the transactions of the receipts are given, the blob gas used by each transaction.
+// Receipts of other transaction types are left untouched.
+func DeriveBlobReceiptFields(receipts []*types.Receipt, blobBaseFee *big.Int, txs types.Transactions) error {
+	if txs != nil && len(txs) != len(receipts) {
+		return fmt.Errorf("got %d transactions for %d receipts", len(txs), len(receipts))
+	}
+	for i, r := range receipts {
+		if r.Type != types.BlobTxType {
+			continue
+		}
+		if blobBaseFee == nil {
+			return fmt.Errorf("receipt %d is of a blob transaction, but the block has no blob base fee", i)
+		}
+		r.BlobGasPrice = new(big.Int).Set(blobBaseFee)
+		if txs != nil {
+			if txs[i].Type() != types.BlobTxType {
+				return fmt.Errorf("receipt %d is of a blob transaction, but transaction %s is of type %d", i, txs[i].Hash(), txs[i].Type())
+			}
+			r.BlobGasUsed = txs[i].BlobGas()
+		}
+	}
+	return nil
 }
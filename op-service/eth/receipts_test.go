@@ -0,0 +1,69 @@
+package eth
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeRawReceipts_BlobTx(t *testing.T) {
+	blobTx := types.NewTx(&types.BlobTx{
+		ChainID:    uint256.NewInt(1),
+		Nonce:      7,
+		GasTipCap:  uint256.NewInt(1e9),
+		GasFeeCap:  uint256.NewInt(30e9),
+		Gas:        21000,
+		To:         common.Address{0xaa},
+		BlobFeeCap: uint256.NewInt(1e9),
+		BlobHashes: []common.Hash{{0x01}, {0x01, 0x02}},
+	})
+	dynTx := types.NewTx(&types.DynamicFeeTx{ChainID: big.NewInt(1), Gas: 50000, To: &common.Address{0xbb}})
+	txs := types.Transactions{dynTx, blobTx}
+	receipts := []*types.Receipt{
+		{Type: types.DynamicFeeTxType, Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: 30000, Logs: []*types.Log{}},
+		{Type: types.BlobTxType, Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: 51000, Logs: []*types.Log{
+			{Address: common.Address{0xcc}, Topics: []common.Hash{{0xdd}}, Data: []byte{1, 2, 3}},
+		}},
+	}
+	for _, r := range receipts {
+		r.Bloom = types.CreateBloom(types.Receipts{r})
+	}
+	// encoded like debug_getRawReceipts returns the receipts
+	raw, err := EncodeReceipts(receipts)
+	require.NoError(t, err)
+	require.Equal(t, byte(types.BlobTxType), raw[1][0])
+
+	block := BlockID{Hash: common.Hash{0xee}, Number: 19_426_587}
+	decoded, err := DecodeRawReceipts(block, raw, []common.Hash{dynTx.Hash(), blobTx.Hash()})
+	require.NoError(t, err)
+	require.Len(t, decoded, 2)
+	blobReceipt := decoded[1]
+	require.Equal(t, uint8(types.BlobTxType), blobReceipt.Type)
+	require.Equal(t, uint64(21000), blobReceipt.GasUsed)
+	require.Equal(t, blobTx.Hash(), blobReceipt.TxHash)
+	require.Len(t, blobReceipt.Logs, 1)
+	require.Equal(t, uint(0), blobReceipt.Logs[0].Index)
+	require.Equal(t, types.DeriveSha(types.Receipts(receipts), trie.NewStackTrie(nil)), types.DeriveSha(types.Receipts(decoded), trie.NewStackTrie(nil)))
+
+	blobBaseFee := big.NewInt(42)
+	require.NoError(t, DeriveBlobReceiptFields(decoded, blobBaseFee, txs))
+	require.Equal(t, blobBaseFee, blobReceipt.BlobGasPrice)
+	require.Equal(t, uint64(2*params.BlobTxBlobGasPerBlob), blobReceipt.BlobGasUsed)
+	require.Nil(t, decoded[0].BlobGasPrice, "receipts of other tx types are untouched")
+
+	// without transactions, only the blob gas price is derived
+	decoded, err = DecodeRawReceipts(block, raw, []common.Hash{dynTx.Hash(), blobTx.Hash()})
+	require.NoError(t, err)
+	require.NoError(t, DeriveBlobReceiptFields(decoded, blobBaseFee, nil))
+	require.Equal(t, blobBaseFee, decoded[1].BlobGasPrice)
+	require.Zero(t, decoded[1].BlobGasUsed)
+
+	require.ErrorContains(t, DeriveBlobReceiptFields(decoded, nil, nil), "no blob base fee")
+	require.ErrorContains(t, DeriveBlobReceiptFields(decoded, blobBaseFee, types.Transactions{blobTx, dynTx}), "is of type")
+}
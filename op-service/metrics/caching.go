@@ -1,6 +1,8 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -10,6 +12,8 @@ type CacheMetrics struct {
 	SizeVec *prometheus.GaugeVec
 	GetVec  *prometheus.CounterVec
 	AddVec  *prometheus.CounterVec
+
+	FetchDurationVec *prometheus.HistogramVec
 }
 
 // CacheAdd meters the addition of an item with a given type to the cache,
@@ -23,6 +27,11 @@ func (m *CacheMetrics) CacheAdd(typeLabel string, typeCacheSize int, evicted boo
 	}
 }
 
+// CacheRemove meters the removal of items with a given type from the cache, metering the change of the cache size.
+func (m *CacheMetrics) CacheRemove(typeLabel string, typeCacheSize int) {
+	m.SizeVec.WithLabelValues(typeLabel).Set(float64(typeCacheSize))
+}
+
 // CacheGet meters a lookup of an item with a given type to the cache
 // and indicating if the lookup was a hit.
 func (m *CacheMetrics) CacheGet(typeLabel string, hit bool) {
@@ -33,6 +42,11 @@ func (m *CacheMetrics) CacheGet(typeLabel string, hit bool) {
 	}
 }
 
+// CacheFetch meters the duration of a fetch of an item with a given type, after the item missed the cache.
+func (m *CacheMetrics) CacheFetch(typeLabel string, duration time.Duration) {
+	m.FetchDurationVec.WithLabelValues(typeLabel).Observe(duration.Seconds())
+}
+
 func NewCacheMetrics(factory Factory, ns string, name string, displayName string) *CacheMetrics {
 	return &CacheMetrics{
 		SizeVec: factory.NewGaugeVec(prometheus.GaugeOpts{
@@ -58,5 +72,13 @@ func NewCacheMetrics(factory Factory, ns string, name string, displayName string
 			"type",
 			"evicted",
 		}),
+		FetchDurationVec: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: ns,
+			Name:      name + "_fetch_seconds",
+			Help:      displayName + " fetch duration of items that missed the cache, in seconds",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{
+			"type",
+		}),
 	}
 }
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ReceiptsMetrics implements the ReceiptsMetrics interface in the sources package,
+// metering which RPC methods are used to fetch receipts.
+type ReceiptsMetrics struct {
+	MethodVec   *prometheus.CounterVec
+	FallbackVec *prometheus.CounterVec
+}
+
+// RecordReceiptsMethod meters a successful receipts fetch with the given method.
+func (m *ReceiptsMetrics) RecordReceiptsMethod(method string) {
+	m.MethodVec.WithLabelValues(method).Inc()
+}
+
+// RecordReceiptsMethodFallback meters a fallback away from the given method, after it failed.
+func (m *ReceiptsMetrics) RecordReceiptsMethodFallback(method string) {
+	m.FallbackVec.WithLabelValues(method).Inc()
+}
+
+func NewReceiptsMetrics(factory Factory, ns string, name string, displayName string) *ReceiptsMetrics {
+	return &ReceiptsMetrics{
+		MethodVec: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      name + "_method",
+			Help:      displayName + " receipts fetches, by the method that was used",
+		}, []string{
+			"method",
+		}),
+		FallbackVec: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      name + "_method_fallback",
+			Help:      displayName + " fallbacks from failed receipts fetching methods, by the method that failed",
+		}, []string{
+			"method",
+		}),
+	}
+}
@@ -6,12 +6,25 @@ import (
 	"io"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-service/retry"
 )
 
+// RetryPolicy configures the retries of individual failed requests of an IterativeBatchCall.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a request within a single Fetch, including the first attempt.
+	// Values below 2 disable retries.
+	MaxAttempts int
+	// Strategy determines the backoff before each retry. Retries are immediate if nil.
+	Strategy retry.Strategy
+}
+
 // IterativeBatchCall batches many RPC requests with safe and easy parallelization.
 // Request errors are handled and re-tried, and the batch size is configurable.
 // Executing IterativeBatchCall is as simple as calling Fetch repeatedly until it returns io.EOF.
@@ -28,6 +41,8 @@ type IterativeBatchCall[K any, V any] struct {
 
 	requestsValues []V
 	scheduled      chan rpc.BatchElem
+
+	retryPolicy RetryPolicy
 }
 
 // NewIterativeBatchCall constructs a batch call, fetching the values with the given keys,
@@ -58,6 +73,16 @@ func NewIterativeBatchCall[K any, V any](
 	return out
 }
 
+// SetRetryPolicy configures Fetch to retry the requests that failed, with backoff, instead of returning an error
+// on the first failure. Only the failed requests are retried: requests that were fetched successfully are never
+// requested again. Errors of the batch request as a whole are not retried. If requests still fail after
+// the maximum number of attempts, Fetch returns their errors, and they are scheduled again like without retries.
+func (ibc *IterativeBatchCall[K, V]) SetRetryPolicy(policy RetryPolicy) {
+	ibc.resetLock.Lock()
+	defer ibc.resetLock.Unlock()
+	ibc.retryPolicy = policy
+}
+
 // Reset will clear the batch call, to start fetching all contents from scratch.
 func (ibc *IterativeBatchCall[K, V]) Reset() {
 	ibc.resetLock.Lock()
@@ -131,36 +156,99 @@ func (ibc *IterativeBatchCall[K, V]) Fetch(ctx context.Context) error {
 		return nil
 	}
 
-	if ibc.batchSize == 1 {
-		first := batch[0]
-		if err := ibc.getSingle(ctx, &first.Result, first.Method, first.Args...); err != nil {
-			ibc.scheduled <- first
-			return err
+	for attempt := 1; ; attempt++ {
+		if ibc.batchSize == 1 {
+			first := batch[0]
+			if err := ibc.getSingle(ctx, &first.Result, first.Method, first.Args...); err != nil {
+				if !ibc.awaitRetry(ctx, attempt) {
+					ibc.scheduled <- first
+					return err
+				}
+				continue
+			}
+		} else {
+			if err := ibc.getBatch(ctx, batch); err != nil {
+				for _, r := range batch {
+					ibc.scheduled <- r
+				}
+				return fmt.Errorf("failed batch-retrieval: %w", err)
+			}
 		}
-	} else {
-		if err := ibc.getBatch(ctx, batch); err != nil {
-			for _, r := range batch {
-				ibc.scheduled <- r
+		var result error
+		var failed []rpc.BatchElem
+		for _, elem := range batch {
+			if elem.Error != nil {
+				result = multierror.Append(result, elem.Error)
+				elem.Error = nil // reset, we'll try this element again
+				failed = append(failed, elem)
+				continue
+			} else {
+				atomic.AddUint32(&ibc.completed, 1)
+				if atomic.LoadUint32(&ibc.completed) >= uint32(len(ibc.requestsKeys)) {
+					close(ibc.scheduled)
+					return io.EOF
+				}
 			}
-			return fmt.Errorf("failed batch-retrieval: %w", err)
 		}
+		if len(failed) == 0 {
+			return nil
+		}
+		if !ibc.awaitRetry(ctx, attempt) {
+			for _, elem := range failed {
+				ibc.scheduled <- elem
+			}
+			return result
+		}
+		batch = failed
 	}
-	var result error
-	for _, elem := range batch {
-		if elem.Error != nil {
-			result = multierror.Append(result, elem.Error)
-			elem.Error = nil // reset, we'll try this element again
-			ibc.scheduled <- elem
-			continue
-		} else {
-			atomic.AddUint32(&ibc.completed, 1)
-			if atomic.LoadUint32(&ibc.completed) >= uint32(len(ibc.requestsKeys)) {
-				close(ibc.scheduled)
-				return io.EOF
+}
+
+// awaitRetry waits for the backoff of the retry following the given attempt, and reports if the retry should happen.
+// It returns false if the attempts are exhausted, or if the context is done while waiting.
+func (ibc *IterativeBatchCall[K, V]) awaitRetry(ctx context.Context, attempt int) bool {
+	if attempt >= ibc.retryPolicy.MaxAttempts {
+		return false
+	}
+	var backoff time.Duration
+	if ibc.retryPolicy.Strategy != nil {
+		backoff = ibc.retryPolicy.Strategy.Duration(attempt - 1)
+	}
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// FetchAll fetches all data, dispatching up to parallelism batches concurrently, and returns nil once all data has
+// been fetched. The results are stored by request, so Result returns them in order regardless of completion order.
+// onFetch is optional, and called after each Fetch, possibly concurrently.
+// The first error aborts the other in-flight batches, which are then scheduled again, and is returned.
+func (ibc *IterativeBatchCall[K, V]) FetchAll(ctx context.Context, parallelism int, onFetch func()) error {
+	fetchAll := func(ctx context.Context) error {
+		for {
+			err := ibc.Fetch(ctx)
+			if onFetch != nil {
+				onFetch()
+			}
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
 			}
 		}
 	}
-	return result
+	if parallelism <= 1 {
+		return fetchAll(ctx)
+	}
+	g, gctx := errgroup.WithContext(ctx)
+	for i := 0; i < parallelism; i++ {
+		g.Go(func() error { return fetchAll(gctx) })
+	}
+	return g.Wait()
 }
 
 // Complete indicates if the batch call is done.
@@ -170,6 +258,13 @@ func (ibc *IterativeBatchCall[K, V]) Complete() bool {
 	return atomic.LoadUint32(&ibc.completed) >= uint32(len(ibc.requestsKeys))
 }
 
+// Progress returns the number of completed requests, and the total number of requests.
+func (ibc *IterativeBatchCall[K, V]) Progress() (done, total int) {
+	ibc.resetLock.RLock()
+	defer ibc.resetLock.RUnlock()
+	return int(atomic.LoadUint32(&ibc.completed)), len(ibc.requestsKeys)
+}
+
 // Result returns the fetched values, checked and transformed to the final output type, if available.
 // If the check fails, the IterativeBatchCall will Reset itself, to be ready for a re-attempt in fetching new data.
 func (ibc *IterativeBatchCall[K, V]) Result() ([]V, error) {
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -12,6 +14,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-service/retry"
 )
 
 type elemCall struct {
@@ -339,3 +343,148 @@ func TestFetchBatched(t *testing.T) {
 		t.Run(tc.name, tc.Run)
 	}
 }
+
+// flakyRPC fails the requests of specific ids the first given number of times they are requested.
+type flakyRPC struct {
+	mu        sync.Mutex
+	failures  map[int]int
+	requested map[int]int
+}
+
+func (f *flakyRPC) fail(id int) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requested[id]++
+	if f.failures[id] > 0 {
+		f.failures[id]--
+		return true
+	}
+	return false
+}
+
+func (f *flakyRPC) GetBatch(ctx context.Context, b []rpc.BatchElem) error {
+	for i, elem := range b {
+		id := elem.Args[0].(int)
+		if f.fail(id) {
+			b[i].Error = mockErr
+			continue
+		}
+		*elem.Result.(*string) = fmt.Sprintf("mock result id %d", id)
+	}
+	return nil
+}
+
+func (f *flakyRPC) GetSingle(ctx context.Context, result any, method string, args ...any) error {
+	id := args[0].(int)
+	if f.fail(id) {
+		return mockErr
+	}
+	*(*(result.(*interface{}))).(*string) = fmt.Sprintf("mock result id %d", id)
+	return nil
+}
+
+func TestIterativeBatchCall_RetryPolicy(t *testing.T) {
+	for _, batchSize := range []int{1, 4} {
+		batchSize := batchSize
+		t.Run(fmt.Sprintf("batch size %d", batchSize), func(t *testing.T) {
+			keys := []int{0, 1, 2, 3}
+			client := &flakyRPC{failures: map[int]int{1: 2, 3: 1}, requested: make(map[int]int)}
+			iter := NewIterativeBatchCall[int, *string](keys, makeTestRequest, client.GetBatch, client.GetSingle, batchSize)
+			iter.SetRetryPolicy(RetryPolicy{MaxAttempts: 3, Strategy: retry.Fixed(time.Millisecond)})
+
+			for {
+				err := iter.Fetch(context.Background())
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err, "transient failures are retried within the fetch")
+			}
+			out, err := iter.Result()
+			require.NoError(t, err)
+			for i, v := range out {
+				require.Equal(t, fmt.Sprintf("mock result id %d", i), *v)
+			}
+			// successfully fetched requests are not requested again
+			require.Equal(t, map[int]int{0: 1, 1: 3, 2: 1, 3: 2}, client.requested)
+		})
+	}
+}
+
+func TestIterativeBatchCall_RetryPolicyExhausted(t *testing.T) {
+	keys := []int{0, 1, 2}
+	client := &flakyRPC{failures: map[int]int{1: 3}, requested: make(map[int]int)}
+	iter := NewIterativeBatchCall[int, *string](keys, makeTestRequest, client.GetBatch, client.GetSingle, 3)
+	iter.SetRetryPolicy(RetryPolicy{MaxAttempts: 2})
+
+	require.ErrorIs(t, iter.Fetch(context.Background()), mockErr)
+	require.False(t, iter.Complete())
+	require.Equal(t, map[int]int{0: 1, 1: 2, 2: 1}, client.requested)
+
+	// the failed request is scheduled again, and retried on the next fetch
+	require.Equal(t, io.EOF, iter.Fetch(context.Background()))
+	require.Equal(t, map[int]int{0: 1, 1: 4, 2: 1}, client.requested)
+	require.True(t, iter.Complete())
+}
+
+func TestIterativeBatchCall_RetryPolicyContextDone(t *testing.T) {
+	keys := []int{0, 1}
+	client := &flakyRPC{failures: map[int]int{1: 1}, requested: make(map[int]int)}
+	iter := NewIterativeBatchCall[int, *string](keys, makeTestRequest, client.GetBatch, client.GetSingle, 2)
+	iter.SetRetryPolicy(RetryPolicy{MaxAttempts: 3, Strategy: retry.Fixed(time.Hour)})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, iter.Fetch(ctx), mockErr, "the backoff is aborted when the context is done")
+	require.Equal(t, map[int]int{0: 1, 1: 1}, client.requested)
+}
+
+func TestIterativeBatchCall_FetchAll(t *testing.T) {
+	keys := make([]int, 10)
+	for i := range keys {
+		keys[i] = i
+	}
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	getBatch := func(ctx context.Context, b []rpc.BatchElem) error {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+		// earlier batches complete later, to complete out of order
+		time.Sleep(time.Duration(10-b[0].Args[0].(int)) * 5 * time.Millisecond)
+		for i, elem := range b {
+			*b[i].Result.(*string) = fmt.Sprintf("mock result id %d", elem.Args[0].(int))
+		}
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		return nil
+	}
+	iter := NewIterativeBatchCall[int, *string](keys, makeTestRequest, getBatch, nil, 2)
+	var fetches atomic.Int32
+	require.NoError(t, iter.FetchAll(context.Background(), 3, func() { fetches.Add(1) }))
+	require.Equal(t, 3, maxInFlight, "batches are fetched concurrently, up to the parallelism")
+	require.GreaterOrEqual(t, fetches.Load(), int32(5))
+
+	out, err := iter.Result()
+	require.NoError(t, err)
+	for i, v := range out {
+		require.Equal(t, fmt.Sprintf("mock result id %d", i), *v, "results are ordered regardless of completion order")
+	}
+}
+
+func TestIterativeBatchCall_FetchAllError(t *testing.T) {
+	keys := []int{0, 1, 2, 3}
+	getBatch := func(ctx context.Context, b []rpc.BatchElem) error {
+		if b[0].Args[0].(int) == 2 {
+			return mockErr
+		}
+		for i, elem := range b {
+			*b[i].Result.(*string) = fmt.Sprintf("mock result id %d", elem.Args[0].(int))
+		}
+		return nil
+	}
+	iter := NewIterativeBatchCall[int, *string](keys, makeTestRequest, getBatch, nil, 2)
+	require.ErrorIs(t, iter.FetchAll(context.Background(), 2, nil), mockErr)
+	require.False(t, iter.Complete())
+}
@@ -1,43 +1,333 @@
 package caching
 
-import lru "github.com/hashicorp/golang-lru/v2"
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
 
 type Metrics interface {
 	CacheAdd(label string, cacheSize int, evicted bool)
 	CacheGet(label string, hit bool)
 }
 
-// LRUCache wraps hashicorp *lru.Cache and tracks cache metrics
+// RemoveMetrics is optionally implemented by Metrics, to meter the cache size after entries are removed
+// with Remove or Clear, rather than evicted by additions.
+type RemoveMetrics interface {
+	CacheRemove(label string, cacheSize int)
+}
+
+// FetchMetrics is optionally implemented by Metrics, to meter how long it takes to fetch values
+// that missed the cache, by the users of the cache that fetch them.
+type FetchMetrics interface {
+	CacheFetch(label string, duration time.Duration)
+}
+
+// Option configures optional behavior of a LRUCache.
+type Option func(*options)
+
+type options struct {
+	ttl     time.Duration
+	now     func() time.Time
+	maxCost int
+	cost    func(value any) int
+	exempt  func(key any, value any) bool
+
+	trackAccess bool
+}
+
+// WithTTL expires entries the given duration after they were added.
+// Expired entries are treated as misses. A zero TTL disables expiry.
+func WithTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.ttl = ttl
+	}
+}
+
+// WithExpiryExemption exempts the entries for which exempt returns true from the TTL, see WithTTL, so they are only
+// evicted by the size and cost bounds of the cache. Exemption is checked whenever an entry would have expired,
+// rather than when it is added, so an entry may become exempt while it is cached, e.g. once its data is final.
+// K and V must be the key and value types of the cache.
+func WithExpiryExemption[K comparable, V any](exempt func(key K, value V) bool) Option {
+	return func(o *options) {
+		o.exempt = func(key any, value any) bool {
+			return exempt(key.(K), value.(V))
+		}
+	}
+}
+
+// WithMaxCost bounds the accumulated cost of the cached values, e.g. their size in bytes, in addition to their count.
+// The cost of a value is computed once, when it is added. When adding a value exceeds maxCost,
+// the least recently used values are evicted until the cache is within budget again,
+// except for the value that was just added, which is always retained.
+// A non-positive maxCost disables the cost bound. V must be the value type of the cache.
+func WithMaxCost[V any](maxCost int, cost func(value V) int) Option {
+	return func(o *options) {
+		o.maxCost = maxCost
+		o.cost = func(value any) int {
+			return cost(value.(V))
+		}
+	}
+}
+
+// WithAccessTracking tracks the number of hits and the last access of every cached key, to inspect the access
+// distribution of the cache with HotKeys, e.g. to size it. The stats of a key are dropped when it is evicted or removed.
+// This is meant for diagnostics only: it adds locking and bookkeeping to every lookup, which caches without it do not pay.
+func WithAccessTracking() Option {
+	return func(o *options) {
+		o.trackAccess = true
+	}
+}
+
+// KeyAccess are the access stats of a cached key, as tracked with WithAccessTracking.
+type KeyAccess[K comparable] struct {
+	Key        K
+	Hits       uint64
+	LastAccess time.Time
+}
+
+// accessTracker tracks the KeyAccess of cached keys.
+type accessTracker[K comparable] struct {
+	mu   sync.Mutex
+	keys map[K]*KeyAccess[K]
+}
+
+func (t *accessTracker[K]) hit(key K, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	access, ok := t.keys[key]
+	if !ok {
+		access = &KeyAccess[K]{Key: key}
+		t.keys[key] = access
+	}
+	access.Hits++
+	access.LastAccess = now
+}
+
+func (t *accessTracker[K]) remove(key K) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.keys, key)
+}
+
+// entry is a cached value, with the time it was added to the cache, and its cost if the cache is cost-bounded.
+type entry[V any] struct {
+	value V
+	added time.Time
+	cost  int
+}
+
+// LRUCache wraps hashicorp *lru.Cache and tracks cache metrics.
+// It is safe for concurrent use: the inner cache serializes all access with a lock.
 type LRUCache[K comparable, V any] struct {
 	m     Metrics
 	label string
-	inner *lru.Cache[K, V]
+	inner *lru.Cache[K, entry[V]]
+	ttl   time.Duration
+	now   func() time.Time
+	// exempt optionally exempts entries from the TTL
+	exempt func(key any, value any) bool
+
+	// maxCost bounds totalCost, if cost is not nil
+	maxCost int
+	cost    func(value any) int
+	// totalCost is the accumulated cost of the cached entries,
+	// it is decremented by the eviction callback of the inner cache.
+	totalCost atomic.Int64
+	// addMu serializes cost-bounded additions, so replaced and evicted entries are accounted for consistently
+	addMu sync.Mutex
+
+	// access is nil, unless access tracking is enabled
+	access *accessTracker[K]
 }
 
 func (c *LRUCache[K, V]) Get(key K) (value V, ok bool) {
-	value, ok = c.inner.Get(key)
+	e, ok := c.inner.Get(key)
+	if ok && c.expired(key, e) {
+		// A concurrent Add of the same key may be removed as well, which only causes an extra miss.
+		c.inner.Remove(key)
+		ok = false
+	}
+	if ok {
+		value = e.value
+		if c.access != nil {
+			c.access.hit(key, c.now())
+		}
+	}
 	if c.m != nil {
 		c.m.CacheGet(c.label, ok)
 	}
 	return value, ok
 }
 
+// HotKeys returns the access stats of the n most hit keys in the cache, from the most to the least hit,
+// with the most recently accessed key first among keys with as many hits. Keys that were never hit are not included.
+// It returns nil if the cache was not created WithAccessTracking.
+func (c *LRUCache[K, V]) HotKeys(n int) []KeyAccess[K] {
+	if c.access == nil || n <= 0 {
+		return nil
+	}
+	c.access.mu.Lock()
+	hot := make([]KeyAccess[K], 0, len(c.access.keys))
+	for _, access := range c.access.keys {
+		hot = append(hot, *access)
+	}
+	c.access.mu.Unlock()
+	// a hit racing with the eviction of its key may be tracked after the eviction dropped the stats of the key
+	cached := hot[:0]
+	for _, access := range hot {
+		if c.inner.Contains(access.Key) {
+			cached = append(cached, access)
+		}
+	}
+	hot = cached
+	sort.Slice(hot, func(i, j int) bool {
+		if hot[i].Hits != hot[j].Hits {
+			return hot[i].Hits > hot[j].Hits
+		}
+		return hot[i].LastAccess.After(hot[j].LastAccess)
+	})
+	if len(hot) > n {
+		hot = hot[:n]
+	}
+	return hot
+}
+
+func (c *LRUCache[K, V]) expired(key K, e entry[V]) bool {
+	if c.ttl <= 0 || c.now().Sub(e.added) < c.ttl {
+		return false
+	}
+	return c.exempt == nil || !c.exempt(key, e.value)
+}
+
+// Contains checks if the cache holds an unexpired value for the given key,
+// without updating the recency of the key, and without metering a lookup.
+func (c *LRUCache[K, V]) Contains(key K) bool {
+	e, ok := c.inner.Peek(key)
+	return ok && !c.expired(key, e)
+}
+
+// Peek returns the unexpired value of the given key, if any, without updating the recency of the key,
+// and without metering a lookup.
+func (c *LRUCache[K, V]) Peek(key K) (value V, ok bool) {
+	e, ok := c.inner.Peek(key)
+	if !ok || c.expired(key, e) {
+		return value, false
+	}
+	return e.value, true
+}
+
+// Keys returns the keys of the unexpired values in the cache, from the least to the most recently used.
+func (c *LRUCache[K, V]) Keys() []K {
+	keys := c.inner.Keys()
+	unexpired := keys[:0]
+	for _, key := range keys {
+		if c.Contains(key) {
+			unexpired = append(unexpired, key)
+		}
+	}
+	return unexpired
+}
+
+// Remove removes the value of the given key from the cache, and reports if it was present.
+func (c *LRUCache[K, V]) Remove(key K) (present bool) {
+	present = c.inner.Remove(key)
+	if present {
+		c.meterRemove()
+	}
+	return present
+}
+
+// Clear removes all values from the cache.
+func (c *LRUCache[K, V]) Clear() {
+	c.inner.Purge()
+	c.meterRemove()
+}
+
+// Len returns the number of values in the cache, including expired values that were not looked up since.
+func (c *LRUCache[K, V]) Len() int {
+	return c.inner.Len()
+}
+
+func (c *LRUCache[K, V]) meterRemove() {
+	if m, ok := c.m.(RemoveMetrics); ok {
+		m.CacheRemove(c.label, c.inner.Len())
+	}
+}
+
+// Cost returns the accumulated cost of the cached values. It is always 0 if the cache is not cost-bounded.
+func (c *LRUCache[K, V]) Cost() int {
+	return int(c.totalCost.Load())
+}
+
 func (c *LRUCache[K, V]) Add(key K, value V) (evicted bool) {
-	evicted = c.inner.Add(key, value)
+	e := entry[V]{value: value}
+	if c.ttl > 0 {
+		e.added = c.now()
+	}
+	if c.cost != nil {
+		evicted = c.addCostBounded(key, e)
+	} else {
+		evicted = c.inner.Add(key, e)
+	}
 	if c.m != nil {
 		c.m.CacheAdd(c.label, c.inner.Len(), evicted)
 	}
 	return evicted
 }
 
+func (c *LRUCache[K, V]) addCostBounded(key K, e entry[V]) (evicted bool) {
+	e.cost = c.cost(e.value)
+	c.addMu.Lock()
+	defer c.addMu.Unlock()
+	// replacing a value does not call the eviction callback, so the cost of the replaced value is subtracted here
+	if prev, ok := c.inner.Peek(key); ok {
+		c.totalCost.Add(-int64(prev.cost))
+	}
+	c.totalCost.Add(int64(e.cost))
+	evicted = c.inner.Add(key, e)
+	for c.totalCost.Load() > int64(c.maxCost) && c.inner.Len() > 1 {
+		c.inner.RemoveOldest()
+		evicted = true
+	}
+	return evicted
+}
+
 // NewLRUCache creates a LRU cache with the given metrics, labeling the cache adds/gets.
 // Metrics are optional: no metrics will be tracked if m == nil.
-func NewLRUCache[K comparable, V any](m Metrics, label string, maxSize int) *LRUCache[K, V] {
-	// no errors if the size is positive
-	cache, _ := lru.New[K, V](maxSize)
-	return &LRUCache[K, V]{
-		m:     m,
-		label: label,
-		inner: cache,
+func NewLRUCache[K comparable, V any](m Metrics, label string, maxSize int, opts ...Option) *LRUCache[K, V] {
+	o := options{now: time.Now}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	c := &LRUCache[K, V]{
+		m:      m,
+		label:  label,
+		ttl:    o.ttl,
+		now:    o.now,
+		exempt: o.exempt,
 	}
+	costBounded := o.cost != nil && o.maxCost > 0
+	if costBounded {
+		c.maxCost = o.maxCost
+		c.cost = o.cost
+	}
+	if o.trackAccess {
+		c.access = &accessTracker[K]{keys: make(map[K]*KeyAccess[K])}
+	}
+	var onEvict func(key K, e entry[V])
+	if costBounded || c.access != nil {
+		onEvict = func(key K, e entry[V]) {
+			c.totalCost.Add(-int64(e.cost))
+			if c.access != nil {
+				c.access.remove(key)
+			}
+		}
+	}
+	// no errors if the size is positive
+	c.inner, _ = lru.NewWithEvict[K, entry[V]](maxSize, onEvict)
+	return c
 }
@@ -0,0 +1,226 @@
+package caching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCache_TTL(t *testing.T) {
+	now := time.Unix(1000, 0)
+	withClock := func(o *options) { o.now = func() time.Time { return now } }
+	c := NewLRUCache[int, string](nil, "test", 10, WithTTL(time.Minute), withClock)
+
+	c.Add(1, "a")
+	now = now.Add(time.Minute - time.Nanosecond)
+	v, ok := c.Get(1)
+	require.True(t, ok, "entry is live just before the TTL")
+	require.Equal(t, "a", v)
+
+	now = now.Add(time.Nanosecond)
+	_, ok = c.Get(1)
+	require.False(t, ok, "entry expires at the TTL")
+	_, ok = c.Get(1)
+	require.False(t, ok, "expired entry is removed")
+
+	// re-adding the entry restarts its TTL
+	c.Add(1, "b")
+	now = now.Add(time.Minute - time.Nanosecond)
+	v, ok = c.Get(1)
+	require.True(t, ok)
+	require.Equal(t, "b", v)
+}
+
+func TestLRUCache_NoTTL(t *testing.T) {
+	now := time.Unix(1000, 0)
+	withClock := func(o *options) { o.now = func() time.Time { return now } }
+	c := NewLRUCache[int, string](nil, "test", 10, withClock)
+
+	c.Add(1, "a")
+	now = now.Add(365 * 24 * time.Hour)
+	v, ok := c.Get(1)
+	require.True(t, ok)
+	require.Equal(t, "a", v)
+}
+
+func TestLRUCache_ExpiryExemption(t *testing.T) {
+	now := time.Unix(1000, 0)
+	withClock := func(o *options) { o.now = func() time.Time { return now } }
+	final := map[int]bool{1: true}
+	c := NewLRUCache[int, string](nil, "test", 2, WithTTL(time.Minute), withClock,
+		WithExpiryExemption(func(key int, _ string) bool { return final[key] }))
+
+	c.Add(1, "a")
+	c.Add(2, "b")
+	now = now.Add(time.Hour)
+	v, ok := c.Get(1)
+	require.True(t, ok, "exempt entry does not expire")
+	require.Equal(t, "a", v)
+	require.False(t, c.Contains(2), "other entries expire")
+
+	// entries may become exempt while cached
+	c.Add(2, "b")
+	now = now.Add(time.Hour)
+	final[2] = true
+	require.Equal(t, []int{1, 2}, c.Keys())
+
+	// exempt entries are still evicted by size
+	c.Add(3, "c")
+	require.False(t, c.Contains(1))
+}
+
+type testMetrics struct {
+	sizes map[string]int
+	gets  int
+}
+
+func (m *testMetrics) CacheAdd(label string, cacheSize int, _ bool) { m.sizes[label] = cacheSize }
+func (m *testMetrics) CacheGet(string, bool)                        { m.gets++ }
+func (m *testMetrics) CacheRemove(label string, cacheSize int)      { m.sizes[label] = cacheSize }
+
+func TestLRUCache_RemoveClear(t *testing.T) {
+	m := &testMetrics{sizes: make(map[string]int)}
+	c := NewLRUCache[int, string](m, "test", 2)
+
+	c.Add(1, "a")
+	c.Add(2, "b")
+	require.Equal(t, 2, c.Len())
+	require.Equal(t, 2, m.sizes["test"])
+
+	require.True(t, c.Remove(1))
+	require.False(t, c.Remove(1))
+	require.Equal(t, 1, c.Len())
+	require.Equal(t, 1, m.sizes["test"])
+	_, ok := c.Get(1)
+	require.False(t, ok)
+
+	c.Clear()
+	require.Zero(t, c.Len())
+	require.Zero(t, m.sizes["test"])
+}
+
+func TestLRUCache_Contains(t *testing.T) {
+	now := time.Unix(1000, 0)
+	withClock := func(o *options) { o.now = func() time.Time { return now } }
+	m := &testMetrics{sizes: make(map[string]int)}
+	c := NewLRUCache[int, string](m, "test", 2, WithTTL(time.Minute), withClock)
+
+	c.Add(1, "a")
+	c.Add(2, "b")
+	// Contains does not bump the recency of 1, so it is evicted first
+	require.True(t, c.Contains(1))
+	require.Zero(t, m.gets)
+	c.Add(3, "c")
+	require.False(t, c.Contains(1))
+	require.True(t, c.Contains(2))
+
+	now = now.Add(time.Minute)
+	require.False(t, c.Contains(2), "expired values are not contained")
+}
+
+func TestLRUCache_PeekKeys(t *testing.T) {
+	now := time.Unix(1000, 0)
+	withClock := func(o *options) { o.now = func() time.Time { return now } }
+	m := &testMetrics{sizes: make(map[string]int)}
+	c := NewLRUCache[int, string](m, "test", 3, WithTTL(time.Minute), withClock)
+
+	c.Add(1, "a")
+	now = now.Add(time.Second)
+	c.Add(2, "b")
+	c.Add(3, "c")
+	// Peek does not bump the recency of 1, nor meter a lookup
+	v, ok := c.Peek(1)
+	require.True(t, ok)
+	require.Equal(t, "a", v)
+	require.Zero(t, m.gets)
+	require.Equal(t, []int{1, 2, 3}, c.Keys())
+
+	_, _ = c.Get(1)
+	require.Equal(t, []int{2, 3, 1}, c.Keys())
+
+	now = now.Add(time.Minute - time.Second)
+	_, ok = c.Peek(1)
+	require.False(t, ok, "expired values are not peeked")
+	require.Equal(t, []int{2, 3}, c.Keys(), "expired values are not listed")
+}
+
+func TestLRUCache_MaxCost(t *testing.T) {
+	c := NewLRUCache[int, string](nil, "test", 10, WithMaxCost(10, func(v string) int { return len(v) }))
+
+	c.Add(1, "aaaa")
+	require.False(t, c.Add(2, "bbbb"))
+	require.Equal(t, 8, c.Cost())
+
+	// the least recently used entries are evicted until the cache is within budget
+	_, _ = c.Get(1)
+	require.True(t, c.Add(3, "cccc"))
+	require.True(t, c.Contains(1))
+	require.False(t, c.Contains(2))
+	require.Equal(t, 8, c.Cost())
+
+	// replacing a value replaces its cost
+	require.False(t, c.Add(3, "cc"))
+	require.Equal(t, 6, c.Cost())
+
+	// a value exceeding the budget by itself is retained, evicting all others
+	require.True(t, c.Add(4, "dddddddddddd"))
+	require.Equal(t, 1, c.Len())
+	require.True(t, c.Contains(4))
+	require.Equal(t, 12, c.Cost())
+
+	require.True(t, c.Remove(4))
+	require.Equal(t, 0, c.Cost())
+	c.Add(5, "eeee")
+	c.Clear()
+	require.Equal(t, 0, c.Cost())
+}
+
+func TestLRUCache_MaxCostAndSize(t *testing.T) {
+	c := NewLRUCache[int, string](nil, "test", 2, WithMaxCost(100, func(v string) int { return len(v) }))
+
+	c.Add(1, "a")
+	c.Add(2, "b")
+	require.True(t, c.Add(3, "c"), "the size still bounds the cache")
+	require.Equal(t, 2, c.Len())
+	require.Equal(t, 2, c.Cost())
+}
+
+func TestLRUCache_HotKeys(t *testing.T) {
+	now := time.Unix(1000, 0)
+	withClock := func(o *options) { o.now = func() time.Time { return now } }
+	c := NewLRUCache[int, string](nil, "test", 3, WithAccessTracking(), withClock)
+	for i := 1; i <= 3; i++ {
+		c.Add(i, "v")
+	}
+	for i := 0; i < 3; i++ {
+		c.Get(1)
+	}
+	now = now.Add(time.Second)
+	c.Get(2)
+	now = now.Add(time.Second)
+	c.Get(3)
+	c.Get(4) // misses are not tracked
+
+	require.Equal(t, []KeyAccess[int]{
+		{Key: 1, Hits: 3, LastAccess: time.Unix(1000, 0)},
+		{Key: 3, Hits: 1, LastAccess: time.Unix(1002, 0)},
+	}, c.HotKeys(2))
+	require.Len(t, c.HotKeys(10), 3)
+	require.Empty(t, c.HotKeys(0))
+
+	// the stats of evicted and removed keys are dropped
+	c.Add(4, "v") // evicts 1, the least recently used
+	c.Remove(2)
+	require.Equal(t, []KeyAccess[int]{{Key: 3, Hits: 1, LastAccess: time.Unix(1002, 0)}}, c.HotKeys(10))
+	c.Clear()
+	require.Empty(t, c.HotKeys(10))
+}
+
+func TestLRUCache_HotKeysDisabled(t *testing.T) {
+	c := NewLRUCache[int, string](nil, "test", 3)
+	c.Add(1, "v")
+	c.Get(1)
+	require.Nil(t, c.HotKeys(10))
+	require.Nil(t, c.access)
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/urfave/cli/v2"
+
+	openum "github.com/ethereum-optimism/optimism/op-service/enum"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/opio"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+)
+
+const (
+	RPCFlagName          = "rpc"
+	RPCKindFlagName      = "rpc.kind"
+	StartFlagName        = "start"
+	EndFlagName          = "end"
+	MaxBatchSizeFlagName = "max-batch-size"
+)
+
+func main() {
+	oplog.SetupDefaults()
+
+	app := cli.NewApp()
+	app.Name = "receipts-bench"
+	app.Usage = "Benchmark the receipt fetching methods of an RPC provider"
+	app.Description = "Fetches the receipts of a range of blocks with each receipt fetching method available for the RPC provider kind, " +
+		"and reports the throughput and error rate of each method, to pick the RPC provider kind of a node empirically."
+	app.Flags = append([]cli.Flag{
+		&cli.StringFlag{
+			Name:     RPCFlagName,
+			Usage:    "RPC URL of the provider to benchmark",
+			Required: true,
+		},
+		&cli.GenericFlag{
+			Name:  RPCKindFlagName,
+			Usage: "The kind of RPC provider, whose receipt fetching methods are benchmarked. Valid options: " + openum.EnumString(sources.RPCProviderKinds),
+			Value: func() *sources.RPCProviderKind {
+				out := sources.RPCKindAny
+				return &out
+			}(),
+		},
+		&cli.Uint64Flag{
+			Name:     StartFlagName,
+			Usage:    "First block (inclusive) to fetch the receipts of",
+			Required: true,
+		},
+		&cli.Uint64Flag{
+			Name:     EndFlagName,
+			Usage:    "Last block (exclusive) to fetch the receipts of",
+			Required: true,
+		},
+		&cli.IntFlag{
+			Name:  MaxBatchSizeFlagName,
+			Usage: "Maximum batch size of per-tx receipt fetching",
+			Value: 20,
+		},
+	}, oplog.CLIFlags("RECEIPTS_BENCH")...)
+	app.Action = benchReceipts
+
+	ctx := opio.CancelOnInterrupt(context.Background())
+	if err := app.RunContext(ctx, os.Args); err != nil {
+		log.Crit("Receipts benchmark failed", "err", err)
+	}
+}
+
+func benchReceipts(c *cli.Context) error {
+	// the logs are written to stderr, so they do not get mixed into the results table
+	logger := oplog.NewLogger(os.Stderr, oplog.ReadCLIConfig(c))
+	start, end := c.Uint64(StartFlagName), c.Uint64(EndFlagName)
+	if end <= start {
+		return fmt.Errorf("--%s %d must be greater than --%s %d", EndFlagName, end, StartFlagName, start)
+	}
+	kind := *c.Generic(RPCKindFlagName).(*sources.RPCProviderKind)
+
+	client, err := rpc.DialContext(c.Context, c.String(RPCFlagName))
+	if err != nil {
+		return fmt.Errorf("failed to dial RPC: %w", err)
+	}
+	defer client.Close()
+
+	// the blocks are fetched up front, so only the fetching of receipts is measured
+	blocks := make([]sources.BlockReceiptsRequest, 0, end-start)
+	for number := start; number < end; number++ {
+		req, err := fetchBlock(c.Context, client, number)
+		if err != nil {
+			return err
+		}
+		blocks = append(blocks, req)
+	}
+	logger.Info("Fetched blocks to benchmark with", "start", start, "end", end, "kind", kind)
+
+	results, err := sources.BenchReceiptsMethods(c.Context, client, logger, sources.RPCReceiptsConfig{
+		MaxBatchSize: c.Int(MaxBatchSizeFlagName),
+		ProviderKind: kind,
+	}, blocks)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.App.Writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "METHOD\tBLOCKS\tRECEIPTS\tBLOCKS/S\tRECEIPTS/S\tERROR RATE\tPER-TX FALLBACKS\tFIRST ERROR")
+	for _, r := range results {
+		firstErr := "-"
+		if r.Unsupported {
+			firstErr = "unsupported: " + r.FirstErr.Error()
+		} else if r.FirstErr != nil {
+			firstErr = r.FirstErr.Error()
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%.2f\t%.2f\t%.1f%%\t%d\t%s\n", r.Method, r.Blocks, r.Receipts,
+			r.BlocksPerSecond(), r.ReceiptsPerSecond(), r.ErrorRate()*100, r.PerTxFallbacks, firstErr)
+	}
+	return w.Flush()
+}
+
+// fetchBlock fetches the header and transaction hashes of a block, verifying them against the block hash.
+func fetchBlock(ctx context.Context, client *rpc.Client, number uint64) (sources.BlockReceiptsRequest, error) {
+	var block *sources.RPCBlock
+	if err := client.CallContext(ctx, &block, "eth_getBlockByNumber", hexutil.EncodeUint64(number), true); err != nil {
+		return sources.BlockReceiptsRequest{}, fmt.Errorf("failed to fetch block %d: %w", number, err)
+	}
+	if block == nil {
+		return sources.BlockReceiptsRequest{}, fmt.Errorf("block %d not found", number)
+	}
+	info, txs, err := block.Info(false, false)
+	if err != nil {
+		return sources.BlockReceiptsRequest{}, fmt.Errorf("invalid block %d: %w", number, err)
+	}
+	txHashes := make([]common.Hash, len(txs))
+	for i, tx := range txs {
+		txHashes[i] = tx.Hash()
+	}
+	return sources.BlockReceiptsRequest{Info: info, TxHashes: txHashes}, nil
+}
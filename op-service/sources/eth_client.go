@@ -33,10 +33,23 @@ type EthClientConfig struct {
 	// limit concurrent requests, applies to the source as a whole
 	MaxConcurrentRequests int
 
+	// [OPTIONAL] Number of batches of per-tx receipt fetching of a block to request concurrently.
+	// If this is 0 then the batches are requested one by one.
+	ReceiptsBatchConcurrency int
+
 	// cache sizes
 
-	// Number of blocks worth of receipts to cache
+	// Number of blocks worth of receipts to cache, 0 disables caching receipts
 	ReceiptsCacheSize int
+	// [OPTIONAL] How long receipts stay cached, after which they are fetched again.
+	// If this is 0 then receipts are only evicted by the cache size.
+	ReceiptsCacheTTL time.Duration
+	// [OPTIONAL] Reports whether the block of the given number is finalized. The receipts of finalized blocks
+	// do not expire with ReceiptsCacheTTL, and stay cached until evicted by size, see WithFinalizedReceipts.
+	ReceiptsFinalized func(number uint64) bool
+	// [OPTIONAL] Approximate number of bytes of receipts to cache, see ReceiptsCost.
+	// If this is 0 then receipts are only evicted by the cache size.
+	ReceiptsCacheMaxBytes int
 	// Number of blocks worth of transactions to cache
 	TransactionsCacheSize int
 	// Number of block headers to cache
@@ -48,6 +61,8 @@ type EthClientConfig struct {
 	// and instead verify against the block-hash.
 	// Of real L1 blocks no deposits can be missed/faked, no batches can be missed/faked,
 	// only the wrong L1 blocks can be retrieved.
+	// If the RPC is trusted, fetched receipts are not verified against the receipts root either,
+	// see RPCReceiptsConfig.TrustRPC.
 	TrustRPC bool
 
 	// If the RPC must ensure that the results fit the ExecutionPayload(Header) format.
@@ -61,8 +76,31 @@ type EthClientConfig struct {
 	// Method reset duration defines how long we stick to available RPC methods,
 	// till we re-attempt the user-preferred methods.
 	// If this is 0 then the client does not fall back to less optimal but available methods.
+	// See DefaultMethodResetDuration for a default that suits the RPCProviderKind.
 	MethodResetDuration time.Duration
 
+	// [OPTIONAL] ReceiptsTracer records every RPC receipt fetching attempt, for offline analysis.
+	ReceiptsTracer ReceiptsTracer
+
+	// [OPTIONAL] ReceiptsMetrics meters which RPC receipt fetching methods are used and fallen back from.
+	ReceiptsMetrics ReceiptsMetrics
+
+	// [OPTIONAL] ReceiptsMethodPreference overrides the order in which the available RPC receipt fetching methods
+	// are preferred. By default the methods are picked by a heuristic based on the RPCProviderKind.
+	ReceiptsMethodPreference []ReceiptsFetchingMethod
+
+	// [OPTIONAL] ProbeReceiptMethods probes which of the RPC receipt fetching methods of the RPCProviderKind
+	// the RPC supports once, when the client is created, rather than discovering unsupported methods
+	// on the first fetch. See RPCReceiptsFetcher.ProbeMethods.
+	// A warning is logged if the RPC supports the methods of another provider kind, see DetectProviderKind.
+	ProbeReceiptMethods bool
+
+	// [OPTIONAL] VerifyContractAddresses verifies the contract address of the receipts of contract-creation
+	// transactions against the address derived from the sender and nonce of the transaction.
+	// This costs a signature recovery per contract-creation transaction, every time receipts are returned,
+	// also when they are cached. It is off by default, since the contract addresses are not used by derivation.
+	VerifyContractAddresses bool
+
 	// [OPTIONAL] The reth DB path to fetch receipts from.
 	// If it is specified, the rethdb receipts fetcher will be used
 	// and the RPC configuration parameters don't need to be set.
@@ -73,6 +111,12 @@ func (c *EthClientConfig) Check() error {
 	if c.ReceiptsCacheSize < 0 {
 		return fmt.Errorf("invalid receipts cache size: %d", c.ReceiptsCacheSize)
 	}
+	if c.ReceiptsCacheTTL < 0 {
+		return fmt.Errorf("invalid receipts cache TTL: %s", c.ReceiptsCacheTTL)
+	}
+	if c.ReceiptsCacheMaxBytes < 0 {
+		return fmt.Errorf("invalid receipts cache max bytes: %d", c.ReceiptsCacheMaxBytes)
+	}
 	if c.TransactionsCacheSize < 0 {
 		return fmt.Errorf("invalid transactions cache size: %d", c.TransactionsCacheSize)
 	}
@@ -94,6 +138,9 @@ func (c *EthClientConfig) Check() error {
 	if c.MaxConcurrentRequests < 1 {
 		return fmt.Errorf("expected at least 1 concurrent request, but max is %d", c.MaxConcurrentRequests)
 	}
+	if c.ReceiptsBatchConcurrency < 0 {
+		return fmt.Errorf("invalid receipts batch concurrency: %d", c.ReceiptsBatchConcurrency)
+	}
 	if c.MaxRequestsPerBatch < 1 {
 		return fmt.Errorf("expected at least 1 request per batch, but max is: %d", c.MaxRequestsPerBatch)
 	}
@@ -113,6 +160,8 @@ type EthClient struct {
 
 	mustBePostMerge bool
 
+	verifyContractAddresses bool
+
 	log log.Logger
 
 	// cache transactions in bundles per block hash
@@ -141,14 +190,15 @@ func NewEthClient(client client.RPC, log log.Logger, metrics caching.Metrics, co
 		return nil, fmt.Errorf("failed to open RethDB")
 	}
 	return &EthClient{
-		client:            client,
-		recProvider:       recProvider,
-		trustRPC:          config.TrustRPC,
-		mustBePostMerge:   config.MustBePostMerge,
-		log:               log,
-		transactionsCache: caching.NewLRUCache[common.Hash, types.Transactions](metrics, "txs", config.TransactionsCacheSize),
-		headersCache:      caching.NewLRUCache[common.Hash, eth.BlockInfo](metrics, "headers", config.HeadersCacheSize),
-		payloadsCache:     caching.NewLRUCache[common.Hash, *eth.ExecutionPayloadEnvelope](metrics, "payloads", config.PayloadsCacheSize),
+		client:                  client,
+		recProvider:             recProvider,
+		trustRPC:                config.TrustRPC,
+		mustBePostMerge:         config.MustBePostMerge,
+		verifyContractAddresses: config.VerifyContractAddresses,
+		log:                     log,
+		transactionsCache:       caching.NewLRUCache[common.Hash, types.Transactions](metrics, "txs", config.TransactionsCacheSize),
+		headersCache:            caching.NewLRUCache[common.Hash, eth.BlockInfo](metrics, "headers", config.HeadersCacheSize),
+		payloadsCache:           caching.NewLRUCache[common.Hash, *eth.ExecutionPayloadEnvelope](metrics, "payloads", config.PayloadsCacheSize),
 	}, nil
 }
 
@@ -325,6 +375,86 @@ func (s *EthClient) FetchReceipts(ctx context.Context, blockHash common.Hash) (e
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := s.checkContractAddresses(txs, receipts); err != nil {
+		return nil, nil, err
+	}
+	return info, receipts, nil
+}
+
+// checkContractAddresses verifies the contract addresses of the receipts, if configured to.
+func (s *EthClient) checkContractAddresses(txs types.Transactions, receipts types.Receipts) error {
+	if !s.verifyContractAddresses {
+		return nil
+	}
+	return validateContractAddresses(txs, receipts)
+}
+
+// FetchReceiptsAndTxs returns a block info and all of the receipts in the block, each paired with its transaction,
+// validated like FetchReceipts. The transactions are those of the block that is fetched to validate the receipts,
+// so no additional requests are made, regardless of the method the receipts are fetched with.
+func (s *EthClient) FetchReceiptsAndTxs(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, []ReceiptWithTx, error) {
+	info, txs, err := s.InfoAndTxsByHash(ctx, blockHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("querying block: %w", err)
+	}
+	receipts, err := s.recProvider.FetchReceipts(ctx, info, eth.TransactionsToHashes(txs))
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.checkContractAddresses(txs, receipts); err != nil {
+		return nil, nil, err
+	}
+	if len(receipts) != len(txs) {
+		return nil, nil, fmt.Errorf("got %d receipts but expected %d", len(receipts), len(txs))
+	}
+	out := make([]ReceiptWithTx, len(txs))
+	for i, tx := range txs {
+		if r := receipts[i]; r.TxHash != (common.Hash{}) && r.TxHash != tx.Hash() {
+			return nil, nil, fmt.Errorf("receipt %d is of tx %s, but expected tx %s", i, r.TxHash, tx.Hash())
+		}
+		out[i] = ReceiptWithTx{Receipt: receipts[i], Tx: tx}
+	}
+	return info, out, nil
+}
+
+// FetchReceiptsByLabel returns a block info and all of the receipts associated with transactions in the block
+// with the given label, e.g. the latest block, fetching the receipts by label rather than resolving the block first.
+// The receipts are validated against the header of the block they belong to, like FetchReceipts.
+// The receipts of a block without transactions cannot be attributed to a block, so for such a block
+// the block is resolved by label first, and its receipts are fetched by hash.
+func (s *EthClient) FetchReceiptsByLabel(ctx context.Context, label eth.BlockLabel) (eth.BlockInfo, types.Receipts, error) {
+	p, ok := s.recProvider.(ReceiptsByLabelProvider)
+	if !ok {
+		return nil, nil, fmt.Errorf("receipts provider %T does not support fetching receipts by label", s.recProvider)
+	}
+	block, receipts, err := p.FetchReceiptsByLabel(ctx, label)
+	if err != nil {
+		info, infoErr := s.InfoByLabel(ctx, label)
+		if infoErr != nil || info.ReceiptHash() != types.EmptyRootHash {
+			return nil, nil, fmt.Errorf("fetching receipts of %s block: %w", label, err)
+		}
+		return s.FetchReceipts(ctx, info.Hash())
+	}
+
+	info, txs, err := s.InfoAndTxsByHash(ctx, block.Hash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("querying block %s: %w", block, err)
+	}
+	if info.NumberU64() != block.Number {
+		return nil, nil, fmt.Errorf("receipts of block %s do not match the block number %d", block, info.NumberU64())
+	}
+	txHashes := eth.TransactionsToHashes(txs)
+	if s.trustRPC {
+		err = validateReceiptsCount(txHashes, receipts)
+	} else {
+		err = validateReceipts(block, info.ReceiptHash(), txHashes, receipts)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid receipts of %s block %s: %w", label, block, err)
+	}
+	if err := s.checkContractAddresses(txs, receipts); err != nil {
+		return nil, nil, err
+	}
 	return info, receipts, nil
 }
 
@@ -223,6 +223,84 @@ func TestReceiptValidation(t *testing.T) {
 	ethcl.recProvider = rp
 	ethcl.trustRPC = true
 
+	// the receipt is left empty by the mock, like a receipt that was not found
 	_, _, err := ethcl.FetchReceipts(ctx, block.Hash)
-	require.ErrorContains(err, "unexpected nil block number")
+	require.ErrorIs(err, ErrNilReceipt)
+}
+
+func TestEthClient_FetchReceiptsByLabel(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	for _, r := range receipts {
+		r.ContractAddress = common.Address{}
+	}
+	ctx := context.Background()
+
+	setup := func(kind RPCProviderKind, served types.Receipts) (*EthClient, *mockRPC) {
+		mrpc := new(mockRPC)
+		mrpc.On("CallContext", ctx, mock.Anything, "eth_getBlockReceipts", []any{"latest"}).
+			Run(func(args mock.Arguments) {
+				*(args[1].(*types.Receipts)) = served
+			}).
+			Return([]error{nil})
+		mrpc.On("CallContext", ctx, mock.Anything, "eth_getBlockByHash", []any{block.Hash, true}).
+			Run(func(args mock.Arguments) {
+				*(args[1].(**RPCBlock)) = block
+			}).
+			Return([]error{nil})
+		ethcl := newEthClientWithCaches(nil, 1)
+		ethcl.client = mrpc
+		ethcl.recProvider = NewCachingReceiptsProvider(NewRPCReceiptsFetcher(mrpc, nil, RPCReceiptsConfig{ProviderKind: kind}), nil, 1)
+		return ethcl, mrpc
+	}
+
+	ethcl, mrpc := setup(RPCKindStandard, receipts)
+	info, recs, err := ethcl.FetchReceiptsByLabel(ctx, eth.Unsafe)
+	require.NoError(t, err)
+	require.Equal(t, block.Hash, info.Hash())
+	require.Len(t, recs, len(receipts))
+	mrpc.AssertExpectations(t)
+
+	// the receipts are validated against the block they belong to
+	ethcl, _ = setup(RPCKindStandard, receipts[:3])
+	_, _, err = ethcl.FetchReceiptsByLabel(ctx, eth.Unsafe)
+	require.ErrorContains(t, err, "got 3 receipts but expected 4")
+
+	// methods that require a block hash are not used
+	rp := NewRPCReceiptsFetcher(new(mockRPC), nil, RPCReceiptsConfig{ProviderKind: RPCKindDebugGeth})
+	_, _, err = rp.FetchReceiptsByLabel(ctx, eth.Unsafe)
+	require.ErrorContains(t, err, "no receipt fetching method that accepts a block label is available")
+}
+
+func TestEthClient_FetchReceiptsAndTxs(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	for _, r := range receipts {
+		r.ContractAddress = common.Address{}
+	}
+	ctx := context.Background()
+
+	mrpc := new(mockRPC)
+	mrpc.On("CallContext", ctx, mock.Anything, "eth_getBlockReceipts", []any{block.Hash}).
+		Run(func(args mock.Arguments) {
+			*(args[1].(*types.Receipts)) = receipts
+		}).
+		Return([]error{nil})
+	mrpc.On("CallContext", ctx, mock.Anything, "eth_getBlockByHash", []any{block.Hash, true}).
+		Run(func(args mock.Arguments) {
+			*(args[1].(**RPCBlock)) = block
+		}).
+		Return([]error{nil}).
+		Once() // the transactions are those of the block that is fetched anyway
+	ethcl := newEthClientWithCaches(nil, 1)
+	ethcl.client = mrpc
+	ethcl.recProvider = NewCachingReceiptsProvider(NewRPCReceiptsFetcher(mrpc, nil, RPCReceiptsConfig{ProviderKind: RPCKindStandard}), nil, 1)
+
+	info, pairs, err := ethcl.FetchReceiptsAndTxs(ctx, block.Hash)
+	require.NoError(t, err)
+	require.Equal(t, block.Hash, info.Hash())
+	require.Len(t, pairs, len(receipts))
+	for i, pair := range pairs {
+		require.Equal(t, block.Transactions[i].Hash(), pair.Tx.Hash())
+		require.Equal(t, pair.Tx.Hash(), pair.Receipt.TxHash)
+	}
+	mrpc.AssertExpectations(t)
 }
@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
@@ -52,7 +52,7 @@ func L2ClientDefaultConfig(config *rollup.Config, trustRPC bool) *L2ClientConfig
 			TrustRPC:              trustRPC,
 			MustBePostMerge:       true,
 			RPCProviderKind:       RPCKindStandard,
-			MethodResetDuration:   time.Minute,
+			MethodResetDuration:   DefaultMethodResetDuration(RPCKindStandard),
 		},
 		// Not bounded by span, to cover find-sync-start range fully for speedy recovery after errors.
 		L2BlockRefsCacheSize: fullSpan,
@@ -92,6 +92,29 @@ func NewL2Client(client client.RPC, log log.Logger, metrics caching.Metrics, con
 	}, nil
 }
 
+// FetchL2Receipts returns a block info and all of the receipts associated with transactions in the block,
+// validated like FetchReceipts, preserving the OP-specific L1 fee fields of the receipts:
+// L1Fee, L1GasUsed, L1GasPrice and FeeScalar. Only methods that return receipts as JSON preserve these fields,
+// see L1FeeReceiptsMethods: debug_getRawReceipts is not used, and RethDB does not support it.
+func (s *L2Client) FetchL2Receipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error) {
+	p, ok := s.recProvider.(L2ReceiptsProvider)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: receipts provider %T", ErrL1FeeFieldsUnsupported, s.recProvider)
+	}
+	info, txs, err := s.InfoAndTxsByHash(ctx, blockHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("querying block: %w", err)
+	}
+	receipts, err := p.FetchL2Receipts(ctx, info, eth.TransactionsToHashes(txs))
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.checkContractAddresses(txs, receipts); err != nil {
+		return nil, nil, err
+	}
+	return info, receipts, nil
+}
+
 func (s *L2Client) RollupConfig() *rollup.Config {
 	return s.rollupCfg
 }
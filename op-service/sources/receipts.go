@@ -2,11 +2,14 @@ package sources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/trie"
 )
 
@@ -17,28 +20,176 @@ type ReceiptsProvider interface {
 	FetchReceipts(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (types.Receipts, error)
 }
 
+// ReceiptsByLabelProvider fetches the receipts of a block by label, e.g. the latest block,
+// without the block being resolved first.
+type ReceiptsByLabelProvider interface {
+	// FetchReceiptsByLabel returns the block, derived from the receipts, and the receipts of the block with the given label.
+	// The receipts are not validated, since the block is only known once the receipts are fetched.
+	FetchReceiptsByLabel(ctx context.Context, label eth.BlockLabel) (eth.BlockID, types.Receipts, error)
+}
+
+// L2ReceiptsProvider fetches the receipts of L2 blocks, preserving the OP-specific L1 fee fields of the receipts:
+// L1Fee, L1GasUsed, L1GasPrice and FeeScalar, which are not part of the consensus encoding of receipts.
+type L2ReceiptsProvider interface {
+	// FetchL2Receipts fetches and validates the receipts of the given block, like [ReceiptsProvider.FetchReceipts],
+	// but only with methods that preserve the L1 fee fields, see L1FeeReceiptsMethods.
+	// The fields are nil for deposit transactions, and FeeScalar is nil after the Ecotone upgrade.
+	FetchL2Receipts(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (types.Receipts, error)
+}
+
+// ReceiptWithTx pairs a receipt with the transaction it is the receipt of.
+type ReceiptWithTx struct {
+	Receipt *types.Receipt
+	Tx      *types.Transaction
+}
+
+// ErrTxCountMismatch is returned when the number of transaction hashes to fetch receipts for
+// does not match the transaction count expected for the block.
+var ErrTxCountMismatch = errors.New("transaction count mismatch")
+
+// ErrBlockPruned is returned when the receipts of a block cannot be fetched because the RPC node pruned them,
+// e.g. a non-archive node asked for an old block. Retrying with the same node will not help,
+// callers should route the request to an archive node instead.
+var ErrBlockPruned = errors.New("block pruned")
+
+// ErrReceiptCountMismatch is returned when the number of fetched receipts does not match the number of transactions.
+var ErrReceiptCountMismatch = errors.New("receipt count mismatch")
+
+// ErrMissingReceipt is returned when the receipts of a block-level receipt method do not include the receipt
+// of one of the requested transactions.
+var ErrMissingReceipt = errors.New("missing receipt")
+
+// ErrNilReceipt is returned when a fetched receipt is nil, e.g. when it disappeared in a reorg before it was retrieved.
+var ErrNilReceipt = errors.New("nil receipt")
+
+// ErrReceiptRootMismatch is returned when the fetched receipts do not form the receipts root of the block,
+// e.g. when the provider dropped or altered receipts, which is likely a bug of the receipt fetching method used.
+var ErrReceiptRootMismatch = errors.New("receipt root mismatch")
+
+// FetchReceiptsWithTxCount fetches receipts from the given provider, like [ReceiptsProvider.FetchReceipts],
+// but first checks that the number of given transaction hashes matches the expected transaction count of the block.
+// This catches callers passing a stale or incomplete list of transactions before any receipts are fetched.
+func FetchReceiptsWithTxCount(ctx context.Context, p ReceiptsProvider, blockInfo eth.BlockInfo, txHashes []common.Hash, expectedTxCount int) (types.Receipts, error) {
+	if len(txHashes) != expectedTxCount {
+		return nil, fmt.Errorf("%w: got %d transaction hashes for block %s, but expected %d",
+			ErrTxCountMismatch, len(txHashes), eth.ToBlockID(blockInfo), expectedTxCount)
+	}
+	return p.FetchReceipts(ctx, blockInfo, txHashes)
+}
+
+// ComputeReceiptsRoot computes the receipts root of a block from its receipts, in order of transaction index,
+// the same way fetched receipts are validated against the receipts root of the block header.
+func ComputeReceiptsRoot(receipts types.Receipts) common.Hash {
+	return types.DeriveSha(receipts, trie.NewStackTrie(nil))
+}
+
+// validateReceiptsCount validates that there is a receipt for each transaction.
+// This is the only validation of receipts fetched from a trusted RPC.
+func validateReceiptsCount(txHashes []common.Hash, receipts []*types.Receipt) error {
+	if len(receipts) != len(txHashes) {
+		return fmt.Errorf("%w: got %d receipts but expected %d", ErrReceiptCountMismatch, len(receipts), len(txHashes))
+	}
+	for i, r := range receipts {
+		if r == nil { // on reorgs or other cases the receipts may disappear before they can be retrieved.
+			return fmt.Errorf("%w: receipt of tx %d returns nil on retrieval", ErrNilReceipt, i)
+		}
+	}
+	return nil
+}
+
+// orderReceiptsByTxHash returns the receipts in the order of the given transaction hashes, matching them by
+// transaction hash, since some providers return the receipts of block-level methods in another order.
+// The receipts are returned as-is if they are in order already, or if they are not one per transaction,
+// which is left to validateReceiptsCount. It errors with ErrMissingReceipt if a requested transaction has no receipt.
+func orderReceiptsByTxHash(txHashes []common.Hash, receipts types.Receipts) (types.Receipts, error) {
+	if len(receipts) != len(txHashes) {
+		return receipts, nil
+	}
+	ordered := true
+	for i, r := range receipts {
+		if r == nil {
+			return receipts, nil
+		}
+		ordered = ordered && r.TxHash == txHashes[i]
+	}
+	if ordered {
+		return receipts, nil
+	}
+	byTxHash := make(map[common.Hash]*types.Receipt, len(receipts))
+	for _, r := range receipts {
+		byTxHash[r.TxHash] = r
+	}
+	out := make(types.Receipts, len(txHashes))
+	for i, txHash := range txHashes {
+		r, ok := byTxHash[txHash]
+		if !ok {
+			return nil, fmt.Errorf("%w: receipt of tx %d (%s) is not included", ErrMissingReceipt, i, txHash)
+		}
+		out[i] = r
+	}
+	return out, nil
+}
+
+// validateContractAddresses validates that the contract address of each receipt of a contract-creation transaction
+// matches the address derived from the sender and nonce of the transaction. The sender is recovered from the signature,
+// which makes this a more expensive check. Other receipts are not checked.
+// The receipts must be of the given transactions, as validated by validateReceipts.
+func validateContractAddresses(txs types.Transactions, receipts []*types.Receipt) error {
+	if len(receipts) != len(txs) {
+		return fmt.Errorf("got %d receipts but expected %d", len(receipts), len(txs))
+	}
+	for i, tx := range txs {
+		if tx.To() != nil {
+			continue
+		}
+		nonce := tx.Nonce()
+		if tx.IsDepositTx() {
+			// deposits use the nonce of the sender account at the time of inclusion, as recorded since Regolith
+			if receipts[i].DepositNonce == nil {
+				continue
+			}
+			nonce = *receipts[i].DepositNonce
+		}
+		from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			return fmt.Errorf("failed to recover sender of contract-creation tx %d (%s): %w", i, tx.Hash(), err)
+		}
+		if expected := crypto.CreateAddress(from, nonce); receipts[i].ContractAddress != expected {
+			return fmt.Errorf("receipt %d has unexpected contract address %s, expected %s", i, receipts[i].ContractAddress, expected)
+		}
+	}
+	return nil
+}
+
 // validateReceipts validates that the receipt contents are valid.
+// The receipts are sorted by tx index in place first, since some providers return them in another order,
+// e.g. sorted by tx hash. There must be exactly one receipt per tx index.
 // Warning: contractAddress is not verified, since it is a more expensive operation for data we do not use.
-// See go-ethereum/crypto.CreateAddress to verify contract deployment address data based on sender and tx nonce.
+// See validateContractAddresses to verify contract deployment address data based on sender and tx nonce.
 func validateReceipts(block eth.BlockID, receiptHash common.Hash, txHashes []common.Hash, receipts []*types.Receipt) error {
-	if len(receipts) != len(txHashes) {
-		return fmt.Errorf("got %d receipts but expected %d", len(receipts), len(txHashes))
+	if err := validateReceiptsCount(txHashes, receipts); err != nil {
+		return err
 	}
 	if len(txHashes) == 0 {
 		if receiptHash != types.EmptyRootHash {
 			return fmt.Errorf("no transactions, but got non-empty receipt trie root: %s", receiptHash)
 		}
 	}
+	sort.SliceStable(receipts, func(i, j int) bool {
+		return receipts[i].TransactionIndex < receipts[j].TransactionIndex
+	})
+	for i := 1; i < len(receipts); i++ {
+		if receipts[i].TransactionIndex == receipts[i-1].TransactionIndex {
+			return fmt.Errorf("got more than one receipt of tx %d", receipts[i].TransactionIndex)
+		}
+	}
 	// We don't trust the RPC to provide consistent cached receipt info that we use for critical rollup derivation work.
 	// Let's check everything quickly.
 	logIndex := uint(0)
 	cumulativeGas := uint64(0)
 	for i, r := range receipts {
-		if r == nil { // on reorgs or other cases the receipts may disappear before they can be retrieved.
-			return fmt.Errorf("receipt of tx %d returns nil on retrieval", i)
-		}
 		if r.TransactionIndex != uint(i) {
-			return fmt.Errorf("receipt %d has unexpected tx index %d", i, r.TransactionIndex)
+			return fmt.Errorf("missing receipt of tx %d, got receipt of tx %d instead", i, r.TransactionIndex)
 		}
 		if r.BlockNumber == nil {
 			return fmt.Errorf("receipt %d has unexpected nil block number, expected %d", i, block.Number)
@@ -82,10 +233,9 @@ func validateReceipts(block eth.BlockID, receiptHash common.Hash, txHashes []com
 
 	// Sanity-check: external L1-RPC sources are notorious for not returning all receipts,
 	// or returning them out-of-order. Verify the receipts against the expected receipt-hash.
-	hasher := trie.NewStackTrie(nil)
-	computed := types.DeriveSha(types.Receipts(receipts), hasher)
+	computed := ComputeReceiptsRoot(receipts)
 	if receiptHash != computed {
-		return fmt.Errorf("failed to fetch list of receipts: expected receipt root %s but computed %s from retrieved receipts", receiptHash, computed)
+		return fmt.Errorf("%w: failed to fetch list of receipts: expected receipt root %s but computed %s from retrieved receipts", ErrReceiptRootMismatch, receiptHash, computed)
 	}
 	return nil
 }
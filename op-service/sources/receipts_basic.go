@@ -2,7 +2,6 @@ package sources
 
 import (
 	"context"
-	"io"
 	"sync"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
@@ -21,6 +20,18 @@ type BasicRPCReceiptsFetcher struct {
 	// calls caches uncompleted batch calls
 	calls   map[common.Hash]*receiptsBatchCall
 	callsMu sync.Mutex
+
+	// onProgress is optional, and called with the progress of a batch call after each fetch of it
+	onProgress func(done, total int)
+
+	// batchConcurrency is the number of batches of a batch call that are fetched concurrently, at least 1
+	batchConcurrency int
+
+	// retryPolicy configures the retries of failed receipt requests of a batch call, see batching.RetryPolicy
+	retryPolicy batching.RetryPolicy
+
+	// nilReceiptRetries is the number of times the receipts that were not found are requested again
+	nilReceiptRetries int
 }
 
 func NewBasicRPCReceiptsFetcher(client rpcClient, maxBatchSize int) *BasicRPCReceiptsFetcher {
@@ -32,18 +43,26 @@ func NewBasicRPCReceiptsFetcher(client rpcClient, maxBatchSize int) *BasicRPCRec
 }
 
 // FetchReceipts fetches receipts for the given block and transaction hashes
-// it does not validate receipts, and expects the caller to do so
+// it does not validate receipts, and expects the caller to do so.
+// Receipts that are not found, e.g. during a shallow reorg, are nil in the result,
+// after requesting them again up to nilReceiptRetries times.
 func (f *BasicRPCReceiptsFetcher) FetchReceipts(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (types.Receipts, error) {
+	return f.fetchReceipts(ctx, blockInfo, txHashes, f.maxBatchSize)
+}
+
+// fetchReceipts is like FetchReceipts, but with the given batch size for a new batch call.
+// An uncompleted batch call of the block is continued with the batch size it was created with.
+func (f *BasicRPCReceiptsFetcher) fetchReceipts(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash, batchSize int) (types.Receipts, error) {
 	block := eth.ToBlockID(blockInfo)
-	call := f.getOrCreateBatchCall(block.Hash, txHashes)
+	call := f.getOrCreateBatchCall(block.Hash, txHashes, batchSize)
 
 	// Fetch all receipts
-	for {
-		if err := call.Fetch(ctx); err == io.EOF {
-			break
-		} else if err != nil {
-			return nil, err
-		}
+	var onFetch func()
+	if f.onProgress != nil {
+		onFetch = func() { f.onProgress(call.Progress()) }
+	}
+	if err := call.FetchAll(ctx, f.batchConcurrency, onFetch); err != nil {
+		return nil, err
 	}
 	res, err := call.Result()
 	if err != nil {
@@ -51,10 +70,46 @@ func (f *BasicRPCReceiptsFetcher) FetchReceipts(ctx context.Context, blockInfo e
 	}
 	// call successful, remove from cache
 	f.deleteBatchCall(block.Hash)
-	return res, nil
+	return f.refetchMissingReceipts(ctx, txHashes, res)
+}
+
+// refetchMissingReceipts requests the receipts that were not found again, in a single batch per attempt,
+// up to nilReceiptRetries times, and returns the receipts with the ones still missing set to nil.
+// A receipt that is not found is left zero by the request, see makeReceiptRequest.
+func (f *BasicRPCReceiptsFetcher) refetchMissingReceipts(ctx context.Context, txHashes []common.Hash, receipts types.Receipts) (types.Receipts, error) {
+	var missing []int
+	for i, r := range receipts {
+		if r == nil || r.TxHash == (common.Hash{}) {
+			receipts[i] = nil
+			missing = append(missing, i)
+		}
+	}
+	for attempt := 0; attempt < f.nilReceiptRetries && len(missing) > 0; attempt++ {
+		requests := make([]rpc.BatchElem, len(missing))
+		refetched := make([]*types.Receipt, len(missing))
+		for j, i := range missing {
+			refetched[j], requests[j] = makeReceiptRequest(txHashes[i])
+		}
+		if err := f.client.BatchCallContext(ctx, requests); err != nil {
+			return nil, err
+		}
+		var stillMissing []int
+		for j, i := range missing {
+			if requests[j].Error != nil {
+				return nil, requests[j].Error
+			}
+			if refetched[j].TxHash == (common.Hash{}) {
+				stillMissing = append(stillMissing, i)
+				continue
+			}
+			receipts[i] = refetched[j]
+		}
+		missing = stillMissing
+	}
+	return receipts, nil
 }
 
-func (f *BasicRPCReceiptsFetcher) getOrCreateBatchCall(blockHash common.Hash, txHashes []common.Hash) *receiptsBatchCall {
+func (f *BasicRPCReceiptsFetcher) getOrCreateBatchCall(blockHash common.Hash, txHashes []common.Hash, batchSize int) *receiptsBatchCall {
 	f.callsMu.Lock()
 	defer f.callsMu.Unlock()
 	if call, ok := f.calls[blockHash]; ok {
@@ -65,8 +120,9 @@ func (f *BasicRPCReceiptsFetcher) getOrCreateBatchCall(blockHash common.Hash, tx
 		makeReceiptRequest,
 		f.client.BatchCallContext,
 		f.client.CallContext,
-		f.maxBatchSize,
+		batchSize,
 	)
+	call.SetRetryPolicy(f.retryPolicy)
 	f.calls[blockHash] = call
 	return call
 }
@@ -82,6 +138,6 @@ func makeReceiptRequest(txHash common.Hash) (*types.Receipt, rpc.BatchElem) {
 	return out, rpc.BatchElem{
 		Method: "eth_getTransactionReceipt",
 		Args:   []any{txHash},
-		Result: &out, // receipt may become nil, double pointer is intentional
+		Result: &out, // receipt may become nil, double pointer is intentional: out is left zero if not found
 	}
 }
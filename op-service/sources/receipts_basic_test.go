@@ -176,3 +176,69 @@ func receiptTxHashes(receipts types.Receipts) []common.Hash {
 	}
 	return txHashes
 }
+
+func TestBasicRPCReceiptsFetcher_NilReceiptRetries(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	txHashes := receiptTxHashes(receipts)
+	recMap := make(map[common.Hash]*types.Receipt, len(receipts))
+	for _, rec := range receipts {
+		recMap[rec.TxHash] = rec
+	}
+	bInfo, _, _ := block.Info(true, true)
+	ctx := context.Background()
+
+	// newMockRPC returns a RPC which does not find the receipt of tx 2 for the given number of requests of it
+	newMockRPC := func(notFound int) (*simpleMockRPC, *[][]common.Hash) {
+		var requested [][]common.Hash
+		mrpc := &simpleMockRPC{batchCallFn: func(_ context.Context, b []rpc.BatchElem) error {
+			var hashes []common.Hash
+			for _, el := range b {
+				txHash := el.Args[0].(common.Hash)
+				hashes = append(hashes, txHash)
+				if txHash == txHashes[2] && notFound > 0 {
+					notFound--
+					// like a null result, which leaves the allocated receipt untouched
+					*(el.Result.(**types.Receipt)) = nil
+					continue
+				}
+				**(el.Result.(**types.Receipt)) = *recMap[txHash]
+			}
+			requested = append(requested, hashes)
+			return nil
+		}}
+		return mrpc, &requested
+	}
+
+	t.Run("Refetched", func(t *testing.T) {
+		mrpc, requested := newMockRPC(2)
+		rp := NewBasicRPCReceiptsFetcher(mrpc, 4)
+		rp.nilReceiptRetries = 2
+		recs, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+		require.NoError(t, err)
+		for i, rec := range recs {
+			requireEqualReceipt(t, receipts[i], rec)
+		}
+		// only the missing receipt is requested again
+		require.Equal(t, [][]common.Hash{txHashes, {txHashes[2]}, {txHashes[2]}}, *requested)
+	})
+
+	t.Run("RetriesExhausted", func(t *testing.T) {
+		mrpc, requested := newMockRPC(3)
+		rp := NewBasicRPCReceiptsFetcher(mrpc, 4)
+		rp.nilReceiptRetries = 2
+		recs, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+		require.NoError(t, err)
+		require.Nil(t, recs[2])
+		require.Len(t, *requested, 3)
+		require.ErrorIs(t, validateReceipts(block.BlockID(), bInfo.ReceiptHash(), txHashes, recs), ErrNilReceipt)
+	})
+
+	t.Run("NoRetries", func(t *testing.T) {
+		mrpc, requested := newMockRPC(1)
+		rp := NewBasicRPCReceiptsFetcher(mrpc, 4)
+		recs, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+		require.NoError(t, err)
+		require.Nil(t, recs[2])
+		require.Len(t, *requested, 1)
+	})
+}
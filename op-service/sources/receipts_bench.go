@@ -0,0 +1,111 @@
+package sources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ReceiptsBenchResult is the outcome of fetching the receipts of a range of blocks with a single receipt method.
+type ReceiptsBenchResult struct {
+	Method ReceiptsFetchingMethod
+	// Blocks and Receipts count the blocks whose receipts were fetched, and their receipts.
+	Blocks   int
+	Receipts int
+	// Errors counts the blocks whose receipts failed to be fetched, FirstErr is the first of these errors.
+	Errors   int
+	FirstErr error
+	// PerTxFallbacks counts the blocks the method returned no receipts for, which were fetched per transaction instead.
+	PerTxFallbacks int
+	// Unsupported is set if the RPC does not implement the method, in which case the remaining blocks are skipped.
+	Unsupported bool
+	// Duration is the time spent fetching, including the failed fetches.
+	Duration time.Duration
+}
+
+// BlocksPerSecond returns the number of blocks whose receipts were fetched per second.
+func (r ReceiptsBenchResult) BlocksPerSecond() float64 {
+	return perSecond(r.Blocks, r.Duration)
+}
+
+// ReceiptsPerSecond returns the number of receipts fetched per second.
+func (r ReceiptsBenchResult) ReceiptsPerSecond() float64 {
+	return perSecond(r.Receipts, r.Duration)
+}
+
+// ErrorRate returns the fraction of the attempted blocks whose receipts failed to be fetched.
+func (r ReceiptsBenchResult) ErrorRate() float64 {
+	if attempted := r.Blocks + r.Errors; attempted > 0 {
+		return float64(r.Errors) / float64(attempted)
+	}
+	return 0
+}
+
+func perSecond(n int, d time.Duration) float64 {
+	if d <= 0 {
+		return 0
+	}
+	return float64(n) / d.Seconds()
+}
+
+// benchTracer counts the per-tx fallbacks of receipt fetches, and forwards the records to the configured tracer, if any.
+type benchTracer struct {
+	inner          ReceiptsTracer
+	perTxFallbacks int
+}
+
+func (t *benchTracer) TraceReceiptsFetch(rec ReceiptsTraceRecord) {
+	if rec.PerTxFallback {
+		t.perTxFallbacks++
+	}
+	if t.inner != nil {
+		t.inner.TraceReceiptsFetch(rec)
+	}
+}
+
+// BenchReceiptsMethods fetches the receipts of the given blocks with each receipt method available for the provider kind
+// of the config, one method after the other, to compare their throughput and error rates on an RPC provider.
+// The receipts are fetched and validated like FetchReceipts does, but always with the benchmarked method,
+// without falling back to other methods, except to per-tx fetching of blocks the method returned no receipts for.
+// Each method is benchmarked with a fetcher of its own, so methods do not affect each other.
+// An error is only returned if ctx is done, failed fetches are counted in the results instead.
+func BenchReceiptsMethods(ctx context.Context, client rpcClient, log log.Logger, config RPCReceiptsConfig, blocks []BlockReceiptsRequest) ([]ReceiptsBenchResult, error) {
+	methods := AvailableReceiptsFetchingMethods(config.ProviderKind).Methods()
+	results := make([]ReceiptsBenchResult, 0, len(methods))
+	for _, m := range methods {
+		tracer := &benchTracer{inner: config.Tracer}
+		methodConfig := config
+		methodConfig.Tracer = tracer
+		fetcher := NewRPCReceiptsFetcher(client, log, methodConfig)
+
+		result := ReceiptsBenchResult{Method: m}
+		start := time.Now()
+		for _, req := range blocks {
+			receipts, err := fetcher.fetchReceiptsWithMethod(ctx, m, req.Info, req.TxHashes)
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("benchmarking %s: %w", m, ctx.Err())
+			}
+			if err != nil {
+				result.Errors++
+				if result.FirstErr == nil {
+					result.FirstErr = err
+				}
+				if methodNotFound(err) {
+					result.Unsupported = true
+					break
+				}
+				continue
+			}
+			result.Blocks++
+			result.Receipts += len(receipts)
+		}
+		result.Duration = time.Since(start)
+		result.PerTxFallbacks = tracer.perTxFallbacks
+		log.Info("benchmarked receipt fetching method", "method", m, "blocks", result.Blocks, "receipts", result.Receipts,
+			"errors", result.Errors, "duration", result.Duration)
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,59 @@
+package sources
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/sourcestest"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestBenchReceiptsMethods(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	rpc := sourcestest.NewRPC()
+	var blocks []BlockReceiptsRequest
+	var receiptCount int
+	for i := 0; i < 3; i++ {
+		block, receipts := sourcestest.RandomBlockAndReceipts(rng, uint64(2+i))
+		rpc.AddBlock(eth.ToBlockID(block), receipts)
+		blocks = append(blocks, BlockReceiptsRequest{Info: block, TxHashes: sourcestest.TxHashes(receipts)})
+		receiptCount += len(receipts)
+	}
+	rpc.SetError("alchemy_getTransactionReceipts", &sourcestest.MethodNotFoundError{Method: "alchemy_getTransactionReceipts"})
+
+	results, err := BenchReceiptsMethods(context.Background(), rpc, testlog.Logger(t, log.LevelInfo), RPCReceiptsConfig{
+		MaxBatchSize: 2,
+		ProviderKind: RPCKindAny,
+	}, blocks)
+	require.NoError(t, err)
+	require.Len(t, results, len(AvailableReceiptsFetchingMethods(RPCKindAny).Methods()))
+	for _, result := range results {
+		if result.Method == AlchemyGetTransactionReceipts {
+			// the remaining blocks are skipped once the method is known to be unsupported
+			require.True(t, result.Unsupported)
+			require.Equal(t, 1, result.Errors)
+			require.Zero(t, result.Blocks)
+			require.Equal(t, 1.0, result.ErrorRate())
+			continue
+		}
+		require.NoError(t, result.FirstErr, result.Method.String())
+		require.False(t, result.Unsupported)
+		require.Equal(t, len(blocks), result.Blocks, result.Method.String())
+		require.Equal(t, receiptCount, result.Receipts, result.Method.String())
+		require.Zero(t, result.ErrorRate())
+		require.Positive(t, result.ReceiptsPerSecond())
+	}
+	// each method fetched every block, except the unsupported one
+	require.Equal(t, len(blocks), rpc.Calls("debug_getRawReceipts"))
+	require.Equal(t, 1, rpc.Calls("alchemy_getTransactionReceipts"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = BenchReceiptsMethods(ctx, rpc, testlog.Logger(t, log.LevelInfo), RPCReceiptsConfig{MaxBatchSize: 2, ProviderKind: RPCKindBasic}, blocks)
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -2,85 +2,327 @@ package sources
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"golang.org/x/sync/singleflight"
 )
 
+// ReceiptsFetchLabel labels the metrics of receipt fetches through a CachingReceiptsProvider:
+// a lookup per FetchReceipts call, hitting the cache or not, and the duration of the inner fetch on a miss.
+// Unlike the "receipts" label of the lookups of the cache itself, it does not include other lookups,
+// like those of CachedReceipts, so it can be used to compute the receipts-cache hit ratio of fetches.
+const ReceiptsFetchLabel = "receipts_fetch"
+
 // A CachingReceiptsProvider caches successful receipt fetches from the inner
-// ReceiptsProvider. It also avoids duplicate in-flight requests per block hash.
+// ReceiptsProvider. It also coalesces concurrent requests per block hash into a single in-flight request.
+// With a cache size of 0 caching is disabled: fetches pass straight through to the inner ReceiptsProvider.
 type CachingReceiptsProvider struct {
 	inner ReceiptsProvider
+	// cache is nil if caching is disabled
 	cache *caching.LRUCache[common.Hash, types.Receipts]
+	m     caching.Metrics
+
+	// fetching coalesces concurrent fetches of the same block hash
+	fetching singleflight.Group
 
-	// lock fetching process for each block hash to avoid duplicate requests
-	fetching   map[common.Hash]*sync.Mutex
-	fetchingMu sync.Mutex // only protects map
+	// hints of upcoming blocks to prefetch the receipts of, see receipts_prefetch.go
+	hints *receiptsHints
+
+	// numbers indexes the cached receipts by block number, see CachedReceiptsByNumber
+	numbers *receiptsNumberIndex
 }
 
-func NewCachingReceiptsProvider(inner ReceiptsProvider, m caching.Metrics, cacheSize int) *CachingReceiptsProvider {
-	return &CachingReceiptsProvider{
-		inner:    inner,
-		cache:    caching.NewLRUCache[common.Hash, types.Receipts](m, "receipts", cacheSize),
-		fetching: make(map[common.Hash]*sync.Mutex),
+// ReceiptsCost approximates the memory used by the given receipts in bytes,
+// from the number of receipts and the size of their logs. It is used to bound the receipts cache by size.
+func ReceiptsCost(receipts types.Receipts) int {
+	cost := 0
+	for _, r := range receipts {
+		cost += int(r.Size())
 	}
+	return cost
+}
+
+// WithFinalizedReceipts exempts the cached receipts of finalized blocks from the TTL of the receipts cache, so they
+// are kept until evicted by the size of the cache, while the receipts of unfinalized blocks still expire with the TTL,
+// e.g. to not keep serving the receipts of a block that may have been reorged out by number for long.
+// isFinalized reports whether the block of the given number is finalized, and is only called for receipts that are
+// past the TTL. It has no effect without a TTL, see caching.WithTTL. Blocks without receipts are never exempt,
+// since their number is not known from their receipts, but blocks without transactions are known to have no receipts,
+// so they are not fetched again once expired.
+func WithFinalizedReceipts(isFinalized func(number uint64) bool) caching.Option {
+	return caching.WithExpiryExemption(func(_ common.Hash, receipts types.Receipts) bool {
+		return len(receipts) > 0 && receipts[0].BlockNumber != nil && isFinalized(receipts[0].BlockNumber.Uint64())
+	})
 }
 
-func NewCachingRPCReceiptsProvider(client rpcClient, log log.Logger, config RPCReceiptsConfig, m caching.Metrics, cacheSize int) *CachingReceiptsProvider {
-	return NewCachingReceiptsProvider(NewRPCReceiptsFetcher(client, log, config), m, cacheSize)
+// receiptsCacheOptions returns the receipts cache options of the given config.
+func receiptsCacheOptions(config *EthClientConfig) []caching.Option {
+	opts := []caching.Option{
+		caching.WithTTL(config.ReceiptsCacheTTL),
+		caching.WithMaxCost(config.ReceiptsCacheMaxBytes, ReceiptsCost),
+	}
+	if config.ReceiptsFinalized != nil {
+		opts = append(opts, WithFinalizedReceipts(config.ReceiptsFinalized))
+	}
+	return opts
 }
 
-func (p *CachingReceiptsProvider) getOrCreateFetchingLock(blockHash common.Hash) *sync.Mutex {
-	p.fetchingMu.Lock()
-	defer p.fetchingMu.Unlock()
-	if mu, ok := p.fetching[blockHash]; ok {
-		return mu
+func NewCachingReceiptsProvider(inner ReceiptsProvider, m caching.Metrics, cacheSize int, opts ...caching.Option) *CachingReceiptsProvider {
+	p := &CachingReceiptsProvider{
+		inner:   inner,
+		m:       m,
+		hints:   newReceiptsHints(cacheSize),
+		numbers: newReceiptsNumberIndex(cacheSize),
+	}
+	if cacheSize > 0 {
+		p.cache = caching.NewLRUCache[common.Hash, types.Receipts](m, "receipts", cacheSize, opts...)
 	}
-	mu := new(sync.Mutex)
-	p.fetching[blockHash] = mu
-	return mu
+	return p
 }
 
-func (p *CachingReceiptsProvider) deleteFetchingLock(blockHash common.Hash) {
-	p.fetchingMu.Lock()
-	defer p.fetchingMu.Unlock()
-	delete(p.fetching, blockHash)
+func NewCachingRPCReceiptsProvider(client rpcClient, log log.Logger, config RPCReceiptsConfig, m caching.Metrics, cacheSize int, opts ...caching.Option) *CachingReceiptsProvider {
+	return NewCachingReceiptsProvider(NewRPCReceiptsFetcher(client, log, config), m, cacheSize, opts...)
 }
 
 // FetchReceipts fetches receipts for the given block and transaction hashes
-// it expects that the inner FetchReceipts implementation handles validation
+// it expects that the inner FetchReceipts implementation handles validation.
+// Concurrent calls for the same block hash share a single fetch of the inner provider, and its result or error.
+// The shared fetch runs with the context of the call that started it, so it is aborted if that call is cancelled.
+// The calls that were waiting on an aborted fetch then fetch again, unless their own context is done too.
+// If caching is disabled, the receipts are fetched from the inner provider directly.
 func (p *CachingReceiptsProvider) FetchReceipts(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (types.Receipts, error) {
+	if p.cache == nil {
+		return p.inner.FetchReceipts(ctx, blockInfo, txHashes)
+	}
 	block := eth.ToBlockID(blockInfo)
-	if r, ok := p.cache.Get(block.Hash); ok {
+	r, ok := p.cache.Get(block.Hash)
+	if p.m != nil {
+		p.m.CacheGet(ReceiptsFetchLabel, ok)
+	}
+	if ok {
 		return r, nil
 	}
 
-	mu := p.getOrCreateFetchingLock(block.Hash)
-	mu.Lock()
-	defer mu.Unlock()
-	// Other routine might have fetched in the meantime
-	if r, ok := p.cache.Get(block.Hash); ok {
-		// we might have created a new lock above while the old
-		// fetching job completed.
-		p.deleteFetchingLock(block.Hash)
-		return r, nil
+	for {
+		v, err, _ := p.fetching.Do(string(block.Hash[:]), func() (any, error) {
+			// Another fetch might have completed in the meantime
+			if r, ok := p.cache.Get(block.Hash); ok {
+				return r, nil
+			}
+			r, err := p.fetchUncached(ctx, blockInfo, txHashes)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, &abortedFetchError{err: err}
+				}
+				return nil, err
+			}
+			p.cache.Add(block.Hash, r)
+			p.numbers.add(block)
+			return r, nil
+		})
+		var aborted *abortedFetchError
+		if errors.As(err, &aborted) {
+			if ctx.Err() == nil {
+				// the fetch was aborted by the context of the call that started it, not by ours
+				continue
+			}
+			return nil, aborted.err
+		}
+		if err != nil {
+			return nil, err
+		}
+		return v.(types.Receipts), nil
 	}
+}
+
+// abortedFetchError is the error of a shared fetch that was aborted because the context of the call that
+// started it is done. It is not returned to the caller, see FetchReceipts.
+type abortedFetchError struct {
+	err error
+}
+
+func (e *abortedFetchError) Error() string {
+	return e.err.Error()
+}
+
+func (e *abortedFetchError) Unwrap() error {
+	return e.err
+}
 
+// FetchReceiptsUncached fetches the receipts of the given block from the inner provider, bypassing the cache,
+// and replaces the cached receipts of the block, if any, with the result. Like FetchReceipts, it expects the inner
+// provider to validate the receipts. This is a safety valve to recover from cached receipts of an orphaned view
+// of the block, e.g. after a reorg. Subsequent FetchReceipts calls do not share fetches of the block that are
+// in-flight already, but such a fetch may still cache its result after this call completed.
+func (p *CachingReceiptsProvider) FetchReceiptsUncached(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (types.Receipts, error) {
+	if p.cache == nil {
+		return p.inner.FetchReceipts(ctx, blockInfo, txHashes)
+	}
+	block := eth.ToBlockID(blockInfo)
+	p.fetching.Forget(string(block.Hash[:]))
 	r, err := p.inner.FetchReceipts(ctx, blockInfo, txHashes)
 	if err != nil {
 		return nil, err
 	}
-
+	if r == nil {
+		r = types.Receipts{}
+	}
 	p.cache.Add(block.Hash, r)
-	// result now in cache, can delete fetching lock
-	p.deleteFetchingLock(block.Hash)
+	p.numbers.add(block)
+	return r, nil
+}
+
+// fetchUncached fetches the receipts of a block that is not cached.
+func (p *CachingReceiptsProvider) fetchUncached(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (types.Receipts, error) {
+	if len(txHashes) == 0 && blockInfo.ReceiptHash() == types.EmptyRootHash {
+		// A block without transactions is known to have no receipts, as validated by validateReceipts,
+		// so there is nothing to fetch. This avoids a round-trip per block during quiet periods.
+		return types.Receipts{}, nil
+	}
+	start := time.Now()
+	r, err := p.inner.FetchReceipts(ctx, blockInfo, txHashes)
+	if m, ok := p.m.(caching.FetchMetrics); ok {
+		m.CacheFetch(ReceiptsFetchLabel, time.Since(start))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		// cache empty results explicitly, so they are not mistaken for a miss
+		r = types.Receipts{}
+	}
 	return r, nil
 }
 
+// FetchReceiptsByLabel fetches the receipts of the block with the given label from the inner provider, if supported.
+// The receipts are not cached, since they are not validated yet.
+func (p *CachingReceiptsProvider) FetchReceiptsByLabel(ctx context.Context, label eth.BlockLabel) (eth.BlockID, types.Receipts, error) {
+	inner, ok := p.inner.(ReceiptsByLabelProvider)
+	if !ok {
+		return eth.BlockID{}, nil, fmt.Errorf("receipts provider %T does not support fetching receipts by label", p.inner)
+	}
+	return inner.FetchReceiptsByLabel(ctx, label)
+}
+
+// FetchL2Receipts fetches the receipts of the given L2 block from the inner provider, if supported,
+// preserving the L1 fee fields of the receipts. The cache is bypassed, since cached receipts may have been
+// fetched with a method that does not preserve the L1 fee fields, and the result is not cached either.
+func (p *CachingReceiptsProvider) FetchL2Receipts(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (types.Receipts, error) {
+	inner, ok := p.inner.(L2ReceiptsProvider)
+	if !ok {
+		return nil, fmt.Errorf("%w: receipts provider %T", ErrL1FeeFieldsUnsupported, p.inner)
+	}
+	return inner.FetchL2Receipts(ctx, blockInfo, txHashes)
+}
+
+// CachedReceipts returns the cached receipts for the given block hash, if any, without fetching.
+// It is safe to call concurrently with FetchReceipts: receipts are only added to the cache once
+// they are fully fetched and validated, so a read observes either the complete receipts or a miss.
+// The receipts of empty blocks are cached too, as an empty, non-nil, list of receipts.
+// The returned receipts are shared with the cache and must not be modified.
+// It always misses if caching is disabled.
+func (p *CachingReceiptsProvider) CachedReceipts(blockHash common.Hash) (types.Receipts, bool) {
+	if p.cache == nil {
+		return nil, false
+	}
+	return p.cache.Get(blockHash)
+}
+
+// CachedReceiptsByNumber returns the cached receipts of the block with the given number, if any, without fetching.
+// It only answers for numbers at which the receipts of a single block were cached: if the receipts of competing
+// blocks were cached at the same number, the number is not answered anymore, see receiptsNumberIndex.
+// The returned receipts are shared with the cache and must not be modified.
+func (p *CachingReceiptsProvider) CachedReceiptsByNumber(number uint64) (types.Receipts, bool) {
+	if p.cache == nil {
+		return nil, false
+	}
+	hash, ok := p.numbers.get(number)
+	if !ok {
+		return nil, false
+	}
+	return p.cache.Get(hash)
+}
+
 func (p *CachingReceiptsProvider) isInnerNil() bool {
 	return p.inner == nil
 }
+
+// receiptsNumberIndex maps block numbers to the hash of the block whose receipts were cached at that number.
+//
+// The cache only sees the blocks it fetches receipts for, not the canonical chain, so a reorg shows as the
+// receipts of a competing block being cached at a number that is indexed already. At that point it is unknown
+// which of the two blocks is canonical: fetches may complete out of order, e.g. a prefetch of the reorged-out block
+// may complete after the fetch of the new canonical block. So rather than trusting the latest fetch, the number
+// is marked as conflicted and is not answered anymore, until it is pruned from the index.
+// All higher numbers are dropped as well, since they were indexed before the reorg was seen, and may be
+// descendants of the reorged-out block. Lookups by hash are unaffected, and a miss is always safe:
+// the receipts are then fetched by hash.
+type receiptsNumberIndex struct {
+	mu sync.Mutex
+	// hashes maps numbers to block hashes, the zero hash marks a conflicted number
+	hashes map[uint64]common.Hash
+	// maxSize bounds the index to the cache capacity, pruning the lowest numbers first
+	maxSize int
+}
+
+func newReceiptsNumberIndex(maxSize int) *receiptsNumberIndex {
+	return &receiptsNumberIndex{
+		hashes:  make(map[uint64]common.Hash),
+		maxSize: maxSize,
+	}
+}
+
+func (idx *receiptsNumberIndex) add(block eth.BlockID) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	if existing, ok := idx.hashes[block.Number]; ok {
+		if existing == block.Hash || existing == (common.Hash{}) {
+			return
+		}
+		for num := range idx.hashes {
+			if num > block.Number {
+				delete(idx.hashes, num)
+			}
+		}
+		idx.hashes[block.Number] = common.Hash{}
+		return
+	}
+	idx.hashes[block.Number] = block.Hash
+	if len(idx.hashes) > idx.maxSize {
+		lowest := block.Number
+		for num := range idx.hashes {
+			lowest = min(lowest, num)
+		}
+		delete(idx.hashes, lowest)
+	}
+}
+
+// number returns the number the given block hash is indexed at, if any.
+func (idx *receiptsNumberIndex) number(hash common.Hash) (uint64, bool) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for number, h := range idx.hashes {
+		if h == hash {
+			return number, true
+		}
+	}
+	return 0, false
+}
+
+func (idx *receiptsNumberIndex) get(number uint64) (common.Hash, bool) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	hash, ok := idx.hashes[number]
+	if !ok || hash == (common.Hash{}) {
+		return common.Hash{}, false
+	}
+	return hash, true
+}
@@ -2,11 +2,15 @@ package sources
 
 import (
 	"context"
+	"errors"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/mock"
@@ -62,3 +66,421 @@ func TestCachingReceiptsProvider_Concurrency(t *testing.T) {
 
 	mrp.AssertExpectations(t)
 }
+
+func TestCachingReceiptsProvider_CachedReceiptsConcurrency(t *testing.T) {
+	const numBlocks = 16
+	rng := rand.New(rand.NewSource(69))
+	mrp := new(mockReceiptsProvider)
+	rp := NewCachingReceiptsProvider(mrp, nil, numBlocks)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	blocks := make([]*RPCBlock, numBlocks)
+	blockReceipts := make([]types.Receipts, numBlocks)
+	for i := range blocks {
+		block, receipts := randomRpcBlockAndReceipts(rng, 4)
+		blocks[i], blockReceipts[i] = block, receipts
+		mrp.On("FetchReceipts", mock.Anything, block.BlockID(), receiptTxHashes(receipts)).
+			Return(blockReceipts[i], error(nil)).
+			Once()
+	}
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	// readers hammer the direct cache accessor while the cache is being populated
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				for i, block := range blocks {
+					if recs, ok := rp.CachedReceipts(block.Hash); ok {
+						// a read is never torn: it is either a miss or the complete receipts
+						require.Len(t, recs, len(blockReceipts[i]))
+						for j := range recs {
+							require.Equal(t, blockReceipts[i][j].TxHash, recs[j].TxHash)
+						}
+					}
+				}
+			}
+		}()
+	}
+
+	var fetchers sync.WaitGroup
+	for i := range blocks {
+		fetchers.Add(1)
+		go func(i int) {
+			defer fetchers.Done()
+			bInfo, _, _ := blocks[i].Info(true, true)
+			_, err := rp.FetchReceipts(ctx, bInfo, receiptTxHashes(blockReceipts[i]))
+			require.NoError(t, err)
+		}(i)
+	}
+	fetchers.Wait()
+	close(stop)
+	wg.Wait()
+
+	for i, block := range blocks {
+		recs, ok := rp.CachedReceipts(block.Hash)
+		require.True(t, ok)
+		require.Equal(t, blockReceipts[i], recs)
+	}
+	mrp.AssertExpectations(t)
+}
+
+func TestCachingReceiptsProvider_CachedReceiptsByNumber(t *testing.T) {
+	mrp := new(mockReceiptsProvider)
+	mrp.On("FetchReceipts", mock.Anything, mock.Anything, mock.Anything).Return(types.Receipts{}, error(nil))
+	rp := NewCachingReceiptsProvider(mrp, nil, 3)
+	ctx := context.Background()
+	fetch := func(num uint64, hash byte) {
+		_, err := rp.FetchReceipts(ctx, &testutils.MockBlockInfo{InfoNum: num, InfoHash: common.Hash{hash}}, nil)
+		require.NoError(t, err)
+	}
+	requireByNumber := func(num uint64, expected bool) {
+		_, ok := rp.CachedReceiptsByNumber(num)
+		require.Equal(t, expected, ok, "block %d", num)
+	}
+
+	fetch(10, 0xa)
+	fetch(11, 0xb)
+	requireByNumber(10, true)
+	requireByNumber(11, true)
+	requireByNumber(12, false)
+
+	// a competing block at the same number invalidates the number and everything after it
+	fetch(10, 0xc)
+	requireByNumber(10, false)
+	requireByNumber(11, false)
+	_, ok := rp.CachedReceipts(common.Hash{0xc})
+	require.True(t, ok)
+
+	// the number stays invalidated, regardless of which block completes last
+	fetch(10, 0xa)
+	requireByNumber(10, false)
+
+	// the index is bounded by the cache size, pruning the lowest numbers first
+	fetch(12, 0xd)
+	fetch(13, 0xe)
+	requireByNumber(12, true)
+	requireByNumber(13, true)
+	fetch(14, 0xf)
+	require.NotContains(t, rp.numbers.hashes, uint64(10))
+	requireByNumber(14, true)
+}
+
+func TestCachingReceiptsProvider_EmptyBlock(t *testing.T) {
+	mrp := new(mockReceiptsProvider)
+	rp := NewCachingReceiptsProvider(mrp, nil, 2)
+	ctx := context.Background()
+
+	// a block without transactions is cached without fetching its receipts
+	empty := &testutils.MockBlockInfo{InfoNum: 10, InfoHash: common.Hash{0xa}, InfoReceiptRoot: types.EmptyRootHash}
+	recs, err := rp.FetchReceipts(ctx, empty, nil)
+	require.NoError(t, err)
+	require.NotNil(t, recs)
+	require.Empty(t, recs)
+	recs, ok := rp.CachedReceipts(empty.InfoHash)
+	require.True(t, ok)
+	require.Equal(t, types.Receipts{}, recs)
+
+	// an empty result of the inner provider is cached as empty receipts too
+	other := &testutils.MockBlockInfo{InfoNum: 11, InfoHash: common.Hash{0xb}}
+	mrp.On("FetchReceipts", ctx, eth.ToBlockID(other), []common.Hash(nil)).Return(types.Receipts(nil), error(nil)).Once()
+	_, err = rp.FetchReceipts(ctx, other, nil)
+	require.NoError(t, err)
+	recs, ok = rp.CachedReceipts(other.InfoHash)
+	require.True(t, ok)
+	require.Equal(t, types.Receipts{}, recs)
+	mrp.AssertExpectations(t)
+}
+
+func TestCachingReceiptsProvider_TTL(t *testing.T) {
+	const ttl = 100 * time.Millisecond
+	mrp := new(mockReceiptsProvider)
+	rp := NewCachingReceiptsProvider(mrp, nil, 1, caching.WithTTL(ttl))
+	ctx := context.Background()
+	bInfo := &testutils.MockBlockInfo{InfoNum: 10, InfoHash: common.Hash{0xa}}
+	mrp.On("FetchReceipts", ctx, eth.ToBlockID(bInfo), []common.Hash(nil)).Return(types.Receipts{}, error(nil)).Twice()
+
+	_, err := rp.FetchReceipts(ctx, bInfo, nil)
+	require.NoError(t, err)
+	_, ok := rp.CachedReceipts(bInfo.InfoHash)
+	require.True(t, ok)
+
+	// expired receipts are a miss, and are fetched again
+	time.Sleep(ttl)
+	_, ok = rp.CachedReceipts(bInfo.InfoHash)
+	require.False(t, ok)
+	_, err = rp.FetchReceipts(ctx, bInfo, nil)
+	require.NoError(t, err)
+	mrp.AssertExpectations(t)
+}
+
+func TestCachingReceiptsProvider_FinalizedTTL(t *testing.T) {
+	const ttl = 100 * time.Millisecond
+	rng := rand.New(rand.NewSource(69))
+	finalized, finalizedReceipts := randomRpcBlockAndReceipts(rng, 2)
+	unsafe, unsafeReceipts := randomRpcBlockAndReceipts(rng, 2)
+	finalizedInfo, _, _ := finalized.Info(true, true)
+	unsafeInfo, _, _ := unsafe.Info(true, true)
+	mrp := new(mockReceiptsProvider)
+	mrp.On("FetchReceipts", mock.Anything, finalized.BlockID(), receiptTxHashes(finalizedReceipts)).
+		Return(types.Receipts(finalizedReceipts), error(nil)).Once()
+	mrp.On("FetchReceipts", mock.Anything, unsafe.BlockID(), receiptTxHashes(unsafeReceipts)).
+		Return(types.Receipts(unsafeReceipts), error(nil)).Twice()
+	isFinalized := func(number uint64) bool { return number <= uint64(finalized.Number) }
+	rp := NewCachingReceiptsProvider(mrp, nil, 2, caching.WithTTL(ttl), WithFinalizedReceipts(isFinalized))
+	ctx := context.Background()
+
+	fetchAll := func() {
+		_, err := rp.FetchReceipts(ctx, finalizedInfo, receiptTxHashes(finalizedReceipts))
+		require.NoError(t, err)
+		_, err = rp.FetchReceipts(ctx, unsafeInfo, receiptTxHashes(unsafeReceipts))
+		require.NoError(t, err)
+	}
+	fetchAll()
+	time.Sleep(ttl)
+	// the receipts of the finalized block stay cached, those of the unfinalized block expire
+	_, ok := rp.CachedReceipts(finalized.Hash)
+	require.True(t, ok)
+	_, ok = rp.CachedReceipts(unsafe.Hash)
+	require.False(t, ok)
+	fetchAll()
+	mrp.AssertExpectations(t)
+}
+
+func TestCachingReceiptsProvider_SharedError(t *testing.T) {
+	const numFetchers = 8
+	mrp := new(mockReceiptsProvider)
+	rp := NewCachingReceiptsProvider(mrp, nil, 1)
+	ctx := context.Background()
+	bInfo := &testutils.MockBlockInfo{InfoNum: 10, InfoHash: common.Hash{0xa}}
+
+	release := make(chan time.Time)
+	fetchErr := errors.New("fetch failed")
+	mrp.On("FetchReceipts", ctx, eth.ToBlockID(bInfo), []common.Hash(nil)).
+		WaitUntil(release).
+		Return(types.Receipts(nil), fetchErr).
+		Once() // concurrent fetches share a single inner fetch
+
+	errs := make(chan error, numFetchers)
+	for i := 0; i < numFetchers; i++ {
+		go func() {
+			_, err := rp.FetchReceipts(ctx, bInfo, nil)
+			errs <- err
+		}()
+	}
+	// give all fetchers the chance to join the in-flight fetch
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	for i := 0; i < numFetchers; i++ {
+		require.ErrorIs(t, <-errs, fetchErr)
+	}
+	mrp.AssertExpectations(t)
+
+	// the error is not cached
+	mrp.On("FetchReceipts", ctx, eth.ToBlockID(bInfo), []common.Hash(nil)).Return(types.Receipts{}, error(nil)).Once()
+	_, err := rp.FetchReceipts(ctx, bInfo, nil)
+	require.NoError(t, err)
+	mrp.AssertExpectations(t)
+}
+
+func TestCachingReceiptsProvider_LeaderCancelled(t *testing.T) {
+	mrp := new(mockReceiptsProvider)
+	rp := NewCachingReceiptsProvider(mrp, nil, 1)
+	bInfo := &testutils.MockBlockInfo{InfoNum: 10, InfoHash: common.Hash{0xa}}
+	receipts := types.Receipts{&types.Receipt{Status: types.ReceiptStatusSuccessful}}
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	mrp.On("FetchReceipts", leaderCtx, eth.ToBlockID(bInfo), []common.Hash(nil)).
+		Run(func(mock.Arguments) {
+			close(started)
+			<-leaderCtx.Done()
+		}).
+		Return(types.Receipts(nil), context.Canceled).
+		Once()
+	waiterCtx := context.Background()
+	mrp.On("FetchReceipts", waiterCtx, eth.ToBlockID(bInfo), []common.Hash(nil)).Return(receipts, error(nil)).Once()
+
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := rp.FetchReceipts(leaderCtx, bInfo, nil)
+		leaderErr <- err
+	}()
+	<-started
+	type result struct {
+		receipts types.Receipts
+		err      error
+	}
+	waiter := make(chan result, 1)
+	go func() {
+		r, err := rp.FetchReceipts(waiterCtx, bInfo, nil)
+		waiter <- result{r, err}
+	}()
+	// give the waiter the chance to join the in-flight fetch
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	require.ErrorIs(t, <-leaderErr, context.Canceled)
+	// the waiter fetches again with its own context, rather than failing with the cancellation of the leader
+	res := <-waiter
+	require.NoError(t, res.err)
+	require.Equal(t, receipts, res.receipts)
+	mrp.AssertExpectations(t)
+}
+
+func TestCachingReceiptsProvider_MaxCost(t *testing.T) {
+	receipts := types.Receipts{
+		{Logs: []*types.Log{{Data: make([]byte, 100)}}},
+		{Logs: []*types.Log{{Data: make([]byte, 100)}}},
+	}
+	// budget for one block worth of receipts, though the cache size fits more blocks
+	maxBytes := ReceiptsCost(receipts) + 1
+	mrp := new(mockReceiptsProvider)
+	rp := NewCachingReceiptsProvider(mrp, nil, 10, caching.WithMaxCost(maxBytes, ReceiptsCost))
+	ctx := context.Background()
+	blockA := &testutils.MockBlockInfo{InfoNum: 10, InfoHash: common.Hash{0xa}}
+	blockB := &testutils.MockBlockInfo{InfoNum: 11, InfoHash: common.Hash{0xb}}
+	mrp.On("FetchReceipts", ctx, eth.ToBlockID(blockA), []common.Hash(nil)).Return(receipts, error(nil)).Once()
+	mrp.On("FetchReceipts", ctx, eth.ToBlockID(blockB), []common.Hash(nil)).Return(receipts, error(nil)).Once()
+
+	_, err := rp.FetchReceipts(ctx, blockA, nil)
+	require.NoError(t, err)
+	_, err = rp.FetchReceipts(ctx, blockB, nil)
+	require.NoError(t, err)
+	_, ok := rp.CachedReceipts(blockA.InfoHash)
+	require.False(t, ok, "receipts are evicted by size")
+	_, ok = rp.CachedReceipts(blockB.InfoHash)
+	require.True(t, ok)
+	mrp.AssertExpectations(t)
+}
+
+type recordingCacheMetrics struct {
+	mu      sync.Mutex
+	gets    map[string][]bool
+	fetches map[string]int
+}
+
+func (m *recordingCacheMetrics) CacheAdd(string, int, bool) {}
+
+func (m *recordingCacheMetrics) CacheGet(label string, hit bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.gets[label] = append(m.gets[label], hit)
+}
+
+func (m *recordingCacheMetrics) CacheFetch(label string, _ time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fetches[label]++
+}
+
+func TestCachingReceiptsProvider_Metrics(t *testing.T) {
+	m := &recordingCacheMetrics{gets: make(map[string][]bool), fetches: make(map[string]int)}
+	mrp := new(mockReceiptsProvider)
+	rp := NewCachingReceiptsProvider(mrp, m, 2)
+	ctx := context.Background()
+	bInfo := &testutils.MockBlockInfo{InfoNum: 10, InfoHash: common.Hash{0xa}}
+	mrp.On("FetchReceipts", ctx, eth.ToBlockID(bInfo), []common.Hash(nil)).Return(types.Receipts{}, error(nil)).Once()
+
+	for i := 0; i < 3; i++ {
+		_, err := rp.FetchReceipts(ctx, bInfo, nil)
+		require.NoError(t, err)
+	}
+	// lookups without fetching are not metered as fetches
+	_, ok := rp.CachedReceipts(bInfo.InfoHash)
+	require.True(t, ok)
+
+	require.Equal(t, []bool{false, true, true}, m.gets[ReceiptsFetchLabel])
+	require.Equal(t, 1, m.fetches[ReceiptsFetchLabel])
+	mrp.AssertExpectations(t)
+}
+
+func TestCachingReceiptsProvider_FetchReceiptsUncached(t *testing.T) {
+	mrp := new(mockReceiptsProvider)
+	rp := NewCachingReceiptsProvider(mrp, nil, 2)
+	ctx := context.Background()
+	bInfo := &testutils.MockBlockInfo{InfoNum: 10, InfoHash: common.Hash{0xa}}
+	stale := types.Receipts{{TxHash: common.Hash{0x1}}}
+	fresh := types.Receipts{{TxHash: common.Hash{0x2}}}
+	mrp.On("FetchReceipts", ctx, eth.ToBlockID(bInfo), []common.Hash(nil)).Return(stale, error(nil)).Once()
+	mrp.On("FetchReceipts", ctx, eth.ToBlockID(bInfo), []common.Hash(nil)).Return(fresh, error(nil)).Once()
+
+	r, err := rp.FetchReceipts(ctx, bInfo, nil)
+	require.NoError(t, err)
+	require.Equal(t, stale, r)
+
+	// the cache is bypassed, and refreshed with the result
+	r, err = rp.FetchReceiptsUncached(ctx, bInfo, nil)
+	require.NoError(t, err)
+	require.Equal(t, fresh, r)
+	r, err = rp.FetchReceipts(ctx, bInfo, nil)
+	require.NoError(t, err)
+	require.Equal(t, fresh, r)
+	mrp.AssertExpectations(t)
+
+	// a failed fetch keeps the cached receipts
+	mrp.On("FetchReceipts", ctx, eth.ToBlockID(bInfo), []common.Hash(nil)).Return(types.Receipts(nil), errors.New("boom")).Once()
+	_, err = rp.FetchReceiptsUncached(ctx, bInfo, nil)
+	require.ErrorContains(t, err, "boom")
+	r, ok := rp.CachedReceipts(bInfo.InfoHash)
+	require.True(t, ok)
+	require.Equal(t, fresh, r)
+}
+
+// staticReceiptsProvider returns the same receipts for every block, without allocating.
+type staticReceiptsProvider struct {
+	receipts types.Receipts
+}
+
+func (s *staticReceiptsProvider) FetchReceipts(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (types.Receipts, error) {
+	return s.receipts, nil
+}
+
+func TestCachingReceiptsProvider_Disabled(t *testing.T) {
+	m := &recordingCacheMetrics{gets: make(map[string][]bool), fetches: make(map[string]int)}
+	mrp := new(mockReceiptsProvider)
+	rp := NewCachingReceiptsProvider(mrp, m, 0)
+	ctx := context.Background()
+	bInfo := &testutils.MockBlockInfo{InfoNum: 10, InfoHash: common.Hash{0xa}}
+	receipts := types.Receipts{&types.Receipt{Status: types.ReceiptStatusSuccessful}}
+	// every fetch passes through to the inner provider
+	mrp.On("FetchReceipts", ctx, eth.ToBlockID(bInfo), []common.Hash(nil)).Return(receipts, error(nil)).Times(3)
+
+	for i := 0; i < 2; i++ {
+		got, err := rp.FetchReceipts(ctx, bInfo, nil)
+		require.NoError(t, err)
+		require.Equal(t, receipts, got)
+	}
+	got, err := rp.FetchReceiptsUncached(ctx, bInfo, nil)
+	require.NoError(t, err)
+	require.Equal(t, receipts, got)
+
+	_, ok := rp.CachedReceipts(bInfo.InfoHash)
+	require.False(t, ok)
+	_, ok = rp.CachedReceiptsByNumber(bInfo.InfoNum)
+	require.False(t, ok)
+	require.NoError(t, rp.PrefetchReceipts(ctx, []eth.BlockInfo{bInfo}, nil, 1))
+	mrp.AssertExpectations(t)
+
+	// no cache operations are attempted, so nothing is metered
+	require.Empty(t, m.gets)
+	require.Empty(t, m.fetches)
+
+	t.Run("NoOverhead", func(t *testing.T) {
+		rp := NewCachingReceiptsProvider(&staticReceiptsProvider{receipts: receipts}, nil, 0)
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = rp.FetchReceipts(ctx, bInfo, nil)
+			_, _ = rp.CachedReceipts(bInfo.InfoHash)
+			_, _ = rp.CachedReceiptsByNumber(bInfo.InfoNum)
+		})
+		require.Zero(t, allocs)
+	})
+}
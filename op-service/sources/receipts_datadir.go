@@ -0,0 +1,82 @@
+package sources
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
+)
+
+// DatadirReceiptsFetcher reads receipts directly from the chain database of a local geth datadir,
+// including the ancient (freezer) store, instead of fetching them over RPC. This is meant for backfills
+// from a datadir that is on disk already. The read receipts are validated like receipts fetched over RPC.
+type DatadirReceiptsFetcher struct {
+	db ethdb.Database
+}
+
+var _ ReceiptsProvider = (*DatadirReceiptsFetcher)(nil)
+
+// NewDatadirReceiptsFetcher opens the chain database at the given chaindata path read-only,
+// e.g. <datadir>/geth/chaindata, with the ancient store at its default location in the "ancient" sub-directory.
+// The database engine, leveldb or pebble, is detected from the existing database.
+// The database must not be in use by a running geth node.
+func NewDatadirReceiptsFetcher(chaindata string) (*DatadirReceiptsFetcher, error) {
+	db, err := rawdb.Open(rawdb.OpenOptions{
+		Directory:         chaindata,
+		AncientsDirectory: filepath.Join(chaindata, "ancient"),
+		Namespace:         "op/receipts/datadir/",
+		ReadOnly:          true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open chain database %q: %w", chaindata, err)
+	}
+	return &DatadirReceiptsFetcher{db: db}, nil
+}
+
+// FetchReceipts reads the receipts of the given block from the chain database, and validates them.
+// The block body is read as well, since the transaction types of the receipts are not stored with the receipts.
+func (f *DatadirReceiptsFetcher) FetchReceipts(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (types.Receipts, error) {
+	block := eth.ToBlockID(blockInfo)
+	body := rawdb.ReadBody(f.db, block.Hash, block.Number)
+	if body == nil {
+		return nil, fmt.Errorf("block %s not found in chain database", block)
+	}
+	if len(body.Transactions) != len(txHashes) {
+		return nil, fmt.Errorf("block %s has %d transactions in chain database, but expected %d", block, len(body.Transactions), len(txHashes))
+	}
+	receipts := rawdb.ReadRawReceipts(f.db, block.Hash, block.Number)
+	if receipts == nil && len(txHashes) > 0 {
+		return nil, fmt.Errorf("receipts of block %s not found in chain database", block)
+	}
+	if err := validateReceiptsCount(txHashes, receipts); err != nil {
+		return nil, err
+	}
+	for i, tx := range body.Transactions {
+		receipts[i].Type = tx.Type()
+	}
+	eth.DeriveReceiptsMetadata(block, receipts, txHashes)
+	if err := validateReceipts(block, blockInfo.ReceiptHash(), txHashes, receipts); err != nil {
+		return nil, fmt.Errorf("invalid receipts of block %s in chain database: %w", block, err)
+	}
+	return receipts, nil
+}
+
+// Close closes the chain database.
+func (f *DatadirReceiptsFetcher) Close() error {
+	return f.db.Close()
+}
+
+func NewCachingDatadirReceiptsFetcher(chaindata string, m caching.Metrics, cacheSize int, opts ...caching.Option) (*CachingReceiptsProvider, error) {
+	fetcher, err := NewDatadirReceiptsFetcher(chaindata)
+	if err != nil {
+		return nil, err
+	}
+	return NewCachingReceiptsProvider(fetcher, m, cacheSize, opts...), nil
+}
@@ -0,0 +1,68 @@
+package sources
+
+import (
+	"context"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+func TestDatadirReceiptsFetcher(t *testing.T) {
+	rng := rand.New(rand.NewSource(123))
+	block, receipts := testutils.RandomBlock(rng, 4)
+	missing, _ := testutils.RandomBlock(rng, 2)
+	// the blooms of stored receipts are derived from their logs, so the random receipts need consistent blooms
+	for _, r := range receipts {
+		r.Bloom = types.CreateBloom(types.Receipts{r})
+	}
+	block = types.NewBlock(block.Header(), block.Transactions(), nil, receipts, trie.NewStackTrie(nil))
+	for _, r := range receipts {
+		for _, l := range r.Logs {
+			l.BlockHash = block.Hash()
+		}
+	}
+
+	chaindata := filepath.Join(t.TempDir(), "chaindata")
+	db, err := rawdb.Open(rawdb.OpenOptions{
+		Type:              "leveldb",
+		Directory:         chaindata,
+		AncientsDirectory: filepath.Join(chaindata, "ancient"),
+	})
+	require.NoError(t, err)
+	rawdb.WriteBody(db, block.Hash(), block.NumberU64(), block.Body())
+	rawdb.WriteReceipts(db, block.Hash(), block.NumberU64(), receipts)
+	require.NoError(t, db.Close())
+
+	f, err := NewDatadirReceiptsFetcher(chaindata)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, f.Close()) })
+	ctx := context.Background()
+
+	txHashes := eth.TransactionsToHashes(block.Transactions())
+	result, err := f.FetchReceipts(ctx, eth.BlockToInfo(block), txHashes)
+	require.NoError(t, err)
+	require.Len(t, result, len(receipts))
+	for i, r := range result {
+		require.Equal(t, receipts[i].Type, r.Type)
+		require.Equal(t, receipts[i].Status, r.Status)
+		require.Equal(t, receipts[i].GasUsed, r.GasUsed)
+		require.Equal(t, txHashes[i], r.TxHash)
+		require.Equal(t, block.Hash(), r.BlockHash)
+		require.Equal(t, receipts[i].Logs, r.Logs)
+	}
+	require.Equal(t, types.DeriveSha(types.Receipts(receipts), trie.NewStackTrie(nil)), types.DeriveSha(result, trie.NewStackTrie(nil)))
+
+	_, err = f.FetchReceipts(ctx, eth.BlockToInfo(missing), eth.TransactionsToHashes(missing.Transactions()))
+	require.ErrorContains(t, err, "not found")
+
+	_, err = f.FetchReceipts(ctx, eth.BlockToInfo(block), txHashes[:3])
+	require.ErrorContains(t, err, "expected 3")
+}
@@ -0,0 +1,76 @@
+package sources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// FallbackReceiptsMetrics meters which backend of a FallbackReceiptsProvider served the receipts of a block.
+type FallbackReceiptsMetrics interface {
+	// RecordReceiptsBackend is called each time the named backend successfully served the receipts of a block.
+	RecordReceiptsBackend(backend string)
+}
+
+// ReceiptsBackend is a named ReceiptsProvider, used as a backend of a FallbackReceiptsProvider.
+type ReceiptsBackend struct {
+	// Name identifies the backend in logs and metrics, e.g. "local" or "paid"
+	Name     string
+	Provider ReceiptsProvider
+}
+
+// FallbackReceiptsProvider fetches receipts from an ordered list of backends, e.g. a cheap local node first,
+// and a paid provider if that fails. Each fetch tries the backends in order until one of them succeeds.
+// The backends are expected to validate the receipts they return, like the RPCReceiptsFetcher does.
+// Backends can be caching providers themselves, so each backend caches the receipts it served.
+type FallbackReceiptsProvider struct {
+	log      log.Logger
+	backends []ReceiptsBackend
+	metrics  FallbackReceiptsMetrics
+}
+
+var _ ReceiptsProvider = (*FallbackReceiptsProvider)(nil)
+
+// NewFallbackReceiptsProvider creates a FallbackReceiptsProvider that tries the given backends in the given order.
+// Metrics are optional: which backend served the receipts is not tracked if m == nil.
+func NewFallbackReceiptsProvider(log log.Logger, m FallbackReceiptsMetrics, backends ...ReceiptsBackend) *FallbackReceiptsProvider {
+	return &FallbackReceiptsProvider{
+		log:      log,
+		backends: backends,
+		metrics:  m,
+	}
+}
+
+// FetchReceipts fetches the receipts of the given block from the first backend that succeeds.
+// If all backends fail, the errors of all backends are returned, joined.
+// No further backends are tried once the context is done.
+func (p *FallbackReceiptsProvider) FetchReceipts(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (types.Receipts, error) {
+	var errs []error
+	for _, b := range p.backends {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+		receipts, err := b.Provider.FetchReceipts(ctx, blockInfo, txHashes)
+		if err != nil {
+			p.log.Debug("failed to fetch receipts from backend, trying the next backend",
+				"block", eth.ToBlockID(blockInfo), "backend", b.Name, "err", err)
+			errs = append(errs, fmt.Errorf("backend %s: %w", b.Name, err))
+			continue
+		}
+		if p.metrics != nil {
+			p.metrics.RecordReceiptsBackend(b.Name)
+		}
+		return receipts, nil
+	}
+	if len(errs) == 0 {
+		return nil, errors.New("no receipts backends")
+	}
+	return nil, errors.Join(errs...)
+}
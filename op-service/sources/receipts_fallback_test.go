@@ -0,0 +1,100 @@
+package sources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+type recordingFallbackMetrics struct {
+	backends []string
+}
+
+func (m *recordingFallbackMetrics) RecordReceiptsBackend(backend string) {
+	m.backends = append(m.backends, backend)
+}
+
+func TestFallbackReceiptsProvider(t *testing.T) {
+	ctx := context.Background()
+	bInfo := &testutils.MockBlockInfo{InfoNum: 10, InfoHash: common.Hash{0xa}}
+	block := eth.ToBlockID(bInfo)
+	txHashes := []common.Hash{{0x1}}
+	receipts := types.Receipts{{TxHash: txHashes[0]}}
+
+	setup := func() (*mockReceiptsProvider, *mockReceiptsProvider, *recordingFallbackMetrics, *FallbackReceiptsProvider) {
+		local, paid := new(mockReceiptsProvider), new(mockReceiptsProvider)
+		m := new(recordingFallbackMetrics)
+		p := NewFallbackReceiptsProvider(testlog.Logger(t, log.LevelDebug), m,
+			ReceiptsBackend{Name: "local", Provider: local},
+			ReceiptsBackend{Name: "paid", Provider: paid})
+		return local, paid, m, p
+	}
+
+	t.Run("first-success", func(t *testing.T) {
+		local, paid, m, p := setup()
+		local.On("FetchReceipts", ctx, block, txHashes).Return(receipts, error(nil)).Once()
+
+		got, err := p.FetchReceipts(ctx, bInfo, txHashes)
+		require.NoError(t, err)
+		require.Equal(t, receipts, got)
+		require.Equal(t, []string{"local"}, m.backends)
+		local.AssertExpectations(t)
+		paid.AssertNotCalled(t, "FetchReceipts")
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		local, paid, m, p := setup()
+		local.On("FetchReceipts", ctx, block, txHashes).Return(types.Receipts(nil), errors.New("pruned")).Once()
+		paid.On("FetchReceipts", ctx, block, txHashes).Return(receipts, error(nil)).Once()
+
+		got, err := p.FetchReceipts(ctx, bInfo, txHashes)
+		require.NoError(t, err)
+		require.Equal(t, receipts, got)
+		require.Equal(t, []string{"paid"}, m.backends)
+		local.AssertExpectations(t)
+		paid.AssertExpectations(t)
+	})
+
+	t.Run("all-fail", func(t *testing.T) {
+		local, paid, m, p := setup()
+		errLocal, errPaid := errors.New("pruned"), errors.New("rate limited")
+		local.On("FetchReceipts", ctx, block, txHashes).Return(types.Receipts(nil), errLocal).Once()
+		paid.On("FetchReceipts", ctx, block, txHashes).Return(types.Receipts(nil), errPaid).Once()
+
+		_, err := p.FetchReceipts(ctx, bInfo, txHashes)
+		require.ErrorIs(t, err, errLocal)
+		require.ErrorIs(t, err, errPaid)
+		require.ErrorContains(t, err, "backend local")
+		require.ErrorContains(t, err, "backend paid")
+		require.Empty(t, m.backends)
+	})
+
+	t.Run("context-done", func(t *testing.T) {
+		local, paid, _, p := setup()
+		cctx, cancel := context.WithCancel(ctx)
+		local.On("FetchReceipts", cctx, block, txHashes).Return(types.Receipts(nil), errors.New("timeout")).
+			Run(func(_ mock.Arguments) { cancel() }).Once()
+
+		_, err := p.FetchReceipts(cctx, bInfo, txHashes)
+		require.ErrorIs(t, err, context.Canceled)
+		paid.AssertNotCalled(t, "FetchReceipts")
+	})
+
+	t.Run("no-metrics", func(t *testing.T) {
+		local := new(mockReceiptsProvider)
+		p := NewFallbackReceiptsProvider(testlog.Logger(t, log.LevelDebug), nil, ReceiptsBackend{Name: "local", Provider: local})
+		local.On("FetchReceipts", ctx, block, txHashes).Return(receipts, error(nil)).Once()
+		_, err := p.FetchReceipts(ctx, bInfo, txHashes)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,205 @@
+package sources
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// ReceiptsHint describes an upcoming block, whose receipts are expected to be fetched soon.
+type ReceiptsHint struct {
+	Info     eth.BlockInfo
+	TxHashes []common.Hash
+}
+
+// receiptsHint is a registered hint. While its receipts are prefetched, cancel aborts the prefetch.
+type receiptsHint struct {
+	ReceiptsHint
+	cancel context.CancelFunc
+}
+
+// receiptsHints tracks the hinted upcoming blocks by number, and the workers prefetching them.
+type receiptsHints struct {
+	mu       sync.Mutex
+	byNumber map[uint64]*receiptsHint
+	// maxPending bounds the number of hints to the cache capacity,
+	// so prefetched receipts are not evicted before they are used.
+	maxPending int
+	// wake signals an idle worker that there are hints to prefetch
+	wake chan struct{}
+
+	// stop stops the workers, it is nil if prefetching is not started
+	stop context.CancelFunc
+	wg   sync.WaitGroup
+}
+
+func newReceiptsHints(maxPending int) *receiptsHints {
+	return &receiptsHints{
+		byNumber:   make(map[uint64]*receiptsHint),
+		maxPending: maxPending,
+		wake:       make(chan struct{}, 1),
+	}
+}
+
+func (h *receiptsHints) signal() {
+	select {
+	case h.wake <- struct{}{}:
+	default:
+	}
+}
+
+// next marks the lowest hinted block that is not being prefetched yet as being prefetched,
+// and returns it with the context to prefetch it with.
+func (h *receiptsHints) next(ctx context.Context) (*receiptsHint, context.Context, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var next *receiptsHint
+	remaining := 0
+	for _, hint := range h.byNumber {
+		if hint.cancel != nil {
+			continue
+		}
+		remaining++
+		if next == nil || hint.Info.NumberU64() < next.Info.NumberU64() {
+			next = hint
+		}
+	}
+	if next == nil {
+		return nil, nil, false
+	}
+	if remaining > 1 {
+		// let another idle worker pick up the remaining hints
+		h.signal()
+	}
+	hintCtx, cancel := context.WithCancel(ctx)
+	next.cancel = cancel
+	return next, hintCtx, true
+}
+
+// done removes a prefetched hint, unless it was superseded already.
+func (h *receiptsHints) done(hint *receiptsHint) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	hint.cancel()
+	n := hint.Info.NumberU64()
+	if h.byNumber[n] == hint {
+		delete(h.byNumber, n)
+	}
+}
+
+// HintReceipts registers upcoming blocks, whose receipts are prefetched in the background
+// once prefetching is started with StartPrefetching.
+// A hint for a block number that is already hinted with a different block hash indicates a reorg:
+// all previous hints from that number onwards are superseded, and their in-flight prefetches are cancelled.
+// Hints beyond the cache capacity are dropped, so prefetched receipts are not evicted before they are used.
+func (p *CachingReceiptsProvider) HintReceipts(hints ...ReceiptsHint) {
+	h := p.hints
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, hint := range hints {
+		n := hint.Info.NumberU64()
+		if existing, ok := h.byNumber[n]; ok {
+			if existing.Info.Hash() == hint.Info.Hash() {
+				continue
+			}
+			for num, old := range h.byNumber {
+				if num < n {
+					continue
+				}
+				if old.cancel != nil {
+					old.cancel()
+				}
+				delete(h.byNumber, num)
+			}
+		}
+		if len(h.byNumber) >= h.maxPending {
+			continue
+		}
+		h.byNumber[n] = &receiptsHint{ReceiptsHint: hint}
+	}
+	if len(h.byNumber) > 0 {
+		h.signal()
+	}
+}
+
+// StartPrefetching starts prefetching the receipts of hinted blocks in the background,
+// lowest block number first, with up to concurrency prefetches at once.
+// It is a no-op if prefetching is started already.
+func (p *CachingReceiptsProvider) StartPrefetching(concurrency int) {
+	h := p.hints
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.stop != nil {
+		return
+	}
+	ctx, stop := context.WithCancel(context.Background())
+	h.stop = stop
+	for i := 0; i < max(concurrency, 1); i++ {
+		h.wg.Add(1)
+		go p.prefetchLoop(ctx)
+	}
+	h.signal()
+}
+
+// StopPrefetching stops prefetching, cancelling in-flight prefetches, and waits for the background workers to exit.
+// Hints that were not prefetched yet are retained, and prefetched once prefetching is started again.
+func (p *CachingReceiptsProvider) StopPrefetching() {
+	h := p.hints
+	h.mu.Lock()
+	stop := h.stop
+	h.stop = nil
+	h.mu.Unlock()
+	if stop == nil {
+		return
+	}
+	stop()
+	h.wg.Wait()
+}
+
+func (p *CachingReceiptsProvider) prefetchLoop(ctx context.Context) {
+	defer p.hints.wg.Done()
+	for ctx.Err() == nil {
+		hint, hintCtx, ok := p.hints.next(ctx)
+		if !ok {
+			select {
+			case <-ctx.Done():
+				return
+			case <-p.hints.wake:
+				continue
+			}
+		}
+		// Failed prefetches are not retried, the receipts are fetched on demand instead.
+		_, _ = p.FetchReceipts(hintCtx, hint.Info, hint.TxHashes)
+		p.hints.done(hint)
+	}
+}
+
+// PrefetchReceipts fetches the receipts of a window of upcoming blocks into the cache, with up to concurrency
+// fetches at once, and waits for them to complete. The transaction hashes of each block are looked up by block hash.
+// The blocks are given as block infos, since fetched receipts are validated against the receipts root of the block.
+// A block is never fetched twice at the same time: a prefetch of a block that is being fetched already,
+// and a FetchReceipts call for a block that is being prefetched, wait for the in-flight fetch and use its result.
+// The first error is returned once all fetches completed. Receipts that failed to be prefetched are fetched on demand.
+// It is a no-op if caching is disabled, since there is no cache to prefetch the receipts into.
+func (p *CachingReceiptsProvider) PrefetchReceipts(ctx context.Context, blocks []eth.BlockInfo, txHashesByBlock map[common.Hash][]common.Hash, concurrency int) error {
+	if p.cache == nil {
+		return nil
+	}
+	var g errgroup.Group
+	g.SetLimit(max(concurrency, 1))
+	for _, block := range blocks {
+		block := block
+		if _, ok := p.cache.Get(block.Hash()); ok {
+			continue
+		}
+		g.Go(func() error {
+			_, err := p.FetchReceipts(ctx, block, txHashesByBlock[block.Hash()])
+			return err
+		})
+	}
+	return g.Wait()
+}
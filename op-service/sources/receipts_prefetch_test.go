@@ -0,0 +1,202 @@
+package sources
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+// blockingReceiptsProvider serves empty receipts for blocks once they are released,
+// and records which fetches were started and cancelled.
+type blockingReceiptsProvider struct {
+	mu        sync.Mutex
+	released  map[common.Hash]chan struct{}
+	started   []common.Hash
+	cancelled []common.Hash
+}
+
+func newBlockingReceiptsProvider() *blockingReceiptsProvider {
+	return &blockingReceiptsProvider{released: make(map[common.Hash]chan struct{})}
+}
+
+func (b *blockingReceiptsProvider) release(hash common.Hash) {
+	close(b.releaseCh(hash))
+}
+
+func (b *blockingReceiptsProvider) releaseCh(hash common.Hash) chan struct{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ch, ok := b.released[hash]
+	if !ok {
+		ch = make(chan struct{})
+		b.released[hash] = ch
+	}
+	return ch
+}
+
+func (b *blockingReceiptsProvider) startedFetches() []common.Hash {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]common.Hash(nil), b.started...)
+}
+
+func (b *blockingReceiptsProvider) cancelledFetches() []common.Hash {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]common.Hash(nil), b.cancelled...)
+}
+
+func (b *blockingReceiptsProvider) FetchReceipts(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (types.Receipts, error) {
+	b.mu.Lock()
+	b.started = append(b.started, blockInfo.Hash())
+	b.mu.Unlock()
+	select {
+	case <-b.releaseCh(blockInfo.Hash()):
+		return types.Receipts{}, nil
+	case <-ctx.Done():
+		b.mu.Lock()
+		b.cancelled = append(b.cancelled, blockInfo.Hash())
+		b.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+func testReceiptsHint(num uint64, hash byte) ReceiptsHint {
+	return ReceiptsHint{Info: &testutils.MockBlockInfo{InfoNum: num, InfoHash: common.Hash{hash}}}
+}
+
+func pendingHintNumbers(p *CachingReceiptsProvider) []uint64 {
+	p.hints.mu.Lock()
+	defer p.hints.mu.Unlock()
+	var out []uint64
+	for n := range p.hints.byNumber {
+		out = append(out, n)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func TestCachingReceiptsProvider_HintReceipts(t *testing.T) {
+	rp := NewCachingReceiptsProvider(newBlockingReceiptsProvider(), nil, 3)
+
+	rp.HintReceipts(testReceiptsHint(10, 0xa), testReceiptsHint(11, 0xb))
+	// re-hinting the same block is a no-op
+	rp.HintReceipts(testReceiptsHint(11, 0xb))
+	require.Equal(t, []uint64{10, 11}, pendingHintNumbers(rp))
+
+	// hints are bounded by the cache size
+	rp.HintReceipts(testReceiptsHint(12, 0xc), testReceiptsHint(13, 0xd))
+	require.Equal(t, []uint64{10, 11, 12}, pendingHintNumbers(rp))
+
+	// a conflicting hint supersedes the hints from its number onwards
+	rp.HintReceipts(testReceiptsHint(11, 0xe))
+	require.Equal(t, []uint64{10, 11}, pendingHintNumbers(rp))
+	require.Equal(t, common.Hash{0xe}, rp.hints.byNumber[11].Info.Hash())
+}
+
+func TestCachingReceiptsProvider_Prefetch(t *testing.T) {
+	inner := newBlockingReceiptsProvider()
+	rp := NewCachingReceiptsProvider(inner, nil, 10)
+	rp.StartPrefetching(2)
+	t.Cleanup(rp.StopPrefetching)
+
+	hints := []ReceiptsHint{testReceiptsHint(10, 0xa), testReceiptsHint(11, 0xb), testReceiptsHint(12, 0xc)}
+	rp.HintReceipts(hints...)
+
+	// the lowest blocks are prefetched first, bounded by the concurrency
+	require.Eventually(t, func() bool { return len(inner.startedFetches()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	require.ElementsMatch(t, []common.Hash{{0xa}, {0xb}}, inner.startedFetches())
+
+	for _, hint := range hints {
+		inner.release(hint.Info.Hash())
+	}
+	require.Eventually(t, func() bool {
+		for _, hint := range hints {
+			if _, ok := rp.CachedReceipts(hint.Info.Hash()); !ok {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool { return len(pendingHintNumbers(rp)) == 0 }, 5*time.Second, 10*time.Millisecond)
+
+	// prefetched receipts are served from the cache
+	_, err := rp.FetchReceipts(context.Background(), hints[1].Info, nil)
+	require.NoError(t, err)
+	require.Len(t, inner.startedFetches(), 3)
+}
+
+func TestCachingReceiptsProvider_PrefetchReorg(t *testing.T) {
+	inner := newBlockingReceiptsProvider()
+	rp := NewCachingReceiptsProvider(inner, nil, 10)
+	rp.StartPrefetching(1)
+	t.Cleanup(rp.StopPrefetching)
+
+	rp.HintReceipts(testReceiptsHint(10, 0xa), testReceiptsHint(11, 0xb))
+	require.Eventually(t, func() bool { return len(inner.startedFetches()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, common.Hash{0xa}, inner.startedFetches()[0])
+
+	// block 10 is reorged out, cancelling its prefetch and superseding the hint of its descendant
+	reorged := testReceiptsHint(10, 0xc)
+	rp.HintReceipts(reorged)
+	require.Eventually(t, func() bool { return len(inner.cancelledFetches()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, common.Hash{0xa}, inner.cancelledFetches()[0])
+
+	inner.release(reorged.Info.Hash())
+	require.Eventually(t, func() bool {
+		_, ok := rp.CachedReceipts(reorged.Info.Hash())
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+	_, ok := rp.CachedReceipts(common.Hash{0xa})
+	require.False(t, ok)
+	require.NotContains(t, inner.startedFetches(), common.Hash{0xb})
+	require.Empty(t, pendingHintNumbers(rp))
+}
+
+func TestCachingReceiptsProvider_PrefetchReceipts(t *testing.T) {
+	inner := newBlockingReceiptsProvider()
+	rp := NewCachingReceiptsProvider(inner, nil, 10)
+	ctx := context.Background()
+
+	blocks := []eth.BlockInfo{
+		testReceiptsHint(10, 0xa).Info,
+		testReceiptsHint(11, 0xb).Info,
+		testReceiptsHint(12, 0xc).Info,
+	}
+	prefetched := make(chan error, 2)
+	go func() {
+		prefetched <- rp.PrefetchReceipts(ctx, blocks, nil, 2)
+	}()
+	require.Eventually(t, func() bool { return len(inner.startedFetches()) == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	// a concurrent prefetch and fetch of a block that is being prefetched wait for the in-flight fetch
+	go func() {
+		prefetched <- rp.PrefetchReceipts(ctx, blocks[:1], nil, 1)
+	}()
+	fetched := make(chan error, 1)
+	go func() {
+		_, err := rp.FetchReceipts(ctx, blocks[0], nil)
+		fetched <- err
+	}()
+
+	for _, block := range blocks {
+		inner.release(block.Hash())
+	}
+	require.NoError(t, <-fetched)
+	require.NoError(t, <-prefetched)
+	require.NoError(t, <-prefetched)
+	require.ElementsMatch(t, []common.Hash{{0xa}, {0xb}, {0xc}}, inner.startedFetches())
+
+	// cached blocks are not prefetched again
+	require.NoError(t, rp.PrefetchReceipts(ctx, blocks, nil, 2))
+	require.Len(t, inner.startedFetches(), 3)
+}
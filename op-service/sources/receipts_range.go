@@ -0,0 +1,74 @@
+package sources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// BlockReceiptsRequest identifies a block, and the transactions within it, to fetch receipts for.
+type BlockReceiptsRequest struct {
+	Info     eth.BlockInfo
+	TxHashes []common.Hash
+}
+
+// FetchReceiptsRange fetches the receipts of each of the given blocks, in order.
+//
+// If the context has a deadline, the remaining time is treated as a budget shared by the remaining blocks:
+// each block is given an equal share of what is left, so a few slow early blocks cannot starve later ones.
+// When fetching a block with the preferred method exhausts its share of the budget,
+// that method is dropped for the remainder of the range, and the block is retried with the next best method.
+// Methods dropped this way are not marked as unavailable for regular receipts fetching,
+// since a slow method is not a broken method.
+func (f *RPCReceiptsFetcher) FetchReceiptsRange(ctx context.Context, blocks []BlockReceiptsRequest) ([]types.Receipts, error) {
+	deadline, hasDeadline := ctx.Deadline()
+	results := make([]types.Receipts, 0, len(blocks))
+	if !hasDeadline {
+		for _, req := range blocks {
+			recs, err := f.FetchReceipts(ctx, req.Info, req.TxHashes)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, recs)
+		}
+		return results, nil
+	}
+
+	// the methods we may still use within this range, dropped as they exceed their budget
+	f.PickReceiptsMethod(0) // make sure any method-reset is applied before we take a copy
+	available := f.AvailableMethods()
+	for i, req := range blocks {
+		block := eth.ToBlockID(req.Info)
+		for {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return nil, fmt.Errorf("deadline exceeded before fetching receipts of block %s (%d of %d): %w",
+					block, i+1, len(blocks), context.DeadlineExceeded)
+			}
+			budget := remaining / time.Duration(len(blocks)-i)
+			m := f.pickMethod(available, uint64(len(req.TxHashes)))
+
+			blockCtx, cancel := context.WithTimeout(ctx, budget)
+			recs, err := f.fetchReceiptsWithMethod(blockCtx, m, req.Info, req.TxHashes)
+			cancel()
+			if err == nil {
+				results = append(results, recs)
+				break
+			}
+			// Only downgrade if the block ran out of its own share of the budget,
+			// and there is an alternative method left to try.
+			if ctx.Err() != nil || !errors.Is(err, context.DeadlineExceeded) || m == EthGetTransactionReceiptBatch {
+				return nil, fmt.Errorf("failed to fetch receipts of block %s (%d of %d): %w", block, i+1, len(blocks), err)
+			}
+			available &^= m
+			f.log.Warn("receipts fetching method exceeded its share of the range deadline, downgrading for the remaining range",
+				"block", block, "method", m, "budget", budget, "fallback", available)
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,95 @@
+package sources
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCReceiptsFetcher_FetchReceiptsRangeDeadline(t *testing.T) {
+	rng := rand.New(rand.NewSource(123))
+	const numBlocks = 5
+	var reqs []BlockReceiptsRequest
+	var allReceipts []*types.Receipt
+	var expected [][]*types.Receipt
+	for i := 0; i < numBlocks; i++ {
+		block, receipts := randomRpcBlockAndReceipts(rng, 3)
+		bInfo, _, err := block.Info(true, true)
+		require.NoError(t, err)
+		reqs = append(reqs, BlockReceiptsRequest{Info: bInfo, TxHashes: receiptTxHashes(receipts)})
+		allReceipts = append(allReceipts, receipts...)
+		expected = append(expected, receipts)
+	}
+
+	var blockCalls, batchCalls int
+	mrpc := &simpleMockRPC{
+		// the block-level method is unresponsive, and only returns once the request times out
+		callFn: func(ctx context.Context, result any, method string, args ...any) error {
+			require.Equal(t, "eth_getBlockReceipts", method)
+			blockCalls++
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		batchCallFn: serveReceiptsBatch(allReceipts, &batchCalls),
+	}
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindStandard,
+		MethodResetDuration: time.Minute,
+	})
+
+	const deadline = 500 * time.Millisecond
+	ctx, done := context.WithTimeout(context.Background(), deadline)
+	defer done()
+	start := time.Now()
+	results, err := rp.FetchReceiptsRange(ctx, reqs)
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), deadline)
+
+	require.Len(t, results, numBlocks)
+	for i, recs := range results {
+		require.Len(t, recs, len(expected[i]))
+		for j, rec := range recs {
+			requireEqualReceipt(t, expected[i][j], rec)
+		}
+	}
+	// the slow method only consumed the share of the first block, and was not used for the remaining blocks
+	require.Equal(t, 1, blockCalls)
+	require.Equal(t, numBlocks, batchCalls)
+	// a slow method is not an unavailable method
+	require.Equal(t, EthGetBlockReceipts, rp.PickReceiptsMethod(3))
+}
+
+func TestRPCReceiptsFetcher_FetchReceiptsRangeDeadlineExhausted(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 2)
+	bInfo, _, err := block.Info(true, true)
+	require.NoError(t, err)
+
+	mrpc := &simpleMockRPC{
+		callFn: func(ctx context.Context, result any, method string, args ...any) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		batchCallFn: func(ctx context.Context, b []rpc.BatchElem) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindStandard,
+		MethodResetDuration: time.Minute,
+	})
+
+	ctx, done := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer done()
+	_, err = rp.FetchReceiptsRange(ctx, []BlockReceiptsRequest{{Info: bInfo, TxHashes: receiptTxHashes(receipts)}})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
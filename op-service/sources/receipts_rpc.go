@@ -2,10 +2,16 @@ package sources
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/bits"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
 	"github.com/ethereum-optimism/optimism/op-service/sources/caching"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -19,17 +25,70 @@ import (
 func newRPCRecProviderFromConfig(client client.RPC, log log.Logger, metrics caching.Metrics, config *EthClientConfig) *CachingReceiptsProvider {
 	recCfg := RPCReceiptsConfig{
 		MaxBatchSize:        config.MaxRequestsPerBatch,
+		BatchConcurrency:    config.ReceiptsBatchConcurrency,
 		ProviderKind:        config.RPCProviderKind,
 		MethodResetDuration: config.MethodResetDuration,
+		Tracer:              config.ReceiptsTracer,
+		Metrics:             config.ReceiptsMetrics,
+		TrustRPC:            config.TrustRPC,
+		MethodPreference:    config.ReceiptsMethodPreference,
 	}
-	return NewCachingRPCReceiptsProvider(client, log, recCfg, metrics, config.ReceiptsCacheSize)
+	fetcher := NewRPCReceiptsFetcher(client, log, recCfg)
+	if config.ProbeReceiptMethods {
+		ctx, cancel := context.WithTimeout(context.Background(), probeMethodsTimeout)
+		defer cancel()
+		if err := fetcher.ProbeMethods(ctx); err != nil {
+			log.Warn("failed to probe receipt fetching methods, unsupported methods are discovered while fetching instead", "err", err)
+		} else if kind, err := DetectProviderKind(ctx, client); err != nil {
+			log.Warn("failed to detect RPC provider kind", "err", err)
+		} else if kind != config.RPCProviderKind && config.RPCProviderKind != RPCKindAny {
+			log.Warn("RPC provider supports the receipt methods of another provider kind, consider changing the RPC provider kind setting",
+				"configured", config.RPCProviderKind, "detected", kind)
+		}
+	}
+	return NewCachingReceiptsProvider(fetcher, metrics, config.ReceiptsCacheSize, receiptsCacheOptions(config)...)
 }
 
+// probeMethodsTimeout bounds how long probing the receipt methods may delay the construction of a client.
+const probeMethodsTimeout = 20 * time.Second
+
 type rpcClient interface {
 	CallContext(ctx context.Context, result any, method string, args ...any) error
 	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
 }
 
+// timeoutRPCClient bounds every call of the inner client by a timeout of its own, see RPCReceiptsConfig.PerCallTimeout.
+type timeoutRPCClient struct {
+	inner   rpcClient
+	timeout time.Duration
+}
+
+func (c *timeoutRPCClient) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.inner.CallContext(ctx, result, method, args...)
+}
+
+func (c *timeoutRPCClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.inner.BatchCallContext(ctx, b)
+}
+
+// ReceiptsMetrics meters which receipt fetching methods are used, labeled by the method name.
+type ReceiptsMetrics interface {
+	// RecordReceiptsMethod is called each time receipts were successfully fetched with the given method.
+	RecordReceiptsMethod(method string)
+	// RecordReceiptsMethodFallback is called each time the given method failed,
+	// and is temporarily not used anymore in favor of the remaining available methods.
+	RecordReceiptsMethodFallback(method string)
+}
+
+type noopReceiptsMetrics struct{}
+
+func (noopReceiptsMetrics) RecordReceiptsMethod(string)         {}
+func (noopReceiptsMetrics) RecordReceiptsMethodFallback(string) {}
+
 type RPCReceiptsFetcher struct {
 	client rpcClient
 	basic  *BasicRPCReceiptsFetcher
@@ -38,11 +97,17 @@ type RPCReceiptsFetcher struct {
 
 	provKind RPCProviderKind
 
+	// methodsMu protects availableReceiptMethods, disabledReceiptMethods and lastMethodsReset,
+	// which are accessed by concurrent receipt fetches.
+	methodsMu sync.Mutex
+
 	// availableReceiptMethods tracks which receipt methods can be used for fetching receipts
-	// This may be modified concurrently, but we don't lock since it's a single
-	// uint64 that's not critical (fine to miss or mix up a modification)
 	availableReceiptMethods ReceiptsFetchingMethod
 
+	// disabledReceiptMethods tracks the receipt methods that the RPC does not implement at all,
+	// which are not made available again when availableReceiptMethods is reset.
+	disabledReceiptMethods ReceiptsFetchingMethod
+
 	// lastMethodsReset tracks when availableReceiptMethods was last reset.
 	// When receipt-fetching fails it falls back to available methods,
 	// but periodically it will try to reset to the preferred optimal methods.
@@ -50,68 +115,511 @@ type RPCReceiptsFetcher struct {
 
 	// methodResetDuration defines how long we take till we reset lastMethodsReset
 	methodResetDuration time.Duration
+
+	// methodPreference optionally overrides the built-in method selection heuristic
+	methodPreference []ReceiptsFetchingMethod
+
+	// trustRPC skips all but the receipt count validation of fetched receipts
+	trustRPC bool
+
+	// disableTimeoutDowngrade disables retrying timed out fetches with the next best method
+	disableTimeoutDowngrade bool
+
+	// methodNames optionally overrides the names of the RPC methods of block-level receipt methods
+	methodNames map[ReceiptsFetchingMethod]string
+
+	// tracer optionally records every receipt fetching attempt
+	tracer ReceiptsTracer
+
+	metrics ReceiptsMetrics
+
+	// batchSizeMu protects batchSize and lastBatchSizeChange
+	batchSizeMu sync.Mutex
+
+	// batchSize is the effective batch size of per-tx receipt fetching, at most the configured max batch size.
+	// It is halved when the provider rejects a batch as too large,
+	// and slowly recovers back up, doubling every methodResetDuration.
+	batchSize int
+
+	// lastBatchSizeChange tracks when batchSize was last halved or doubled
+	lastBatchSizeChange time.Time
 }
 
 type RPCReceiptsConfig struct {
-	MaxBatchSize        int
-	ProviderKind        RPCProviderKind
+	MaxBatchSize int
+	ProviderKind RPCProviderKind
+	// MethodResetDuration is how long methods that fell back stay unavailable, before they are attempted again.
+	// Zero makes them available again right away, see DefaultMethodResetDuration for the default of a provider kind.
 	MethodResetDuration time.Duration
+	// Tracer is optional, and records every receipt fetching attempt if set.
+	Tracer ReceiptsTracer
+	// Metrics is optional, and defaults to a no-op.
+	Metrics ReceiptsMetrics
+	// MethodPreference is optional, and overrides the built-in method selection heuristic of the provider kind
+	// with an explicit order of preference. Only methods that are available for the provider kind are used,
+	// and per-tx fetching is used if none of the preferred methods is available.
+	MethodPreference []ReceiptsFetchingMethod
+	// TrustRPC skips the validation of the contents of fetched receipts, and of the receipts root they form,
+	// only checking that there is a receipt for each transaction. This saves the CPU time of recomputing
+	// the receipts trie of every block, and is only meant for trusted nodes, e.g. a co-located node over IPC.
+	// Warning: enabling this sacrifices the protection against RPC providers dropping, reordering or
+	// mixing up receipts or logs, which would then be used as-is, e.g. for deposits during derivation.
+	TrustRPC bool
+	// OnProgress is optional, and is called with the number of fetched and total receipts of a block
+	// after each batch of per-tx receipt fetching. It may be called concurrently, when blocks are fetched concurrently.
+	OnProgress func(done, total int)
+	// DisableTimeoutDowngrade disables retrying a fetch that timed out with the next best available method.
+	// By default, a timed out fetch is retried once with the next best method, within the deadline of the
+	// context of the fetch, since a timeout does not make a method unusable, and would otherwise be retried
+	// with the same slow method. Strict callers may opt out, to have the timeout returned as-is.
+	DisableTimeoutDowngrade bool
+	// BatchConcurrency is optional, and is the number of batches of per-tx receipt fetching of a block
+	// that are requested concurrently. Defaults to fetching the batches one by one.
+	BatchConcurrency int
+	// ReceiptRetries is optional, and retries individual failed receipt requests of per-tx receipt fetching,
+	// with backoff, so one flaky receipt does not fail the fetch of the whole block. No retries by default.
+	ReceiptRetries batching.RetryPolicy
+	// NilReceiptRetries is optional, and is the number of times the receipts of per-tx receipt fetching that are not
+	// found, e.g. during a shallow reorg, are requested again, rather than failing the fetch of the whole block.
+	// Only the missing receipts are requested again. No retries by default.
+	NilReceiptRetries int
+	// PerCallTimeout is optional, and bounds each RPC call of receipt fetching, like a call of
+	// alchemy_getTransactionReceipts, or a batch of per-tx receipt requests, so a hanging call times out on its own,
+	// and is retried with the next best method like any other timeout, rather than blocking until the context of
+	// the fetch is done. It applies to every call of the fetcher, including FetchReceiptsByLabel and the batches of
+	// FetchReceiptsForBlocks. Zero means no per-call timeout.
+	PerCallTimeout time.Duration
+	// MethodNames is optional, and overrides the names of the RPC methods called for block-level receipt methods,
+	// for RPC gateways that expose them under other names, e.g. myprovider_getBlockReceipts for
+	// EthGetBlockReceipts. The methods must take the same parameters and return the same results.
+	// Per-tx fetching always calls eth_getTransactionReceipt.
+	MethodNames map[ReceiptsFetchingMethod]string
 }
 
 func NewRPCReceiptsFetcher(client rpcClient, log log.Logger, config RPCReceiptsConfig) *RPCReceiptsFetcher {
+	metrics := config.Metrics
+	if metrics == nil {
+		metrics = noopReceiptsMetrics{}
+	}
+	if config.PerCallTimeout > 0 {
+		client = &timeoutRPCClient{inner: client, timeout: config.PerCallTimeout}
+	}
+	basic := NewBasicRPCReceiptsFetcher(client, config.MaxBatchSize)
+	basic.onProgress = config.OnProgress
+	basic.retryPolicy = config.ReceiptRetries
+	basic.batchConcurrency = config.BatchConcurrency
+	basic.nilReceiptRetries = config.NilReceiptRetries
 	return &RPCReceiptsFetcher{
 		client:                  client,
-		basic:                   NewBasicRPCReceiptsFetcher(client, config.MaxBatchSize),
+		basic:                   basic,
 		log:                     log,
 		provKind:                config.ProviderKind,
 		availableReceiptMethods: AvailableReceiptsFetchingMethods(config.ProviderKind),
 		lastMethodsReset:        time.Now(),
 		methodResetDuration:     config.MethodResetDuration,
+		methodPreference:        config.MethodPreference,
+		trustRPC:                config.TrustRPC,
+		disableTimeoutDowngrade: config.DisableTimeoutDowngrade,
+		methodNames:             config.MethodNames,
+		tracer:                  config.Tracer,
+		metrics:                 metrics,
+		batchSize:               basic.maxBatchSize,
+		lastBatchSizeChange:     time.Now(),
 	}
 }
 
-func (f *RPCReceiptsFetcher) FetchReceipts(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (result types.Receipts, err error) {
+func (f *RPCReceiptsFetcher) FetchReceipts(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (types.Receipts, error) {
 	m := f.PickReceiptsMethod(len(txHashes))
+	result, err := f.fetchReceiptsWithMethod(ctx, m, blockInfo, txHashes)
+	// A timeout of the request, rather than of the fetch as a whole, is retried once with the next best method.
+	// The timed out method remains available, since a timeout does not make it unusable.
+	if err != nil && !f.disableTimeoutDowngrade && errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		if next := f.pickMethod(f.AvailableMethods()&^m, uint64(len(txHashes))); next != m {
+			f.log.Debug("receipt fetching timed out, retrying with the next best method",
+				"block", eth.ToBlockID(blockInfo), "method", m, "next", next, "err", err)
+			return f.fetchReceiptsWithMethod(ctx, next, blockInfo, txHashes)
+		}
+	}
+	return result, err
+}
+
+// fetchReceiptsWithMethod fetches and validates the receipts of the given block with the given method.
+func (f *RPCReceiptsFetcher) fetchReceiptsWithMethod(ctx context.Context, m ReceiptsFetchingMethod, blockInfo eth.BlockInfo, txHashes []common.Hash) (result types.Receipts, err error) {
 	block := eth.ToBlockID(blockInfo)
+	var perTxFallback bool
+	if f.tracer != nil {
+		start := time.Now()
+		defer func() {
+			rec := ReceiptsTraceRecord{
+				Time:          start,
+				Block:         block,
+				TxCount:       len(txHashes),
+				Method:        m.String(),
+				Duration:      time.Since(start),
+				Outcome:       ReceiptsTraceSuccess,
+				PerTxFallback: perTxFallback,
+			}
+			if err != nil {
+				rec.Outcome = ReceiptsTraceError
+				rec.Error = err.Error()
+				rec.Fallback = (f.AvailableMethods() &^ m).String()
+			}
+			f.tracer.TraceReceiptsFetch(rec)
+		}()
+	}
+
 	switch m {
 	case EthGetTransactionReceiptBatch:
-		result, err = f.basic.FetchReceipts(ctx, blockInfo, txHashes)
+		result, err = f.fetchPerTx(ctx, blockInfo, txHashes)
 	case AlchemyGetTransactionReceipts:
 		var tmp receiptsWrapper
-		err = f.client.CallContext(ctx, &tmp, "alchemy_getTransactionReceipts", blockHashParameter{BlockHash: block.Hash})
+		err = f.client.CallContext(ctx, &tmp, receiptsRPCMethod(f.methodNames, m, "alchemy_getTransactionReceipts"), blockHashParameter{BlockHash: block.Hash})
 		result = tmp.Receipts
 	case DebugGetRawReceipts:
 		var rawReceipts []hexutil.Bytes
-		err = f.client.CallContext(ctx, &rawReceipts, "debug_getRawReceipts", block.Hash)
-		if err == nil {
+		err = f.client.CallContext(ctx, &rawReceipts, receiptsRPCMethod(f.methodNames, m, "debug_getRawReceipts"), block.Hash)
+		// an empty response is handled as a null response below
+		if err == nil && len(rawReceipts) > 0 {
 			if len(rawReceipts) == len(txHashes) {
 				result, err = eth.DecodeRawReceipts(block, rawReceipts, txHashes)
+				if err == nil {
+					// the transactions are not known here, so only the blob gas price is derived, not the blob gas used
+					err = eth.DeriveBlobReceiptFields(result, blockInfo.BlobBaseFee(), nil)
+				}
 			} else {
 				err = fmt.Errorf("got %d raw receipts, but expected %d", len(rawReceipts), len(txHashes))
 			}
 		}
 	case ParityGetBlockReceipts:
-		err = f.client.CallContext(ctx, &result, "parity_getBlockReceipts", block.Hash)
+		err = f.client.CallContext(ctx, &result, receiptsRPCMethod(f.methodNames, m, "parity_getBlockReceipts"), block.Hash)
 	case EthGetBlockReceipts:
-		err = f.client.CallContext(ctx, &result, "eth_getBlockReceipts", block.Hash)
+		err = f.client.CallContext(ctx, &result, receiptsRPCMethod(f.methodNames, m, "eth_getBlockReceipts"), block.Hash)
 	case ErigonGetBlockReceiptsByBlockHash:
-		err = f.client.CallContext(ctx, &result, "erigon_getBlockReceiptsByBlockHash", block.Hash)
+		err = f.client.CallContext(ctx, &result, receiptsRPCMethod(f.methodNames, m, "erigon_getBlockReceiptsByBlockHash"), block.Hash)
+	case EthGetBlockReceiptsByNumber:
+		// the receipts may be of a reorged block at the same height, which is caught by the validation below
+		err = f.client.CallContext(ctx, &result, receiptsRPCMethod(f.methodNames, m, "eth_getBlockReceipts"), hexutil.EncodeUint64(block.Number))
+	case DebugGetBlockReceipts:
+		err = f.client.CallContext(ctx, &result, receiptsRPCMethod(f.methodNames, m, "debug_getBlockReceipts"), block.Hash)
 	default:
 		err = fmt.Errorf("unknown receipt fetching method: %d", uint64(m))
 	}
 
 	if err != nil {
+		// Pruned blocks say nothing about the availability of the method, so the method is kept.
+		if blockPruned(err) {
+			return nil, fmt.Errorf("%w: %w", ErrBlockPruned, err)
+		}
 		f.OnReceiptsMethodErr(m, err)
 		return nil, err
 	}
 
-	if err = validateReceipts(block, blockInfo.ReceiptHash(), txHashes, result); err != nil {
+	// Some providers return null instead of an error for block-level receipt methods on some blocks.
+	// A block with transactions can never have an empty list of receipts, so we downgrade to
+	// per-tx fetching for this block only, without marking the method as unavailable.
+	if m != EthGetTransactionReceiptBatch && len(result) == 0 && len(txHashes) > 0 {
+		f.log.Debug("got null receipts response for non-empty block, falling back to per-tx receipt fetching",
+			"block", block, "method", m, "txs", len(txHashes))
+		perTxFallback = true
+		result, err = f.fetchPerTx(ctx, blockInfo, txHashes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if perTxFallback || m == EthGetTransactionReceiptBatch {
+		err = f.validateReceipts(block, blockInfo.ReceiptHash(), txHashes, result)
+	} else {
+		result, err = f.validateBlockReceipts(block, blockInfo.ReceiptHash(), txHashes, result)
+	}
+	if err != nil {
+		// invalid receipts of a block-level method may make it unusable, like a root mismatch, see unusableMethod
+		if !perTxFallback && m != EthGetTransactionReceiptBatch {
+			f.OnReceiptsMethodErr(m, err)
+		}
 		return nil, err
 	}
 
+	if perTxFallback {
+		f.metrics.RecordReceiptsMethod(EthGetTransactionReceiptBatch.String())
+	} else {
+		f.metrics.RecordReceiptsMethod(m.String())
+	}
 	return
 }
 
+// validateReceipts validates the receipts of a block, only checking there is one for each transaction
+// if the RPC is trusted.
+func (f *RPCReceiptsFetcher) validateReceipts(block eth.BlockID, receiptHash common.Hash, txHashes []common.Hash, receipts types.Receipts) error {
+	if f.trustRPC {
+		return validateReceiptsCount(txHashes, receipts)
+	}
+	return validateReceipts(block, receiptHash, txHashes, receipts)
+}
+
+// validateBlockReceipts is like validateReceipts, for the receipts of a block-level method,
+// which are first put in the order of the given transaction hashes, see orderReceiptsByTxHash.
+func (f *RPCReceiptsFetcher) validateBlockReceipts(block eth.BlockID, receiptHash common.Hash, txHashes []common.Hash, receipts types.Receipts) (types.Receipts, error) {
+	receipts, err := orderReceiptsByTxHash(txHashes, receipts)
+	if err != nil {
+		return nil, err
+	}
+	return receipts, f.validateReceipts(block, receiptHash, txHashes, receipts)
+}
+
+// fetchPerTx fetches the receipts of the given block per tx, in batches of the effective batch size.
+// If the provider rejects a batch as too large, the effective batch size is halved, and the fetch is retried,
+// until the batch size cannot be reduced any further.
+func (f *RPCReceiptsFetcher) fetchPerTx(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (types.Receipts, error) {
+	for {
+		batchSize := f.EffectiveBatchSize()
+		result, err := f.basic.fetchReceipts(ctx, blockInfo, txHashes, batchSize)
+		if err == nil || !batchTooLarge(err) || batchSize <= 1 {
+			return result, err
+		}
+		// the uncompleted batch call of the block continues with its batch size, so it is started over
+		f.basic.deleteBatchCall(blockInfo.Hash())
+		f.onBatchTooLarge(batchSize, err)
+	}
+}
+
+// onBatchTooLarge halves the effective batch size, after a batch of the given size was rejected as too large.
+// Concurrent rejections of batches of the same size only halve the batch size once.
+func (f *RPCReceiptsFetcher) onBatchTooLarge(batchSize int, err error) {
+	f.batchSizeMu.Lock()
+	defer f.batchSizeMu.Unlock()
+	if reduced := max(batchSize/2, 1); reduced < f.batchSize {
+		f.log.Warn("RPC provider rejected receipts batch as too large, reducing batch size",
+			"provider_kind", f.provKind, "batch_size", batchSize, "reduced", reduced, "err", err)
+		f.batchSize = reduced
+		f.lastBatchSizeChange = time.Now()
+	}
+}
+
+// EffectiveBatchSize returns the batch size that per-tx receipt fetching currently uses.
+// It starts at the configured max batch size, is halved each time the provider rejects a batch as too large,
+// and doubles back up every method reset duration, until it is back at the max batch size.
+func (f *RPCReceiptsFetcher) EffectiveBatchSize() int {
+	f.batchSizeMu.Lock()
+	defer f.batchSizeMu.Unlock()
+	if now := time.Now(); f.batchSize < f.basic.maxBatchSize && now.Sub(f.lastBatchSizeChange) > f.methodResetDuration {
+		f.batchSize = min(f.batchSize*2, f.basic.maxBatchSize)
+		f.lastBatchSizeChange = now
+	}
+	return f.batchSize
+}
+
+// labelReceiptsMethods are the receipt fetching methods that accept a block tag instead of a block hash.
+const labelReceiptsMethods = EthGetBlockReceipts | EthGetBlockReceiptsByNumber | ParityGetBlockReceipts
+
+// FetchReceiptsByLabel fetches the receipts of the block with the given label, with the best available method
+// that accepts a block tag: eth_getBlockReceipts or parity_getBlockReceipts, or their overridden names, see MethodNames.
+// Methods that require a block hash are skipped.
+// The block is derived from the block hash and number of the returned receipts, so it cannot be derived for a block
+// without transactions, and an error is returned instead.
+// The receipts are not validated, callers must validate them against the header of the returned block.
+func (f *RPCReceiptsFetcher) FetchReceiptsByLabel(ctx context.Context, label eth.BlockLabel) (eth.BlockID, types.Receipts, error) {
+	available := f.AvailableMethods() & labelReceiptsMethods
+	var m ReceiptsFetchingMethod
+	var method string
+	switch {
+	case available&EthGetBlockReceipts != 0:
+		m, method = EthGetBlockReceipts, "eth_getBlockReceipts"
+	case available&EthGetBlockReceiptsByNumber != 0:
+		m, method = EthGetBlockReceiptsByNumber, "eth_getBlockReceipts"
+	case available&ParityGetBlockReceipts != 0:
+		m, method = ParityGetBlockReceipts, "parity_getBlockReceipts"
+	default:
+		return eth.BlockID{}, nil, fmt.Errorf("no receipt fetching method that accepts a block label is available, available: %s", f.AvailableMethods())
+	}
+
+	var result types.Receipts
+	if err := f.client.CallContext(ctx, &result, receiptsRPCMethod(f.methodNames, m, method), label.Arg()); err != nil {
+		f.OnReceiptsMethodErr(m, err)
+		return eth.BlockID{}, nil, err
+	}
+	if len(result) == 0 {
+		return eth.BlockID{}, nil, fmt.Errorf("no receipts to derive the %s block from", label)
+	}
+	first := result[0]
+	if first == nil || first.BlockNumber == nil {
+		return eth.BlockID{}, nil, fmt.Errorf("receipts of the %s block are missing the block number", label)
+	}
+	return eth.BlockID{Hash: first.BlockHash, Number: first.BlockNumber.Uint64()}, result, nil
+}
+
+// L1FeeReceiptsMethods are the receipt fetching methods that return receipts as JSON, which includes the L1 fee fields
+// of OP receipts. Only debug_getRawReceipts does not preserve them, since it returns consensus-encoded receipts.
+const L1FeeReceiptsMethods = EthGetTransactionReceiptBatch | AlchemyGetTransactionReceipts | ParityGetBlockReceipts |
+	EthGetBlockReceipts | ErigonGetBlockReceiptsByBlockHash | EthGetBlockReceiptsByNumber | DebugGetBlockReceipts
+
+// ErrL1FeeFieldsUnsupported is returned when L2 receipts are fetched, but the configured method preference
+// only allows methods that do not preserve the L1 fee fields of receipts.
+var ErrL1FeeFieldsUnsupported = errors.New("receipt fetching methods do not preserve L1 fee fields")
+
+// FetchL2Receipts fetches and validates the receipts of the given L2 block, like FetchReceipts, preserving
+// the L1 fee fields of the receipts. It picks the best available method of the L1FeeReceiptsMethods.
+// If a method preference is configured, but none of the preferred methods preserves the L1 fee fields,
+// ErrL1FeeFieldsUnsupported is returned, rather than silently falling back to another method.
+func (f *RPCReceiptsFetcher) FetchL2Receipts(ctx context.Context, blockInfo eth.BlockInfo, txHashes []common.Hash) (types.Receipts, error) {
+	if len(f.methodPreference) > 0 {
+		var supported bool
+		for _, m := range f.methodPreference {
+			supported = supported || m&L1FeeReceiptsMethods != 0
+		}
+		if !supported {
+			return nil, fmt.Errorf("%w: preferred methods: %v", ErrL1FeeFieldsUnsupported, f.methodPreference)
+		}
+	}
+	m := f.pickMethod(f.AvailableMethods()&L1FeeReceiptsMethods, uint64(len(txHashes)))
+	return f.fetchReceiptsWithMethod(ctx, m, blockInfo, txHashes)
+}
+
+// FetchReceiptsForBlocks fetches and validates the receipts of multiple blocks, with eth_getBlockReceipts calls
+// of all blocks in JSON-RPC batches of up to the effective batch size, to save round-trips on range scans.
+// txHashes are the transaction hashes of each block, in the order of blocks. The receipts are returned by block hash.
+// Blocks for which the provider returns no receipts are fetched like FetchReceipts instead.
+// Unlike FetchReceipts, the method is used regardless of the available methods, and errors do not affect them.
+func (f *RPCReceiptsFetcher) FetchReceiptsForBlocks(ctx context.Context, blocks []eth.BlockInfo, txHashes [][]common.Hash) (map[common.Hash]types.Receipts, error) {
+	if len(blocks) != len(txHashes) {
+		return nil, fmt.Errorf("got tx hashes of %d blocks, but %d blocks", len(txHashes), len(blocks))
+	}
+	method := receiptsRPCMethod(f.methodNames, EthGetBlockReceipts, "eth_getBlockReceipts")
+	results := make([]types.Receipts, len(blocks))
+	out := make(map[common.Hash]types.Receipts, len(blocks))
+	for start := 0; start < len(blocks); {
+		end := min(start+f.EffectiveBatchSize(), len(blocks))
+		batch := make([]rpc.BatchElem, 0, end-start)
+		for i := start; i < end; i++ {
+			batch = append(batch, rpc.BatchElem{Method: method, Args: []any{blocks[i].Hash()}, Result: &results[i]})
+		}
+		if err := f.client.BatchCallContext(ctx, batch); err != nil {
+			return nil, fmt.Errorf("failed to fetch receipts of blocks %d to %d: %w", blocks[start].NumberU64(), blocks[end-1].NumberU64(), err)
+		}
+		for i := start; i < end; i++ {
+			block := eth.ToBlockID(blocks[i])
+			if err := batch[i-start].Error; err != nil {
+				return nil, fmt.Errorf("failed to fetch receipts of block %s: %w", block, err)
+			}
+			receipts := results[i]
+			var err error
+			if len(receipts) == 0 && len(txHashes[i]) > 0 {
+				if receipts, err = f.FetchReceipts(ctx, blocks[i], txHashes[i]); err != nil {
+					return nil, fmt.Errorf("failed to fetch receipts of block %s: %w", block, err)
+				}
+			} else if receipts, err = f.validateBlockReceipts(block, blocks[i].ReceiptHash(), txHashes[i], receipts); err != nil {
+				return nil, fmt.Errorf("invalid receipts of block %s: %w", block, err)
+			}
+			out[block.Hash] = receipts
+		}
+		start = end
+	}
+	return out, nil
+}
+
+// ProbeMethods probes which of the available receipt methods the provider supports, with a test call of each method
+// for the receipts of the latest block. Methods that the provider rejects as unusable are made unavailable,
+// like on the first real fetch, so they are not discovered to be unsupported during derivation.
+// Like any other fallback, rejected methods are reset after the method reset duration,
+// unless the provider does not implement them at all.
+// Per-tx fetching is not probed, since it is the fallback of last resort.
+// The supported methods are logged, so operators can confirm the configured RPC provider kind.
+func (f *RPCReceiptsFetcher) ProbeMethods(ctx context.Context) error {
+	head, err := probeReceiptMethods(ctx, f.client, f.AvailableMethods(), f.methodNames, f.OnReceiptsMethodErr)
+	if err != nil {
+		return err
+	}
+	f.log.Info("probed receipt fetching methods", "provider_kind", f.provKind,
+		"block", head, "supported", f.AvailableMethods())
+	return nil
+}
+
+// DetectProviderKind probes which receipt methods the provider supports, with a test call of each method for the
+// receipts of the latest block, and returns the provider kind using the most of them, all of which must be supported.
+// If no kind uses any of the supported optimized methods, RPCKindStandard is returned.
+func DetectProviderKind(ctx context.Context, client rpcClient) (RPCProviderKind, error) {
+	var unsupported ReceiptsFetchingMethod
+	probed := AvailableReceiptsFetchingMethods(RPCKindAny)
+	if _, err := probeReceiptMethods(ctx, client, probed, nil, func(m ReceiptsFetchingMethod, _ error) {
+		unsupported |= m
+	}); err != nil {
+		return "", err
+	}
+	supported := probed &^ unsupported
+
+	// Ties are resolved in favor of the standard kind, then in the order of RPCProviderKinds.
+	// Kinds using only per-tx fetching do not count as a match, since every provider supports it.
+	best, bestCount := RPCKindStandard, 1
+	for _, kind := range append([]RPCProviderKind{RPCKindStandard}, RPCProviderKinds...) {
+		if kind == RPCKindAny {
+			continue
+		}
+		methods := AvailableReceiptsFetchingMethods(kind)
+		if methods&^supported != 0 {
+			continue
+		}
+		if count := bits.OnesCount64(uint64(methods)); count > bestCount {
+			best, bestCount = kind, count
+		}
+	}
+	return best, nil
+}
+
+// probeReceiptMethods makes a test call of each of the given methods, except per-tx fetching,
+// for the receipts of the latest block, which is returned. onErr is called with each method that errors.
+// The RPC method names may be overridden by methodNames, see RPCReceiptsConfig.MethodNames.
+func probeReceiptMethods(ctx context.Context, client rpcClient, methods ReceiptsFetchingMethod, methodNames map[ReceiptsFetchingMethod]string, onErr func(m ReceiptsFetchingMethod, err error)) (eth.BlockID, error) {
+	var head struct {
+		Hash   common.Hash    `json:"hash"`
+		Number hexutil.Uint64 `json:"number"`
+	}
+	if err := client.CallContext(ctx, &head, "eth_getBlockByNumber", "latest", false); err != nil {
+		return eth.BlockID{}, fmt.Errorf("failed to fetch latest block to probe receipt methods with: %w", err)
+	}
+	for m := ReceiptsFetchingMethod(1); m <= methods; m <<= 1 {
+		if methods&m == 0 || m == EthGetTransactionReceiptBatch {
+			continue
+		}
+		var method string
+		var arg any = head.Hash
+		switch m {
+		case AlchemyGetTransactionReceipts:
+			method, arg = "alchemy_getTransactionReceipts", blockHashParameter{BlockHash: head.Hash}
+		case DebugGetRawReceipts:
+			method = "debug_getRawReceipts"
+		case ParityGetBlockReceipts:
+			method = "parity_getBlockReceipts"
+		case EthGetBlockReceipts:
+			method = "eth_getBlockReceipts"
+		case ErigonGetBlockReceiptsByBlockHash:
+			method = "erigon_getBlockReceiptsByBlockHash"
+		case EthGetBlockReceiptsByNumber:
+			method, arg = "eth_getBlockReceipts", head.Number.String()
+		case DebugGetBlockReceipts:
+			method = "debug_getBlockReceipts"
+		default:
+			continue
+		}
+		var result json.RawMessage
+		if err := client.CallContext(ctx, &result, receiptsRPCMethod(methodNames, m, method), arg); err != nil {
+			if ctx.Err() != nil {
+				return eth.BlockID{}, fmt.Errorf("probing %s: %w", m, ctx.Err())
+			}
+			onErr(m, err)
+		}
+	}
+	return eth.BlockID{Hash: head.Hash, Number: uint64(head.Number)}, nil
+}
+
+// receiptsRPCMethod returns the name of the RPC method to call for the given receipt fetching method,
+// which is the given standard name, unless it is overridden by methodNames.
+func receiptsRPCMethod(methodNames map[ReceiptsFetchingMethod]string, m ReceiptsFetchingMethod, name string) string {
+	if override := methodNames[m]; override != "" {
+		return override
+	}
+	return name
+}
+
 // receiptsWrapper is a decoding type util. Alchemy in particular wraps the receipts array result.
 type receiptsWrapper struct {
 	Receipts []*types.Receipt `json:"receipts"`
@@ -119,26 +627,82 @@ type receiptsWrapper struct {
 
 func (f *RPCReceiptsFetcher) PickReceiptsMethod(txCount int) ReceiptsFetchingMethod {
 	txc := uint64(txCount)
-	if now := time.Now(); now.Sub(f.lastMethodsReset) > f.methodResetDuration {
-		m := AvailableReceiptsFetchingMethods(f.provKind)
-		if f.availableReceiptMethods != m {
+	f.methodsMu.Lock()
+	defer f.methodsMu.Unlock()
+	// there is nothing to reset if no method fell back, except for the disabled methods, which are never reset
+	if m := AvailableReceiptsFetchingMethods(f.provKind) &^ f.disabledReceiptMethods; f.availableReceiptMethods != m {
+		if now := time.Now(); now.Sub(f.lastMethodsReset) > f.methodResetDuration {
 			f.log.Warn("resetting back RPC preferences, please review RPC provider kind setting", "kind", f.provKind.String())
+			f.availableReceiptMethods = m
+			f.lastMethodsReset = now
 		}
-		f.availableReceiptMethods = m
-		f.lastMethodsReset = now
 	}
-	return PickBestReceiptsFetchingMethod(f.provKind, f.availableReceiptMethods, txc)
+	return f.pickMethod(f.availableReceiptMethods, txc)
+}
+
+// pickMethod picks the available method to fetch the given number of tx receipts with,
+// by the configured method preference if any, or else by the built-in heuristic.
+func (f *RPCReceiptsFetcher) pickMethod(available ReceiptsFetchingMethod, txCount uint64) ReceiptsFetchingMethod {
+	if len(f.methodPreference) > 0 {
+		return PickPreferredReceiptsFetchingMethod(f.methodPreference, available)
+	}
+	return PickBestReceiptsFetchingMethod(f.provKind, available, txCount)
+}
+
+// AvailableMethods returns the receipt methods that are currently available.
+// Methods that failed are unavailable until the available methods are reset, see LastMethodResetAt.
+func (f *RPCReceiptsFetcher) AvailableMethods() ReceiptsFetchingMethod {
+	f.methodsMu.Lock()
+	defer f.methodsMu.Unlock()
+	return f.availableReceiptMethods
+}
+
+// LastMethodResetAt returns when the available receipt methods were last reset to all methods of the provider kind,
+// except for the disabled methods, see DisabledMethods, or when a method first fell back after that, if later.
+// The next reset is attempted once the configured method reset duration has passed since,
+// so a method that fell back is not made available again right away after a long time without fallbacks.
+// Nothing is reset while no method fell back.
+func (f *RPCReceiptsFetcher) LastMethodResetAt() time.Time {
+	f.methodsMu.Lock()
+	defer f.methodsMu.Unlock()
+	return f.lastMethodsReset
+}
+
+// DisabledMethods returns the receipt methods that the RPC does not implement, according to a "method not found" error.
+// Unlike methods that failed otherwise, these are not made available again when the available methods are reset.
+func (f *RPCReceiptsFetcher) DisabledMethods() ReceiptsFetchingMethod {
+	f.methodsMu.Lock()
+	defer f.methodsMu.Unlock()
+	return f.disabledReceiptMethods
 }
 
 func (f *RPCReceiptsFetcher) OnReceiptsMethodErr(m ReceiptsFetchingMethod, err error) {
 	if unusableMethod(err) {
-		// clear the bit of the method that errored
+		// clear the bit of the method that errored,
+		// and keep it cleared on resets if the RPC does not implement the method at all
+		notFound := methodNotFound(err)
+		f.methodsMu.Lock()
+		if f.availableReceiptMethods == AvailableReceiptsFetchingMethods(f.provKind)&^f.disabledReceiptMethods {
+			// the first fallback since the last reset starts the reset duration
+			f.lastMethodsReset = time.Now()
+		}
 		f.availableReceiptMethods &^= m
-		f.log.Warn("failed to use selected RPC method for receipt fetching, temporarily falling back to alternatives",
-			"provider_kind", f.provKind, "failed_method", m, "fallback", f.availableReceiptMethods, "err", err)
+		if notFound {
+			f.disabledReceiptMethods |= m
+		}
+		fallback := f.availableReceiptMethods
+		f.methodsMu.Unlock()
+		f.metrics.RecordReceiptsMethodFallback(m.String())
+		if notFound {
+			f.log.Warn("RPC method for receipt fetching not found, permanently falling back to alternatives",
+				"provider_kind", f.provKind, "failed_method", m, "fallback", fallback, "err", err)
+		} else {
+			f.log.Warn("failed to use selected RPC method for receipt fetching, temporarily falling back to alternatives",
+				"provider_kind", f.provKind, "failed_method", m, "fallback", fallback, "err", err)
+		}
 	} else {
 		f.log.Debug("failed to use selected RPC method for receipt fetching, but method does appear to be available, so we continue to use it",
-			"provider_kind", f.provKind, "failed_method", m, "fallback", f.availableReceiptMethods&^m, "err", err)
+			"provider_kind", f.provKind, "failed_method", m, "fallback", f.AvailableMethods()&^m, "err", err)
 	}
 }
 
@@ -166,6 +730,7 @@ const (
 	RPCKindNethermind RPCProviderKind = "nethermind"
 	RPCKindDebugGeth  RPCProviderKind = "debug_geth"
 	RPCKindErigon     RPCProviderKind = "erigon"
+	RPCKindReth       RPCProviderKind = "reth"
 	RPCKindBasic      RPCProviderKind = "basic"    // try only the standard most basic receipt fetching
 	RPCKindAny        RPCProviderKind = "any"      // try any method available
 	RPCKindStandard   RPCProviderKind = "standard" // try standard methods, including newer optimized standard RPC methods
@@ -179,6 +744,7 @@ var RPCProviderKinds = []RPCProviderKind{
 	RPCKindNethermind,
 	RPCKindDebugGeth,
 	RPCKindErigon,
+	RPCKindReth,
 	RPCKindBasic,
 	RPCKindAny,
 	RPCKindStandard,
@@ -214,26 +780,90 @@ func ValidRPCProviderKind(value RPCProviderKind) bool {
 // Depending on errors, tx counts and preferences the code may select different sets of fetching methods.
 type ReceiptsFetchingMethod uint64
 
+// receiptsFetchingMethodNames are the names of the individual receipt fetching methods, in the order String lists them,
+// and their labels, as returned by Name.
+var receiptsFetchingMethodNames = []struct {
+	method ReceiptsFetchingMethod
+	name   string
+	label  string
+}{
+	{EthGetTransactionReceiptBatch, "eth_getTransactionReceipt (batched)", "eth_getTransactionReceipt_batch"},
+	{AlchemyGetTransactionReceipts, "alchemy_getTransactionReceipts", "alchemy_getTransactionReceipts"},
+	{DebugGetRawReceipts, "debug_getRawReceipts", "debug_getRawReceipts"},
+	{ParityGetBlockReceipts, "parity_getBlockReceipts", "parity_getBlockReceipts"},
+	{EthGetBlockReceipts, "eth_getBlockReceipts", "eth_getBlockReceipts"},
+	{ErigonGetBlockReceiptsByBlockHash, "erigon_getBlockReceiptsByBlockHash", "erigon_getBlockReceiptsByBlockHash"},
+	{EthGetBlockReceiptsByNumber, "eth_getBlockReceipts (by number)", "eth_getBlockReceipts_by_number"},
+	{DebugGetBlockReceipts, "debug_getBlockReceipts", "debug_getBlockReceipts"},
+}
+
+// InvalidReceiptsFetchingMethodName is the Name of anything but a single known receipt fetching method.
+const InvalidReceiptsFetchingMethodName = "invalid"
+
+// Methods decomposes the methods into the individual methods, lowest bit first.
+// Unknown bits are included as well, see Name.
+func (r ReceiptsFetchingMethod) Methods() []ReceiptsFetchingMethod {
+	methods := make([]ReceiptsFetchingMethod, 0, bits.OnesCount64(uint64(r)))
+	for x := uint64(r); x != 0; x &= x - 1 {
+		methods = append(methods, ReceiptsFetchingMethod(1)<<bits.TrailingZeros64(x))
+	}
+	return methods
+}
+
+// Name returns a stable label of a single receipt fetching method, e.g. to label metrics by method.
+// Unlike String, it does not describe multiple methods: it returns InvalidReceiptsFetchingMethodName
+// for anything but a single known method.
+func (r ReceiptsFetchingMethod) Name() string {
+	for _, m := range receiptsFetchingMethodNames {
+		if m.method == r {
+			return m.label
+		}
+	}
+	return InvalidReceiptsFetchingMethodName
+}
+
 func (r ReceiptsFetchingMethod) String() string {
-	out := ""
+	var names []string
 	x := r
-	addMaybe := func(m ReceiptsFetchingMethod, v string) {
-		if x&m != 0 {
-			out += v
-			x ^= x & m
+	for _, m := range receiptsFetchingMethodNames {
+		if x&m.method != 0 {
+			names = append(names, m.name)
+			x &^= m.method
 		}
-		if x != 0 { // add separator if there are entries left
-			out += ", "
+	}
+	if x != 0 { // if anything is left, describe it as unknown
+		names = append(names, "unknown")
+	}
+	return strings.Join(names, ", ")
+}
+
+// ParseReceiptsFetchingMethod parses the name of a single receipt fetching method, as described by String.
+func ParseReceiptsFetchingMethod(name string) (ReceiptsFetchingMethod, error) {
+	for _, m := range receiptsFetchingMethodNames {
+		if m.name == name {
+			return m.method, nil
 		}
 	}
-	addMaybe(EthGetTransactionReceiptBatch, "eth_getTransactionReceipt (batched)")
-	addMaybe(AlchemyGetTransactionReceipts, "alchemy_getTransactionReceipts")
-	addMaybe(DebugGetRawReceipts, "debug_getRawReceipts")
-	addMaybe(ParityGetBlockReceipts, "parity_getBlockReceipts")
-	addMaybe(EthGetBlockReceipts, "eth_getBlockReceipts")
-	addMaybe(ErigonGetBlockReceiptsByBlockHash, "erigon_getBlockReceiptsByBlockHash")
-	addMaybe(^ReceiptsFetchingMethod(0), "unknown") // if anything is left, describe it as unknown
-	return out
+	return 0, fmt.Errorf("unknown receipts fetching method: %q", name)
+}
+
+// MarshalText encodes a single receipt fetching method by its name, to configure it in config files.
+func (r ReceiptsFetchingMethod) MarshalText() ([]byte, error) {
+	for _, m := range receiptsFetchingMethodNames {
+		if m.method == r {
+			return []byte(m.name), nil
+		}
+	}
+	return nil, fmt.Errorf("not a single receipts fetching method: %s", r)
+}
+
+func (r *ReceiptsFetchingMethod) UnmarshalText(text []byte) error {
+	m, err := ParseReceiptsFetchingMethod(string(text))
+	if err != nil {
+		return err
+	}
+	*r = m
+	return nil
 }
 
 const (
@@ -313,12 +943,35 @@ const (
 	// See:
 	// https://github.com/ledgerwatch/erigon/blob/287a3d1d6c90fc6a7a088b5ae320f93600d5a167/cmd/rpcdaemon/commands/erigon_receipts.go#LL391C24-L391C51
 	ErigonGetBlockReceiptsByBlockHash
+	// EthGetBlockReceiptsByNumber is the same as EthGetBlockReceipts, but with a block-number argument,
+	// for nodes which do not accept a block-hash argument to the method in some configurations.
+	// The receipts are still validated against the hash of the requested block,
+	// so receipts of a block that was reorged at the same height are rejected.
+	// Available in:
+	//   - Reth
+	//   - Besu
+	// Method: eth_getBlockReceipts
+	// Params:
+	//   - string, hex-encoded block number
+	// Returns: array of receipts
+	// See: https://ethereum.github.io/execution-apis/api-documentation/
+	EthGetBlockReceiptsByNumber
+	// DebugGetBlockReceipts is a debug method returning the decoded receipts of a block,
+	// unlike DebugGetRawReceipts, which returns the consensus-encoded receipts.
+	// Available in:
+	//   - QuickNode: 22 credits total, like the other debug methods in the price table
+	//   - Geth: free
+	// Method: debug_getBlockReceipts
+	// Params:
+	//   - string, hex-encoded block hash
+	// Returns: array of receipts
+	// See: https://www.quicknode.com/docs/ethereum/debug_getBlockReceipts
+	DebugGetBlockReceipts
 
 	// Other:
 	//  - 250 credits, not supported, strictly worse than other options. In quicknode price-table.
 	// qn_getBlockWithReceipts - in price table, ? undocumented, but in quicknode "Single Flight RPC" description
 	// qn_getReceipts          - in price table, ? undocumented, but in quicknode "Single Flight RPC" description
-	// debug_getBlockReceipts  - ? undocumented, shows up in quicknode price table, not available.
 )
 
 // AvailableReceiptsFetchingMethods selects receipt fetching methods based on the RPC provider kind.
@@ -327,7 +980,7 @@ func AvailableReceiptsFetchingMethods(kind RPCProviderKind) ReceiptsFetchingMeth
 	case RPCKindAlchemy:
 		return AlchemyGetTransactionReceipts | EthGetBlockReceipts | EthGetTransactionReceiptBatch
 	case RPCKindQuickNode:
-		return DebugGetRawReceipts | EthGetBlockReceipts | EthGetTransactionReceiptBatch
+		return DebugGetRawReceipts | DebugGetBlockReceipts | EthGetBlockReceipts | EthGetTransactionReceiptBatch
 	case RPCKindInfura:
 		// Infura is big, but sadly does not support more optimized receipts fetching methods (yet?)
 		return EthGetTransactionReceiptBatch
@@ -339,13 +992,15 @@ func AvailableReceiptsFetchingMethods(kind RPCProviderKind) ReceiptsFetchingMeth
 		return DebugGetRawReceipts | EthGetTransactionReceiptBatch
 	case RPCKindErigon:
 		return ErigonGetBlockReceiptsByBlockHash | EthGetTransactionReceiptBatch
+	case RPCKindReth:
+		return EthGetBlockReceiptsByNumber | EthGetTransactionReceiptBatch
 	case RPCKindBasic:
 		return EthGetTransactionReceiptBatch
 	case RPCKindAny:
 		// if it's any kind of RPC provider, then try all methods
 		return AlchemyGetTransactionReceipts | EthGetBlockReceipts |
 			DebugGetRawReceipts | ErigonGetBlockReceiptsByBlockHash |
-			ParityGetBlockReceipts | EthGetTransactionReceiptBatch
+			ParityGetBlockReceipts | EthGetBlockReceiptsByNumber | DebugGetBlockReceipts | EthGetTransactionReceiptBatch
 	case RPCKindStandard:
 		return EthGetBlockReceipts | EthGetTransactionReceiptBatch
 	default:
@@ -353,6 +1008,30 @@ func AvailableReceiptsFetchingMethods(kind RPCProviderKind) ReceiptsFetchingMeth
 	}
 }
 
+// DefaultMethodResetDuration returns the default method reset duration of the RPC provider kind, i.e. how long
+// receipt methods that fell back stay unavailable before they are attempted again. Kinds that only fetch receipts
+// per tx have nothing better to recover to, so their methods are only reset rarely, to not keep probing a method
+// that keeps failing.
+func DefaultMethodResetDuration(kind RPCProviderKind) time.Duration {
+	switch kind {
+	case RPCKindBasic, RPCKindInfura:
+		return time.Hour
+	default:
+		return time.Minute
+	}
+}
+
+// PickPreferredReceiptsFetchingMethod selects the first method of the given preference that is still available,
+// or falls back on per-tx fetching if none of them is.
+func PickPreferredReceiptsFetchingMethod(preference []ReceiptsFetchingMethod, available ReceiptsFetchingMethod) ReceiptsFetchingMethod {
+	for _, m := range preference {
+		if available&m != 0 {
+			return m
+		}
+	}
+	return EthGetTransactionReceiptBatch
+}
+
 // PickBestReceiptsFetchingMethod selects an RPC method that is still available,
 // and optimal for fetching the given number of tx receipts from the specified provider kind.
 func PickBestReceiptsFetchingMethod(kind RPCProviderKind, available ReceiptsFetchingMethod, txCount uint64) ReceiptsFetchingMethod {
@@ -370,6 +1049,9 @@ func PickBestReceiptsFetchingMethod(kind RPCProviderKind, available ReceiptsFetc
 		if available&DebugGetRawReceipts != 0 {
 			return DebugGetRawReceipts
 		}
+		if available&DebugGetBlockReceipts != 0 && txCount > 22/2 {
+			return DebugGetBlockReceipts
+		}
 		if available&EthGetBlockReceipts != 0 && txCount > 59/2 {
 			return EthGetBlockReceipts
 		}
@@ -382,6 +1064,9 @@ func PickBestReceiptsFetchingMethod(kind RPCProviderKind, available ReceiptsFetc
 	if available&DebugGetRawReceipts != 0 {
 		return DebugGetRawReceipts
 	}
+	if available&DebugGetBlockReceipts != 0 {
+		return DebugGetBlockReceipts
+	}
 	if available&ErigonGetBlockReceiptsByBlockHash != 0 {
 		return ErigonGetBlockReceiptsByBlockHash
 	}
@@ -391,6 +1076,9 @@ func PickBestReceiptsFetchingMethod(kind RPCProviderKind, available ReceiptsFetc
 	if available&ParityGetBlockReceipts != 0 {
 		return ParityGetBlockReceipts
 	}
+	if available&EthGetBlockReceiptsByNumber != 0 {
+		return EthGetBlockReceiptsByNumber
+	}
 	// otherwise fall back on per-tx fetching
 	return EthGetTransactionReceiptBatch
 }
@@ -0,0 +1,1077 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources/batching"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// serveReceiptsBatch returns a batchCallFn that serves eth_getTransactionReceipt requests from the given receipts.
+func serveReceiptsBatch(receipts []*types.Receipt, calls *int) func(ctx context.Context, b []rpc.BatchElem) error {
+	recMap := make(map[common.Hash]*types.Receipt, len(receipts))
+	for _, rec := range receipts {
+		recMap[rec.TxHash] = rec
+	}
+	return func(_ context.Context, b []rpc.BatchElem) error {
+		*calls++
+		for i := range b {
+			if b[i].Method != "eth_getTransactionReceipt" {
+				return fmt.Errorf("unexpected method %s", b[i].Method)
+			}
+			**(b[i].Result.(**types.Receipt)) = *recMap[b[i].Args[0].(common.Hash)]
+		}
+		return nil
+	}
+}
+
+func TestRPCReceiptsFetcher_NullBlockResponse(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	var blockCalls, batchCalls int
+	mrpc := &simpleMockRPC{
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			require.Equal(t, "eth_getBlockReceipts", method)
+			blockCalls++
+			return nil // leave the result untouched, like a null response
+		},
+		batchCallFn: serveReceiptsBatch(receipts, &batchCalls),
+	}
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindStandard,
+		MethodResetDuration: time.Minute,
+	})
+
+	recs, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	require.Len(t, recs, len(receipts))
+	for i, rec := range recs {
+		requireEqualReceipt(t, receipts[i], rec)
+	}
+	require.Equal(t, 1, blockCalls)
+	require.Equal(t, 1, batchCalls)
+
+	// the null response only downgrades the single fetch, the method remains available
+	require.Equal(t, EthGetBlockReceipts, rp.PickReceiptsMethod(len(txHashes)))
+}
+
+func TestRPCReceiptsFetcher_EmptyBlock(t *testing.T) {
+	block, _ := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 1)
+	block.Transactions = nil
+	block.ReceiptHash = types.EmptyRootHash
+	bInfo, _, err := block.Info(true, true)
+	require.NoError(t, err)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	var blockCalls, batchCalls int
+	mrpc := &simpleMockRPC{
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			blockCalls++
+			return nil
+		},
+		batchCallFn: serveReceiptsBatch(nil, &batchCalls),
+	}
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindStandard,
+		MethodResetDuration: time.Minute,
+	})
+
+	// a legitimately empty block is not downgraded
+	recs, err := rp.FetchReceipts(ctx, bInfo, nil)
+	require.NoError(t, err)
+	require.Empty(t, recs)
+	require.Equal(t, 1, blockCalls)
+	require.Zero(t, batchCalls)
+}
+
+func TestRPCReceiptsFetcher_BlockPruned(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 2)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	for _, msg := range []string{
+		"missing trie node 4a8dfa3be8baa8ad1c4b68b6f5c09ab8d1de2ae3b6c2f3d1e2bd6a6c4e3a1b2c (path )", // geth
+		"historical state 0x1b3c is not available",                                                   // geth
+		"block not found",                       // erigon, reth
+		"old data not available due to pruning", // erigon
+		"Pruned history unavailable",            // nethermind
+	} {
+		t.Run(msg, func(t *testing.T) {
+			mrpc := &simpleMockRPC{
+				callFn: func(_ context.Context, result any, method string, args ...any) error {
+					return errors.New(msg)
+				},
+			}
+			rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+				MaxBatchSize:        10,
+				ProviderKind:        RPCKindStandard,
+				MethodResetDuration: time.Minute,
+			})
+			m := rp.PickReceiptsMethod(len(txHashes))
+			require.Equal(t, EthGetBlockReceipts, m)
+
+			_, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+			require.ErrorIs(t, err, ErrBlockPruned)
+			require.ErrorContains(t, err, msg)
+			// the method is still available for blocks that are not pruned
+			require.Equal(t, m, rp.PickReceiptsMethod(len(txHashes)))
+		})
+	}
+
+	t.Run("other errors", func(t *testing.T) {
+		mrpc := &simpleMockRPC{
+			callFn: func(_ context.Context, result any, method string, args ...any) error {
+				return errors.New("upstream request timeout")
+			},
+		}
+		rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+			MaxBatchSize:        10,
+			ProviderKind:        RPCKindStandard,
+			MethodResetDuration: time.Minute,
+		})
+		_, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrBlockPruned)
+	})
+}
+
+type recordingReceiptsMetrics struct {
+	methods   []string
+	fallbacks []string
+}
+
+func (m *recordingReceiptsMetrics) RecordReceiptsMethod(method string) {
+	m.methods = append(m.methods, method)
+}
+
+func (m *recordingReceiptsMetrics) RecordReceiptsMethodFallback(method string) {
+	m.fallbacks = append(m.fallbacks, method)
+}
+
+func TestRPCReceiptsFetcher_Metrics(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	var batchCalls int
+	mrpc := &simpleMockRPC{
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			return errors.New("unknown method " + method)
+		},
+		batchCallFn: serveReceiptsBatch(receipts, &batchCalls),
+	}
+	m := new(recordingReceiptsMetrics)
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindStandard,
+		MethodResetDuration: time.Minute,
+		Metrics:             m,
+	})
+
+	_, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.ErrorContains(t, err, "unknown method")
+	require.Empty(t, m.methods)
+	require.Equal(t, []string{EthGetBlockReceipts.String()}, m.fallbacks)
+
+	_, err = rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	require.Equal(t, []string{EthGetTransactionReceiptBatch.String()}, m.methods)
+	require.Len(t, m.fallbacks, 1)
+}
+
+// TestRPCReceiptsFetcher_ConcurrentFallback fetches receipts concurrently while methods fall back,
+// which is run with the race detector in CI.
+func TestRPCReceiptsFetcher_ConcurrentFallback(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	var mu sync.Mutex
+	var batchCalls int
+	serve := serveReceiptsBatch(receipts, &batchCalls)
+	mrpc := &simpleMockRPC{
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			return errors.New("unknown method " + method)
+		},
+		batchCallFn: func(ctx context.Context, b []rpc.BatchElem) error {
+			mu.Lock()
+			defer mu.Unlock()
+			return serve(ctx, b)
+		},
+	}
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindStandard,
+		MethodResetDuration: time.Minute,
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// fetches racing with the fallback may still fail with the unavailable method
+			_, _ = rp.FetchReceipts(ctx, bInfo, txHashes)
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, EthGetTransactionReceiptBatch, rp.PickReceiptsMethod(len(txHashes)))
+	_, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+}
+
+func TestRPCReceiptsFetcher_MethodPreference(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	var methods []string
+	mrpc := &simpleMockRPC{
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			methods = append(methods, method)
+			return errors.New("unknown method " + method)
+		},
+	}
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindAny,
+		MethodResetDuration: time.Minute,
+		// the preference is respected regardless of the tx count
+		MethodPreference: []ReceiptsFetchingMethod{EthGetBlockReceipts, ParityGetBlockReceipts},
+	})
+
+	require.Equal(t, EthGetBlockReceipts, rp.PickReceiptsMethod(1))
+	_, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.Error(t, err)
+	require.Equal(t, ParityGetBlockReceipts, rp.PickReceiptsMethod(1))
+	_, err = rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.Error(t, err)
+	require.Equal(t, []string{"eth_getBlockReceipts", "parity_getBlockReceipts"}, methods)
+
+	// once no preferred method is available anymore, receipts are fetched per tx
+	require.Equal(t, EthGetTransactionReceiptBatch, rp.PickReceiptsMethod(1))
+
+	// methods that are not available for the provider kind are skipped
+	require.Equal(t, ErigonGetBlockReceiptsByBlockHash, PickPreferredReceiptsFetchingMethod(
+		[]ReceiptsFetchingMethod{EthGetBlockReceipts, ErigonGetBlockReceiptsByBlockHash},
+		AvailableReceiptsFetchingMethods(RPCKindErigon)))
+}
+
+func TestRPCReceiptsFetcher_TrustRPC(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	txHashes := receiptTxHashes(receipts)
+	// the receipts do not match the receipts root anymore
+	block.ReceiptHash = common.Hash{0x42}
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	mrpc := &simpleMockRPC{
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			*result.(*types.Receipts) = receipts
+			return nil
+		},
+	}
+	newFetcher := func(trustRPC bool) *RPCReceiptsFetcher {
+		return NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+			MaxBatchSize:        10,
+			ProviderKind:        RPCKindStandard,
+			MethodResetDuration: time.Minute,
+			TrustRPC:            trustRPC,
+		})
+	}
+
+	_, err := newFetcher(false).FetchReceipts(ctx, bInfo, txHashes)
+	require.ErrorContains(t, err, "expected receipt root")
+
+	recs, err := newFetcher(true).FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	require.Len(t, recs, len(receipts))
+
+	// the receipt count is still validated
+	_, err = newFetcher(true).FetchReceipts(ctx, bInfo, txHashes[1:])
+	require.ErrorContains(t, err, "got 4 receipts but expected 3")
+}
+
+func TestRPCReceiptsFetcher_OnProgress(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 5)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	var batchCalls int
+	mrpc := &simpleMockRPC{batchCallFn: serveReceiptsBatch(receipts, &batchCalls)}
+	var progress [][2]int
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        2,
+		ProviderKind:        RPCKindBasic,
+		MethodResetDuration: time.Minute,
+		OnProgress: func(done, total int) {
+			progress = append(progress, [2]int{done, total})
+		},
+	})
+
+	_, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	require.Equal(t, [][2]int{{2, 5}, {4, 5}, {5, 5}}, progress)
+}
+
+func TestRPCReceiptsFetcher_TimeoutDowngrade(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	newFetcher := func(disable bool) (*RPCReceiptsFetcher, *int) {
+		var batchCalls int
+		mrpc := &simpleMockRPC{
+			callFn: func(_ context.Context, result any, method string, args ...any) error {
+				return fmt.Errorf("request timed out: %w", context.DeadlineExceeded)
+			},
+			batchCallFn: serveReceiptsBatch(receipts, &batchCalls),
+		}
+		return NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+			MaxBatchSize:            10,
+			ProviderKind:            RPCKindStandard,
+			MethodResetDuration:     time.Minute,
+			DisableTimeoutDowngrade: disable,
+		}), &batchCalls
+	}
+
+	rp, batchCalls := newFetcher(false)
+	recs, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	require.Len(t, recs, len(receipts))
+	require.Equal(t, 1, *batchCalls)
+	// the timed out method remains available
+	require.Equal(t, EthGetBlockReceipts, rp.PickReceiptsMethod(len(txHashes)))
+
+	rp, batchCalls = newFetcher(true)
+	_, err = rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Zero(t, *batchCalls)
+}
+
+func TestRPCReceiptsFetcher_PerCallTimeout(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	var batchCalls int
+	mrpc := &simpleMockRPC{
+		callFn: func(ctx context.Context, result any, method string, args ...any) error {
+			// the call hangs until its context is done
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		batchCallFn: serveReceiptsBatch(receipts, &batchCalls),
+	}
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindStandard,
+		MethodResetDuration: time.Minute,
+		PerCallTimeout:      10 * time.Millisecond,
+	})
+	recs, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	require.Len(t, recs, len(receipts))
+	require.Equal(t, 1, batchCalls)
+	require.NoError(t, ctx.Err(), "the fetch does not wait for its own context")
+}
+
+func TestRPCReceiptsFetcher_PerCallTimeoutAllCalls(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	// every call hangs until its context is done
+	mrpc := &simpleMockRPC{
+		callFn: func(ctx context.Context, result any, method string, args ...any) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		batchCallFn: func(ctx context.Context, b []rpc.BatchElem) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+	newFetcher := func(kind RPCProviderKind) *RPCReceiptsFetcher {
+		return NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+			MaxBatchSize:        10,
+			ProviderKind:        kind,
+			MethodResetDuration: time.Minute,
+			PerCallTimeout:      10 * time.Millisecond,
+		})
+	}
+
+	t.Run("per-tx batches", func(t *testing.T) {
+		_, err := newFetcher(RPCKindBasic).FetchReceipts(ctx, bInfo, txHashes)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+	t.Run("by label", func(t *testing.T) {
+		_, _, err := newFetcher(RPCKindStandard).FetchReceiptsByLabel(ctx, eth.Unsafe)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+	t.Run("for blocks", func(t *testing.T) {
+		_, err := newFetcher(RPCKindStandard).FetchReceiptsForBlocks(ctx, []eth.BlockInfo{bInfo}, [][]common.Hash{txHashes})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+	require.NoError(t, ctx.Err(), "the calls do not wait for the context of the fetch")
+}
+
+func TestRPCReceiptsFetcher_MethodNames(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	var methods []string
+	mrpc := &simpleMockRPC{
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			methods = append(methods, method)
+			if method != "myprovider_getBlockReceipts" {
+				return &methodNotFoundError{method: method}
+			}
+			require.Equal(t, []any{block.Hash}, args)
+			dat, err := json.Marshal(receipts)
+			if err != nil {
+				return err
+			}
+			return json.Unmarshal(dat, result)
+		},
+	}
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindStandard,
+		MethodResetDuration: time.Minute,
+		MethodNames:         map[ReceiptsFetchingMethod]string{EthGetBlockReceipts: "myprovider_getBlockReceipts"},
+	})
+	recs, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	require.Len(t, recs, len(receipts))
+	require.Equal(t, []string{"myprovider_getBlockReceipts"}, methods)
+	require.Equal(t, EthGetBlockReceipts|EthGetTransactionReceiptBatch, rp.AvailableMethods())
+}
+
+func TestRPCReceiptsFetcher_MethodNamesByLabel(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	for _, tc := range []struct {
+		kind   RPCProviderKind
+		m      ReceiptsFetchingMethod
+		method string
+	}{
+		{kind: RPCKindStandard, m: EthGetBlockReceipts, method: "myprovider_getBlockReceipts"},
+		{kind: RPCKindParity, m: ParityGetBlockReceipts, method: "myprovider_getParityBlockReceipts"},
+	} {
+		t.Run(string(tc.kind), func(t *testing.T) {
+			var methods []string
+			mrpc := &simpleMockRPC{
+				callFn: func(_ context.Context, result any, method string, args ...any) error {
+					methods = append(methods, method)
+					require.Equal(t, []any{"latest"}, args)
+					dat, err := json.Marshal(receipts)
+					if err != nil {
+						return err
+					}
+					return json.Unmarshal(dat, result)
+				},
+			}
+			rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+				MaxBatchSize:        10,
+				ProviderKind:        tc.kind,
+				MethodResetDuration: time.Minute,
+				MethodNames:         map[ReceiptsFetchingMethod]string{tc.m: tc.method},
+			})
+			id, recs, err := rp.FetchReceiptsByLabel(ctx, eth.Unsafe)
+			require.NoError(t, err)
+			require.Equal(t, block.BlockID(), id)
+			require.Len(t, recs, len(receipts))
+			require.Equal(t, []string{tc.method}, methods)
+		})
+	}
+}
+
+func TestRPCReceiptsFetcher_FetchReceiptsForBlocks(t *testing.T) {
+	rng := rand.New(rand.NewSource(123))
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	var blocks []eth.BlockInfo
+	var txHashes [][]common.Hash
+	receiptsByBlock := make(map[common.Hash]types.Receipts)
+	for _, txCount := range []uint64{2, 1, 3} {
+		block, receipts := randomRpcBlockAndReceipts(rng, txCount)
+		bInfo, _, _ := block.Info(true, true)
+		blocks = append(blocks, bInfo)
+		txHashes = append(txHashes, receiptTxHashes(receipts))
+		receiptsByBlock[bInfo.Hash()] = receipts
+	}
+	// the provider returns null for the last block in a batch, which is then fetched on its own
+	nullBlock := blocks[2].Hash()
+
+	var batchCalls, blockCalls int
+	mrpc := &simpleMockRPC{
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			require.Equal(t, "eth_getBlockReceipts", method)
+			require.Equal(t, []any{nullBlock}, args)
+			blockCalls++
+			*result.(*types.Receipts) = receiptsByBlock[nullBlock]
+			return nil
+		},
+		batchCallFn: func(_ context.Context, b []rpc.BatchElem) error {
+			batchCalls++
+			for i := range b {
+				require.Equal(t, "eth_getBlockReceipts", b[i].Method)
+				if hash := b[i].Args[0].(common.Hash); hash != nullBlock {
+					*b[i].Result.(*types.Receipts) = receiptsByBlock[hash]
+				}
+			}
+			return nil
+		},
+	}
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        2,
+		ProviderKind:        RPCKindStandard,
+		MethodResetDuration: time.Minute,
+	})
+	out, err := rp.FetchReceiptsForBlocks(ctx, blocks, txHashes)
+	require.NoError(t, err)
+	require.Len(t, out, 3)
+	for hash, receipts := range receiptsByBlock {
+		require.Len(t, out[hash], len(receipts))
+	}
+	// 3 blocks in batches of 2
+	require.Equal(t, 2, batchCalls)
+	require.Equal(t, 1, blockCalls)
+
+	// receipts of the wrong block are rejected
+	receiptsByBlock[blocks[0].Hash()] = receiptsByBlock[nullBlock]
+	_, err = rp.FetchReceiptsForBlocks(ctx, blocks, txHashes)
+	require.ErrorContains(t, err, "invalid receipts of block")
+
+	_, err = rp.FetchReceiptsForBlocks(ctx, blocks, txHashes[:1])
+	require.ErrorContains(t, err, "got tx hashes of 1 blocks, but 3 blocks")
+}
+
+func TestRPCReceiptsFetcher_ReceiptRootMismatch(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	var batchCalls int
+	mrpc := &simpleMockRPC{
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			// a buggy method, which alters the status of the last receipt
+			dat, err := json.Marshal(receipts)
+			if err != nil {
+				return err
+			}
+			if err := json.Unmarshal(dat, result); err != nil {
+				return err
+			}
+			altered := *result.(*types.Receipts)
+			altered[3].Status = 1 - altered[3].Status
+			return nil
+		},
+		batchCallFn: serveReceiptsBatch(receipts, &batchCalls),
+	}
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindStandard,
+		MethodResetDuration: time.Minute,
+	})
+	_, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.ErrorIs(t, err, ErrReceiptRootMismatch)
+	// the method is not used anymore until the methods are reset
+	require.Equal(t, EthGetTransactionReceiptBatch, rp.AvailableMethods())
+
+	recs, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	require.Len(t, recs, len(receipts))
+	require.Equal(t, 1, batchCalls)
+}
+
+func TestRPCReceiptsFetcher_UnorderedBlockReceipts(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx := context.Background()
+
+	var response types.Receipts
+	var batchCalls int
+	mrpc := &simpleMockRPC{
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			dat, err := json.Marshal(response)
+			if err != nil {
+				return err
+			}
+			return json.Unmarshal(dat, result)
+		},
+		batchCallFn: serveReceiptsBatch(receipts, &batchCalls),
+	}
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindStandard,
+		MethodResetDuration: time.Minute,
+	})
+
+	response = types.Receipts{receipts[3], receipts[1], receipts[0], receipts[2]}
+	recs, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	for i, rec := range recs {
+		requireEqualReceipt(t, receipts[i], rec)
+	}
+
+	// a receipt of another block in place of a requested one
+	_, otherReceipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(456)), 1)
+	response = types.Receipts{receipts[3], otherReceipts[0], receipts[0], receipts[2]}
+	_, err = rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.ErrorIs(t, err, ErrMissingReceipt)
+	require.Zero(t, batchCalls)
+}
+
+func TestRPCReceiptsFetcher_AvailableMethods(t *testing.T) {
+	rp := NewRPCReceiptsFetcher(&simpleMockRPC{}, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindStandard,
+		MethodResetDuration: time.Minute,
+	})
+	require.Equal(t, EthGetBlockReceipts|EthGetTransactionReceiptBatch, rp.AvailableMethods())
+	resetAt := rp.LastMethodResetAt()
+	require.False(t, resetAt.IsZero())
+
+	rp.OnReceiptsMethodErr(EthGetBlockReceipts, errors.New("unknown method eth_getBlockReceipts"))
+	require.Equal(t, EthGetTransactionReceiptBatch, rp.AvailableMethods())
+
+	// the methods are reset once the reset duration passed
+	rp.methodResetDuration = 0
+	require.Equal(t, EthGetBlockReceipts, rp.PickReceiptsMethod(4))
+	require.Equal(t, EthGetBlockReceipts|EthGetTransactionReceiptBatch, rp.AvailableMethods())
+	require.True(t, rp.LastMethodResetAt().After(resetAt))
+}
+
+func TestRPCReceiptsFetcher_MethodResets(t *testing.T) {
+	rp := NewRPCReceiptsFetcher(&simpleMockRPC{}, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindStandard,
+		MethodResetDuration: time.Minute,
+	})
+	// no method fell back for a long time
+	rp.lastMethodsReset = time.Now().Add(-time.Hour)
+	require.Equal(t, EthGetBlockReceipts, rp.PickReceiptsMethod(4))
+	idleSince := rp.LastMethodResetAt()
+	require.WithinDuration(t, time.Now().Add(-time.Hour), idleSince, time.Second, "nothing to reset")
+
+	// the reset duration counts from the fallback, rather than from the last reset
+	rp.OnReceiptsMethodErr(EthGetBlockReceipts, errors.New("unknown method eth_getBlockReceipts"))
+	require.True(t, rp.LastMethodResetAt().After(idleSince))
+	require.Equal(t, EthGetTransactionReceiptBatch, rp.PickReceiptsMethod(4))
+	require.Equal(t, EthGetTransactionReceiptBatch, rp.AvailableMethods())
+
+	// once the only optimized method is disabled, there is nothing to reset anymore
+	rp.OnReceiptsMethodErr(EthGetBlockReceipts, &methodNotFoundError{method: "eth_getBlockReceipts"})
+	rp.methodResetDuration = 0
+	require.Equal(t, EthGetTransactionReceiptBatch, rp.PickReceiptsMethod(4))
+	resetAt := rp.LastMethodResetAt()
+	require.Equal(t, EthGetTransactionReceiptBatch, rp.PickReceiptsMethod(4))
+	require.Equal(t, resetAt, rp.LastMethodResetAt())
+}
+
+func TestDefaultMethodResetDuration(t *testing.T) {
+	require.Equal(t, time.Hour, DefaultMethodResetDuration(RPCKindBasic))
+	require.Equal(t, time.Hour, DefaultMethodResetDuration(RPCKindInfura))
+	require.Equal(t, time.Minute, DefaultMethodResetDuration(RPCKindAlchemy))
+	require.Equal(t, time.Minute, DefaultMethodResetDuration(RPCKindStandard))
+}
+
+func TestRPCReceiptsFetcher_ReceiptRetries(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	var batchCalls int
+	serve := serveReceiptsBatch(receipts, &batchCalls)
+	flaky := txHashes[2]
+	mrpc := &simpleMockRPC{
+		batchCallFn: func(ctx context.Context, b []rpc.BatchElem) error {
+			if err := serve(ctx, b); err != nil {
+				return err
+			}
+			// the receipt of one tx fails the first time it is requested
+			for i := range b {
+				if b[i].Args[0].(common.Hash) == flaky && batchCalls == 1 {
+					b[i].Error = errors.New("transient")
+				}
+			}
+			return nil
+		},
+	}
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindBasic,
+		MethodResetDuration: time.Minute,
+		ReceiptRetries:      batching.RetryPolicy{MaxAttempts: 2},
+	})
+
+	recs, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	require.Equal(t, receipts, []*types.Receipt(recs))
+	require.Equal(t, 2, batchCalls, "only the failed receipt is requested again")
+}
+
+func TestRPCReceiptsFetcher_BatchTooLarge(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 8)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	var batchCalls int
+	serve := serveReceiptsBatch(receipts, &batchCalls)
+	var batchSizes []int
+	mrpc := &simpleMockRPC{
+		batchCallFn: func(ctx context.Context, b []rpc.BatchElem) error {
+			batchSizes = append(batchSizes, len(b))
+			if len(b) > 3 {
+				return errors.New("batch too large")
+			}
+			return serve(ctx, b)
+		},
+	}
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindBasic,
+		MethodResetDuration: time.Minute,
+	})
+	require.Equal(t, 10, rp.EffectiveBatchSize())
+
+	recs, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	require.Equal(t, receipts, []*types.Receipt(recs))
+	// the batch size is halved until the provider accepts the batches
+	require.Equal(t, []int{8, 5, 2, 2, 2, 2}, batchSizes)
+	require.Equal(t, 2, rp.EffectiveBatchSize())
+
+	// the reduced batch size is remembered
+	batchSizes = nil
+	_, err = rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 2, 2, 2}, batchSizes)
+
+	// and slowly recovers back up
+	rp.lastBatchSizeChange = time.Now().Add(-2 * time.Minute)
+	require.Equal(t, 4, rp.EffectiveBatchSize())
+	require.Equal(t, 4, rp.EffectiveBatchSize(), "batch size only doubles once per reset duration")
+	rp.lastBatchSizeChange = time.Now().Add(-2 * time.Minute)
+	require.Equal(t, 8, rp.EffectiveBatchSize())
+	rp.lastBatchSizeChange = time.Now().Add(-2 * time.Minute)
+	require.Equal(t, 10, rp.EffectiveBatchSize())
+}
+
+func TestRPCReceiptsFetcher_BatchTooLargeMinimum(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 2)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	mrpc := &simpleMockRPC{
+		batchCallFn: func(ctx context.Context, b []rpc.BatchElem) error {
+			return errors.New("batch too large")
+		},
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			return errors.New("batch too large")
+		},
+	}
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        4,
+		ProviderKind:        RPCKindBasic,
+		MethodResetDuration: time.Minute,
+	})
+	_, err := rp.FetchReceipts(ctx, bInfo, txHashes)
+	require.ErrorContains(t, err, "batch too large")
+	require.Equal(t, 1, rp.EffectiveBatchSize())
+}
+
+func TestRPCReceiptsFetcher_FetchL2Receipts(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+	for i, r := range receipts {
+		r.L1GasPrice = big.NewInt(int64(1000 + i))
+		r.L1GasUsed = big.NewInt(int64(2000 + i))
+		r.L1Fee = big.NewInt(int64(3000 + i))
+	}
+
+	var methods []string
+	mrpc := &simpleMockRPC{
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			methods = append(methods, method)
+			if method == "debug_getRawReceipts" {
+				return errors.New("unexpected method " + method)
+			}
+			var dat []byte
+			var err error
+			if method == "alchemy_getTransactionReceipts" {
+				dat, err = json.Marshal(receiptsWrapper{Receipts: receipts})
+			} else {
+				dat, err = json.Marshal(receipts)
+			}
+			if err != nil {
+				return err
+			}
+			return json.Unmarshal(dat, result)
+		},
+	}
+	newFetcher := func(preference ...ReceiptsFetchingMethod) *RPCReceiptsFetcher {
+		return NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+			MaxBatchSize:        10,
+			ProviderKind:        RPCKindAny,
+			MethodResetDuration: time.Minute,
+			MethodPreference:    preference,
+		})
+	}
+
+	recs, err := newFetcher().FetchL2Receipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	require.Len(t, methods, 1)
+	for i, r := range recs {
+		require.Equal(t, receipts[i].L1GasPrice, r.L1GasPrice)
+		require.Equal(t, receipts[i].L1GasUsed, r.L1GasUsed)
+		require.Equal(t, receipts[i].L1Fee, r.L1Fee)
+	}
+
+	// forcing only methods that do not preserve the L1 fee fields is an error
+	methods = nil
+	_, err = newFetcher(DebugGetRawReceipts).FetchL2Receipts(ctx, bInfo, txHashes)
+	require.ErrorIs(t, err, ErrL1FeeFieldsUnsupported)
+	require.Empty(t, methods)
+
+	// preferred methods that do not preserve the L1 fee fields are skipped
+	rp := newFetcher(DebugGetRawReceipts, EthGetBlockReceipts)
+	require.Equal(t, DebugGetRawReceipts, rp.PickReceiptsMethod(len(txHashes)))
+	_, err = rp.FetchL2Receipts(ctx, bInfo, txHashes)
+	require.NoError(t, err)
+	require.Equal(t, []string{"eth_getBlockReceipts"}, methods)
+}
+
+func TestRPCReceiptsFetcher_ProbeMethods(t *testing.T) {
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+	head := common.Hash{0xaa}
+
+	var probed []string
+	mrpc := &simpleMockRPC{
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			switch method {
+			case "eth_getBlockByNumber":
+				require.Equal(t, []any{"latest", false}, args)
+				return json.Unmarshal([]byte(`{"hash":"`+head.Hex()+`","number":"0x10"}`), result)
+			case "debug_getRawReceipts", "debug_getBlockReceipts":
+				require.Equal(t, []any{head}, args)
+				probed = append(probed, method)
+				return nil
+			case "eth_getBlockReceipts":
+				probed = append(probed, method)
+				if args[0] == head {
+					return errors.New("invalid params: block hash argument")
+				}
+				require.Equal(t, []any{"0x10"}, args)
+				return nil
+			default:
+				probed = append(probed, method)
+				return errors.New("unknown method " + method)
+			}
+		},
+	}
+	m := new(recordingReceiptsMetrics)
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindAny,
+		MethodResetDuration: time.Minute,
+		Metrics:             m,
+	})
+
+	require.NoError(t, rp.ProbeMethods(ctx))
+	require.Equal(t, DebugGetRawReceipts|EthGetBlockReceiptsByNumber|DebugGetBlockReceipts|EthGetTransactionReceiptBatch, rp.AvailableMethods())
+	// every method but per-tx fetching is probed once
+	require.Len(t, probed, 7)
+	require.ElementsMatch(t, []string{
+		AlchemyGetTransactionReceipts.String(), ParityGetBlockReceipts.String(),
+		EthGetBlockReceipts.String(), ErigonGetBlockReceiptsByBlockHash.String(),
+	}, m.fallbacks)
+}
+
+func TestRPCReceiptsFetcher_ProbeMethodsError(t *testing.T) {
+	mrpc := &simpleMockRPC{
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			return errors.New("connection refused")
+		},
+	}
+	rp := NewRPCReceiptsFetcher(mrpc, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindStandard,
+		MethodResetDuration: time.Minute,
+	})
+	require.ErrorContains(t, rp.ProbeMethods(context.Background()), "connection refused")
+	// methods are left as-is if they cannot be probed
+	require.Equal(t, EthGetBlockReceipts|EthGetTransactionReceiptBatch, rp.AvailableMethods())
+}
+
+func TestDetectProviderKind(t *testing.T) {
+	head := common.Hash{0xaa}
+	detect := func(t *testing.T, supported ...string) RPCProviderKind {
+		mrpc := &simpleMockRPC{
+			callFn: func(_ context.Context, result any, method string, args ...any) error {
+				if method == "eth_getBlockByNumber" {
+					return json.Unmarshal([]byte(`{"hash":"`+head.Hex()+`","number":"0x10"}`), result)
+				}
+				call := method
+				if args[0] == "0x10" {
+					call += "ByNumber"
+				}
+				for _, m := range supported {
+					if m == call {
+						return nil
+					}
+				}
+				return &methodNotFoundError{method: method}
+			},
+		}
+		kind, err := DetectProviderKind(context.Background(), mrpc)
+		require.NoError(t, err)
+		return kind
+	}
+
+	require.Equal(t, RPCKindStandard, detect(t))
+	require.Equal(t, RPCKindStandard, detect(t, "eth_getBlockReceipts", "eth_getBlockReceiptsByNumber"))
+	require.Equal(t, RPCKindAlchemy, detect(t, "alchemy_getTransactionReceipts", "eth_getBlockReceipts"))
+	require.Equal(t, RPCKindQuickNode, detect(t, "debug_getRawReceipts", "debug_getBlockReceipts", "eth_getBlockReceipts"))
+	require.Equal(t, RPCKindParity, detect(t, "parity_getBlockReceipts"))
+	require.Equal(t, RPCKindDebugGeth, detect(t, "debug_getRawReceipts"))
+	require.Equal(t, RPCKindErigon, detect(t, "erigon_getBlockReceiptsByBlockHash", "eth_getBlockReceiptsByNumber"))
+	require.Equal(t, RPCKindReth, detect(t, "eth_getBlockReceiptsByNumber"))
+
+	_, err := DetectProviderKind(context.Background(), &simpleMockRPC{
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			return errors.New("connection refused")
+		},
+	})
+	require.ErrorContains(t, err, "connection refused")
+}
+
+func TestRPCReceiptsFetcher_MethodNotFound(t *testing.T) {
+	rp := NewRPCReceiptsFetcher(&simpleMockRPC{}, testlog.Logger(t, log.LevelDebug), RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindAny,
+		MethodResetDuration: time.Minute,
+	})
+	all := AvailableReceiptsFetchingMethods(RPCKindAny)
+
+	// the method is not implemented at all, so it stays unavailable after a reset
+	rp.OnReceiptsMethodErr(AlchemyGetTransactionReceipts, &methodNotFoundError{method: "alchemy_getTransactionReceipts"})
+	// the method may be available again after a reset
+	rp.OnReceiptsMethodErr(EthGetBlockReceipts, errors.New("invalid params"))
+	// transient errors do not make the method unavailable
+	rp.OnReceiptsMethodErr(DebugGetRawReceipts, errors.New("internal server error"))
+	require.Equal(t, AlchemyGetTransactionReceipts, rp.DisabledMethods())
+	require.Equal(t, all&^(AlchemyGetTransactionReceipts|EthGetBlockReceipts), rp.AvailableMethods())
+
+	rp.methodResetDuration = 0
+	rp.PickReceiptsMethod(4)
+	require.Equal(t, all&^AlchemyGetTransactionReceipts, rp.AvailableMethods())
+	require.Equal(t, AlchemyGetTransactionReceipts, rp.DisabledMethods())
+}
+
+func TestReceiptsFetchingMethodText(t *testing.T) {
+	require.Equal(t, "debug_getRawReceipts", DebugGetRawReceipts.String())
+	require.Equal(t, "eth_getTransactionReceipt (batched), eth_getBlockReceipts, unknown",
+		(EthGetTransactionReceiptBatch | EthGetBlockReceipts | ReceiptsFetchingMethod(1<<20)).String())
+
+	for m := EthGetTransactionReceiptBatch; m <= DebugGetBlockReceipts; m <<= 1 {
+		parsed, err := ParseReceiptsFetchingMethod(m.String())
+		require.NoError(t, err)
+		require.Equal(t, m, parsed)
+	}
+	_, err := ParseReceiptsFetchingMethod("eth_getLogs")
+	require.ErrorContains(t, err, "unknown receipts fetching method")
+
+	preference := []ReceiptsFetchingMethod{DebugGetRawReceipts, EthGetBlockReceiptsByNumber, EthGetTransactionReceiptBatch}
+	data, err := json.Marshal(preference)
+	require.NoError(t, err)
+	require.JSONEq(t, `["debug_getRawReceipts","eth_getBlockReceipts (by number)","eth_getTransactionReceipt (batched)"]`, string(data))
+	var decoded []ReceiptsFetchingMethod
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, preference, decoded)
+
+	_, err = json.Marshal(DebugGetRawReceipts | EthGetBlockReceipts)
+	require.ErrorContains(t, err, "not a single receipts fetching method")
+	require.Error(t, json.Unmarshal([]byte(`["eth_getLogs"]`), &decoded))
+}
+
+func TestReceiptsFetchingMethodLabels(t *testing.T) {
+	require.Empty(t, ReceiptsFetchingMethod(0).Methods())
+	require.Equal(t, []ReceiptsFetchingMethod{DebugGetRawReceipts}, DebugGetRawReceipts.Methods())
+	require.Equal(t, []ReceiptsFetchingMethod{EthGetTransactionReceiptBatch, EthGetBlockReceipts, ReceiptsFetchingMethod(1 << 20)},
+		(EthGetBlockReceipts | ReceiptsFetchingMethod(1<<20) | EthGetTransactionReceiptBatch).Methods())
+
+	labels := make(map[string]struct{})
+	for _, m := range AvailableReceiptsFetchingMethods(RPCKindAny).Methods() {
+		name := m.Name()
+		require.NotEqual(t, InvalidReceiptsFetchingMethodName, name)
+		require.NotContains(t, name, " ")
+		labels[name] = struct{}{}
+	}
+	require.Len(t, labels, len(receiptsFetchingMethodNames), "labels must be unique")
+	require.Equal(t, "eth_getBlockReceipts_by_number", EthGetBlockReceiptsByNumber.Name())
+
+	require.Equal(t, InvalidReceiptsFetchingMethodName, ReceiptsFetchingMethod(0).Name())
+	require.Equal(t, InvalidReceiptsFetchingMethodName, (DebugGetRawReceipts | EthGetBlockReceipts).Name())
+	require.Equal(t, InvalidReceiptsFetchingMethodName, ReceiptsFetchingMethod(1<<20).Name())
+}
@@ -0,0 +1,119 @@
+package sources
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// receiptsSnapshotEntry is the snapshot of the cached receipts of a block, see CachingReceiptsProvider.Export.
+// The receipts are encoded like RPC receipts, rather than with RLP, which only encodes the consensus fields.
+// The receipts root is recorded, so the receipts can be validated when they are imported.
+// The number is only unknown for blocks without receipts, whose number is not indexed anymore.
+type receiptsSnapshotEntry struct {
+	Hash         common.Hash     `json:"hash"`
+	Number       *hexutil.Uint64 `json:"number,omitempty"`
+	ReceiptsRoot common.Hash     `json:"receiptsRoot"`
+	Receipts     types.Receipts  `json:"receipts"`
+}
+
+// Export writes the cached receipts to w, as a JSON object per block, from the least to the most recently used block,
+// so they can be imported with Import after a restart, e.g. to not fetch the receipts of recent blocks again.
+// Nothing is written if caching is disabled.
+func (p *CachingReceiptsProvider) Export(w io.Writer) error {
+	if p.cache == nil {
+		return nil
+	}
+	enc := json.NewEncoder(w)
+	for _, hash := range p.cache.Keys() {
+		receipts, ok := p.cache.Peek(hash)
+		if !ok { // evicted or expired in the meantime
+			continue
+		}
+		entry := receiptsSnapshotEntry{
+			Hash:         hash,
+			ReceiptsRoot: ComputeReceiptsRoot(receipts),
+			Receipts:     receipts,
+		}
+		if len(receipts) > 0 {
+			number := hexutil.Uint64(receipts[0].BlockNumber.Uint64())
+			entry.Number = &number
+		} else if number, ok := p.numbers.number(hash); ok {
+			entry.Number = (*hexutil.Uint64)(&number)
+		}
+		if err := enc.Encode(&entry); err != nil {
+			return fmt.Errorf("failed to write receipts of block %s: %w", hash, err)
+		}
+	}
+	return nil
+}
+
+// Import adds the receipts written by Export to the cache, in the order they were written, and returns the number
+// of blocks whose receipts were imported. The receipts of each block are validated like fetched receipts, against
+// the block hash, number and receipts root they were written with. Entries that cannot be decoded or are invalid
+// are skipped with a warning, rather than failing the whole import. Imported receipts expire like fetched receipts,
+// counting from the import. Nothing is imported if caching is disabled.
+func (p *CachingReceiptsProvider) Import(r io.Reader, logger log.Logger) (int, error) {
+	if p.cache == nil {
+		return 0, nil
+	}
+	br := bufio.NewReader(r)
+	imported := 0
+	for line := 1; ; line++ {
+		data, err := br.ReadBytes('\n')
+		if len(data) > 0 {
+			if block, err := p.importEntry(data); err != nil {
+				logger.Warn("Skipping invalid receipts snapshot entry", "line", line, "err", err)
+			} else {
+				logger.Trace("Imported receipts from snapshot", "block", block)
+				imported++
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return imported, nil
+		} else if err != nil {
+			return imported, fmt.Errorf("failed to read receipts snapshot: %w", err)
+		}
+	}
+}
+
+// importEntry validates and caches the receipts of a single snapshot entry.
+func (p *CachingReceiptsProvider) importEntry(data []byte) (eth.BlockID, error) {
+	var entry receiptsSnapshotEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return eth.BlockID{}, fmt.Errorf("failed to decode entry: %w", err)
+	}
+	block := eth.BlockID{Hash: entry.Hash}
+	if entry.Number != nil {
+		block.Number = uint64(*entry.Number)
+	} else if len(entry.Receipts) > 0 {
+		return block, errors.New("missing block number")
+	}
+	receipts := entry.Receipts
+	if receipts == nil {
+		receipts = types.Receipts{}
+	}
+	txHashes := make([]common.Hash, len(receipts))
+	for i, r := range receipts {
+		if r != nil {
+			txHashes[i] = r.TxHash
+		}
+	}
+	if err := validateReceipts(block, entry.ReceiptsRoot, txHashes, receipts); err != nil {
+		return block, fmt.Errorf("invalid receipts of block %s: %w", block, err)
+	}
+	p.cache.Add(block.Hash, receipts)
+	if entry.Number != nil {
+		p.numbers.add(block)
+	}
+	return block, nil
+}
@@ -0,0 +1,98 @@
+package sources
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+func TestCachingReceiptsProvider_ExportImport(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	ctx := context.Background()
+	mrp := new(mockReceiptsProvider)
+	rp := NewCachingReceiptsProvider(mrp, nil, 10)
+
+	var infos []eth.BlockInfo
+	var blockReceipts []types.Receipts
+	for i := 0; i < 3; i++ {
+		block, receipts := randomRpcBlockAndReceipts(rng, 2)
+		info, _, _ := block.Info(true, true)
+		mrp.On("FetchReceipts", ctx, block.BlockID(), receiptTxHashes(receipts)).Return(types.Receipts(receipts), error(nil)).Once()
+		_, err := rp.FetchReceipts(ctx, info, receiptTxHashes(receipts))
+		require.NoError(t, err)
+		infos = append(infos, info)
+		blockReceipts = append(blockReceipts, receipts)
+	}
+	// an empty block, whose receipts are not fetched
+	empty := &testutils.MockBlockInfo{InfoNum: 100, InfoHash: common.Hash{0xe}, InfoReceiptRoot: types.EmptyRootHash}
+	_, err := rp.FetchReceipts(ctx, empty, nil)
+	require.NoError(t, err)
+
+	var snapshot bytes.Buffer
+	require.NoError(t, rp.Export(&snapshot))
+	lines := strings.Split(strings.TrimSuffix(snapshot.String(), "\n"), "\n")
+	require.Len(t, lines, 4)
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		imported := NewCachingReceiptsProvider(new(mockReceiptsProvider), nil, 10)
+		n, err := imported.Import(bytes.NewReader(snapshot.Bytes()), testlog.Logger(t, log.LevelDebug))
+		require.NoError(t, err)
+		require.Equal(t, 4, n)
+		for i, info := range infos {
+			got, ok := imported.CachedReceipts(info.Hash())
+			require.True(t, ok)
+			for j, r := range got {
+				requireEqualReceipt(t, blockReceipts[i][j], r)
+			}
+			_, ok = imported.CachedReceiptsByNumber(info.NumberU64())
+			require.True(t, ok)
+		}
+		got, ok := imported.CachedReceipts(empty.InfoHash)
+		require.True(t, ok)
+		require.Empty(t, got)
+		_, ok = imported.CachedReceiptsByNumber(empty.InfoNum)
+		require.True(t, ok)
+	})
+
+	t.Run("SkipsInvalidEntries", func(t *testing.T) {
+		// a truncated entry, and an entry of another block hash than its receipts are of
+		corrupt := lines[0][:len(lines[0])/2]
+		mismatched := strings.Replace(lines[1], infos[1].Hash().Hex(), common.Hash{0x1}.Hex(), 1)
+		input := strings.Join([]string{corrupt, mismatched, lines[2]}, "\n")
+
+		logger, logs := testlog.CaptureLogger(t, log.LevelWarn)
+		imported := NewCachingReceiptsProvider(new(mockReceiptsProvider), nil, 10)
+		n, err := imported.Import(strings.NewReader(input), logger)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+		require.Len(t, logs.FindLogs(testlog.NewMessageFilter("Skipping invalid receipts snapshot entry")), 2)
+
+		_, ok := imported.CachedReceipts(infos[0].Hash())
+		require.False(t, ok)
+		_, ok = imported.CachedReceipts(common.Hash{0x1})
+		require.False(t, ok)
+		_, ok = imported.CachedReceipts(infos[2].Hash())
+		require.True(t, ok)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		disabled := NewCachingReceiptsProvider(new(mockReceiptsProvider), nil, 0)
+		var out bytes.Buffer
+		require.NoError(t, disabled.Export(&out))
+		require.Zero(t, out.Len())
+		n, err := disabled.Import(bytes.NewReader(snapshot.Bytes()), testlog.Logger(t, log.LevelDebug))
+		require.NoError(t, err)
+		require.Zero(t, n)
+	})
+}
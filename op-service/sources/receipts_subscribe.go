@@ -0,0 +1,125 @@
+package sources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// ErrSubscriptionsUnsupported is returned when receipts are subscribed to with an RPC client that does not support
+// subscriptions, e.g. an HTTP client. A websocket or IPC client is needed instead, or receipts have to be polled.
+var ErrSubscriptionsUnsupported = errors.New("RPC client does not support subscriptions")
+
+// subscriptionClient is an rpcClient that supports subscriptions, such as a client.RPC of a websocket connection.
+type subscriptionClient interface {
+	rpcClient
+	EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error)
+}
+
+// BlockReceipts are the validated receipts of a block.
+type BlockReceipts struct {
+	Info     eth.BlockInfo
+	Receipts types.Receipts
+}
+
+// ReceiptsSubscriber pushes the receipts of new blocks as they become the head of the chain,
+// for following the chain with lower latency than polling for new blocks.
+type ReceiptsSubscriber struct {
+	client  rpcClient
+	fetcher *RPCReceiptsFetcher
+	log     log.Logger
+}
+
+// NewReceiptsSubscriber creates a ReceiptsSubscriber, which fetches the receipts of new blocks with the given fetcher.
+// The client must support subscriptions to subscribe to new receipts, and should be the client of the fetcher.
+func NewReceiptsSubscriber(client rpcClient, fetcher *RPCReceiptsFetcher, log log.Logger) *ReceiptsSubscriber {
+	return &ReceiptsSubscriber{
+		client:  client,
+		fetcher: fetcher,
+		log:     log,
+	}
+}
+
+// SubscribeNewReceipts subscribes to new heads, and delivers the receipts of each new head on ch.
+// The receipts are fetched with the method selection of the fetcher, and validated against the header like fetched receipts.
+// Failing to fetch or validate the receipts of a block ends the subscription with the error, rather than skipping the block,
+// so that no block is missed by the subscriber: it may resubscribe and catch up by fetching the missed blocks.
+// An error wrapping ErrSubscriptionsUnsupported is returned if the client does not support subscriptions.
+func (s *ReceiptsSubscriber) SubscribeNewReceipts(ctx context.Context, ch chan<- BlockReceipts) (ethereum.Subscription, error) {
+	client, ok := s.client.(subscriptionClient)
+	if !ok {
+		return nil, fmt.Errorf("%w: cannot subscribe to new receipts", ErrSubscriptionsUnsupported)
+	}
+	heads := make(chan *RPCHeader, 10)
+	headsSub, err := client.EthSubscribe(ctx, heads, "newHeads")
+	if errors.Is(err, rpc.ErrNotificationsUnsupported) {
+		return nil, fmt.Errorf("%w: cannot subscribe to new receipts: %w", ErrSubscriptionsUnsupported, err)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to new heads: %w", err)
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer headsSub.Unsubscribe()
+		fetchCtx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			<-quit
+			cancel()
+		}()
+		for {
+			select {
+			case head := <-heads:
+				info, receipts, err := s.fetchReceipts(fetchCtx, head)
+				if err != nil {
+					if fetchCtx.Err() != nil { // unsubscribed while fetching
+						return nil
+					}
+					return fmt.Errorf("failed to fetch receipts of new head %s: %w", head.BlockID(), err)
+				}
+				select {
+				case ch <- BlockReceipts{Info: info, Receipts: receipts}:
+				case <-quit:
+					return nil
+				}
+			case err := <-headsSub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// fetchReceipts fetches and validates the receipts of the given head.
+// The hashes of the transactions of the block are fetched first, since the head does not include them.
+func (s *ReceiptsSubscriber) fetchReceipts(ctx context.Context, head *RPCHeader) (eth.BlockInfo, types.Receipts, error) {
+	info, err := head.Info(s.fetcher.trustRPC, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	var block struct {
+		Hash         common.Hash   `json:"hash"`
+		Transactions []common.Hash `json:"transactions"`
+	}
+	if err := s.client.CallContext(ctx, &block, "eth_getBlockByHash", info.Hash(), false); err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch transaction hashes: %w", err)
+	}
+	if block.Hash != info.Hash() {
+		// e.g. the block was reorged out, and the node returned null
+		return nil, nil, fmt.Errorf("failed to fetch transaction hashes: got block %s", block.Hash)
+	}
+	receipts, err := s.fetcher.FetchReceipts(ctx, info, block.Transactions)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.log.Trace("Fetched receipts of new head", "block", eth.ToBlockID(info), "receipts", len(receipts))
+	return info, receipts, nil
+}
@@ -0,0 +1,133 @@
+package sources
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+// headsBackend serves new heads and the receipts of their blocks.
+type headsBackend struct {
+	heads    []*RPCBlock
+	receipts map[common.Hash]types.Receipts
+}
+
+func (b *headsBackend) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, ok := rpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+	go func() {
+		for _, head := range b.heads {
+			if err := notifier.Notify(sub.ID, head.RPCHeader); err != nil {
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+func (b *headsBackend) GetBlockByHash(hash common.Hash, fullTxs bool) (map[string]any, error) {
+	for _, head := range b.heads {
+		if head.Hash == hash {
+			txHashes := make([]common.Hash, len(head.Transactions))
+			for i, tx := range head.Transactions {
+				txHashes[i] = tx.Hash()
+			}
+			return map[string]any{"hash": head.Hash, "transactions": txHashes}, nil
+		}
+	}
+	return nil, nil
+}
+
+func (b *headsBackend) GetBlockReceipts(blockHash common.Hash) (types.Receipts, error) {
+	return b.receipts[blockHash], nil
+}
+
+func TestReceiptsSubscriber(t *testing.T) {
+	rng := rand.New(rand.NewSource(123))
+	backend := &headsBackend{receipts: make(map[common.Hash]types.Receipts)}
+	for i := uint64(0); i < 3; i++ {
+		block, receipts := randomRpcBlockAndReceipts(rng, i+1)
+		backend.heads = append(backend.heads, block)
+		backend.receipts[block.Hash] = receipts
+	}
+	srv := rpc.NewServer()
+	t.Cleanup(srv.Stop)
+	require.NoError(t, srv.RegisterName("eth", backend))
+	cl := client.NewBaseRPCClient(rpc.DialInProc(srv))
+	t.Cleanup(cl.Close)
+
+	logger := testlog.Logger(t, log.LevelInfo)
+	fetcher := NewRPCReceiptsFetcher(cl, logger, RPCReceiptsConfig{ProviderKind: RPCKindStandard})
+	subscriber := NewReceiptsSubscriber(cl, fetcher, logger)
+
+	ch := make(chan BlockReceipts)
+	sub, err := subscriber.SubscribeNewReceipts(context.Background(), ch)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+	for _, head := range backend.heads {
+		select {
+		case got := <-ch:
+			require.Equal(t, head.Hash, got.Info.Hash())
+			require.Len(t, got.Receipts, len(head.Transactions))
+			for i, r := range got.Receipts {
+				require.Equal(t, head.Transactions[i].Hash(), r.TxHash)
+			}
+		case err := <-sub.Err():
+			t.Fatalf("subscription failed: %v", err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for receipts")
+		}
+	}
+}
+
+func TestReceiptsSubscriber_InvalidReceipts(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 2)
+	backend := &headsBackend{heads: []*RPCBlock{block}, receipts: map[common.Hash]types.Receipts{block.Hash: receipts[:1]}}
+	srv := rpc.NewServer()
+	t.Cleanup(srv.Stop)
+	require.NoError(t, srv.RegisterName("eth", backend))
+	cl := client.NewBaseRPCClient(rpc.DialInProc(srv))
+	t.Cleanup(cl.Close)
+
+	logger := testlog.Logger(t, log.LevelInfo)
+	fetcher := NewRPCReceiptsFetcher(cl, logger, RPCReceiptsConfig{ProviderKind: RPCKindStandard})
+	sub, err := NewReceiptsSubscriber(cl, fetcher, logger).SubscribeNewReceipts(context.Background(), make(chan BlockReceipts))
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+	select {
+	case err := <-sub.Err():
+		require.ErrorIs(t, err, ErrReceiptCountMismatch)
+		require.ErrorContains(t, err, block.Hash.String())
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the subscription to fail")
+	}
+}
+
+func TestReceiptsSubscriber_Unsupported(t *testing.T) {
+	logger := testlog.Logger(t, log.LevelInfo)
+	// a client without subscriptions
+	m := new(mockRPC)
+	fetcher := NewRPCReceiptsFetcher(m, logger, RPCReceiptsConfig{})
+	_, err := NewReceiptsSubscriber(struct{ rpcClient }{m}, fetcher, logger).SubscribeNewReceipts(context.Background(), make(chan BlockReceipts))
+	require.ErrorIs(t, err, ErrSubscriptionsUnsupported)
+
+	// a client of a transport without subscriptions
+	m.On("EthSubscribe", mock.Anything, []any{"newHeads"}).Return((*rpc.ClientSubscription)(nil), []error{rpc.ErrNotificationsUnsupported})
+	_, err = NewReceiptsSubscriber(m, fetcher, logger).SubscribeNewReceipts(context.Background(), make(chan BlockReceipts))
+	require.ErrorIs(t, err, ErrSubscriptionsUnsupported)
+	require.ErrorIs(t, err, rpc.ErrNotificationsUnsupported)
+}
@@ -3,9 +3,11 @@ package sources
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"math/rand"
+	"slices"
 	"testing"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/trie"
@@ -69,6 +72,11 @@ func (b *debugBackend) GetRawReceipts(id string) ([]hexutil.Bytes, error) {
 	return out[0].([]hexutil.Bytes), *out[1].(*error)
 }
 
+func (b *debugBackend) GetBlockReceipts(id string) ([]*types.Receipt, error) {
+	out := b.Mock.MethodCalled("debug_getBlockReceipts", id)
+	return out[0].([]*types.Receipt), *out[1].(*error)
+}
+
 type parityBackend struct {
 	*mock.Mock
 }
@@ -135,12 +143,16 @@ func (tc *ReceiptsTestCase) Run(t *testing.T) {
 				raw = append(raw, data)
 			}
 			m.On("debug_getRawReceipts", block.Hash.String()).Once().Return(raw, &req.err)
+		case DebugGetBlockReceipts:
+			m.On("debug_getBlockReceipts", block.Hash.String()).Once().Return(req.result, &req.err)
 		case ParityGetBlockReceipts:
 			m.On("parity_getBlockReceipts", block.Hash.String()).Once().Return(req.result, &req.err)
 		case EthGetBlockReceipts:
 			m.On("eth_getBlockReceipts", block.Hash.String()).Once().Return(req.result, &req.err)
 		case ErigonGetBlockReceiptsByBlockHash:
 			m.On("erigon_getBlockReceiptsByBlockHash", block.Hash.String()).Once().Return(req.result, &req.err)
+		case EthGetBlockReceiptsByNumber:
+			m.On("eth_getBlockReceipts", block.Number.String()).Once().Return(req.result, &req.err)
 		default:
 			t.Fatalf("unrecognized request method: %d", uint64(req.method))
 		}
@@ -248,7 +260,19 @@ func TestEthClient_FetchReceipts(t *testing.T) {
 			name:         "alchemy sticky",
 			providerKind: RPCKindAlchemy,
 			staticMethod: true,
-			setup:        fallbackCase(30, AlchemyGetTransactionReceipts, AlchemyGetTransactionReceipts),
+			setup: func(t *testing.T) (*RPCBlock, []ReceiptsRequest) {
+				block, requests := fallbackCase(30, AlchemyGetTransactionReceipts, AlchemyGetTransactionReceipts)(t)
+				// the method is implemented, so it is available again after the reset
+				requests[0].err = errors.New("invalid params")
+				return block, requests
+			},
+		},
+		{
+			name:         "alchemy method not found",
+			providerKind: RPCKindAlchemy,
+			staticMethod: true,
+			// the method is not implemented, so it stays unavailable after the reset
+			setup: fallbackCase(30, AlchemyGetTransactionReceipts, EthGetTransactionReceiptBatch),
 		},
 		{
 			name:         "alchemy fallback 1",
@@ -271,9 +295,24 @@ func TestEthClient_FetchReceipts(t *testing.T) {
 			providerKind: RPCKindQuickNode,
 			setup: fallbackCase(30,
 				DebugGetRawReceipts,
+				DebugGetBlockReceipts,
+			),
+		},
+		{
+			name:         "quicknode fallback 2",
+			providerKind: RPCKindQuickNode,
+			setup: fallbackCase(30,
+				DebugGetRawReceipts,
+				DebugGetBlockReceipts,
 				EthGetBlockReceipts,
 			),
 		},
+		{
+			name:         "quicknode medium tx count cost saving",
+			providerKind: RPCKindQuickNode,
+			// the decoded debug receipts are cheaper than per-tx fetching, but eth_getBlockReceipts is not yet
+			setup: fallbackCase(20, DebugGetRawReceipts, DebugGetBlockReceipts, EthGetTransactionReceiptBatch),
+		},
 		{
 			name:         "quicknode low tx count cost saving",
 			providerKind: RPCKindQuickNode,
@@ -300,6 +339,16 @@ func TestEthClient_FetchReceipts(t *testing.T) {
 			providerKind: RPCKindErigon,
 			setup:        fallbackCase(4, ErigonGetBlockReceiptsByBlockHash),
 		},
+		{
+			name:         "reth",
+			providerKind: RPCKindReth,
+			setup:        fallbackCase(4, EthGetBlockReceiptsByNumber),
+		},
+		{
+			name:         "reth fallback",
+			providerKind: RPCKindReth,
+			setup:        fallbackCase(4, EthGetBlockReceiptsByNumber, EthGetTransactionReceiptBatch),
+		},
 		{
 			name:         "basic",
 			providerKind: RPCKindBasic,
@@ -327,9 +376,24 @@ func TestEthClient_FetchReceipts(t *testing.T) {
 			setup: fallbackCase(4,
 				AlchemyGetTransactionReceipts,
 				DebugGetRawReceipts,
+				DebugGetBlockReceipts,
+				ErigonGetBlockReceiptsByBlockHash,
+				EthGetBlockReceipts,
+				ParityGetBlockReceipts,
+			),
+		},
+		{
+			name:         "any discovers reth",
+			providerKind: RPCKindAny,
+			// fallback through all methods preferred over the block-number method
+			setup: fallbackCase(4,
+				AlchemyGetTransactionReceipts,
+				DebugGetRawReceipts,
+				DebugGetBlockReceipts,
 				ErigonGetBlockReceiptsByBlockHash,
 				EthGetBlockReceipts,
 				ParityGetBlockReceipts,
+				EthGetBlockReceiptsByNumber,
 			),
 		},
 	}
@@ -450,6 +514,7 @@ func TestVerifyReceipts(t *testing.T) {
 	t.Run("NotEnoughReceipts", func(t *testing.T) {
 		block, receiptHash, txHashes, receipts := validData()
 		err := validateReceipts(block, receiptHash, txHashes, receipts[1:])
+		require.ErrorIs(t, err, ErrReceiptCountMismatch)
 		require.ErrorContains(t, err, fmt.Sprintf("got %d receipts but expected %d", len(receipts)-1, len(receipts)))
 	})
 
@@ -474,6 +539,7 @@ func TestVerifyReceipts(t *testing.T) {
 	t.Run("IncorrectReceiptRoot", func(t *testing.T) {
 		block, _, txHashes, receipts := validData()
 		err := validateReceipts(block, common.Hash{0x35}, txHashes, receipts)
+		require.ErrorIs(t, err, ErrReceiptRootMismatch)
 		require.ErrorContains(t, err, "failed to fetch list of receipts: expected receipt root")
 	})
 
@@ -481,14 +547,31 @@ func TestVerifyReceipts(t *testing.T) {
 		block, receiptHash, txHashes, receipts := validData()
 		receipts[0] = nil
 		err := validateReceipts(block, receiptHash, txHashes, receipts)
+		require.ErrorIs(t, err, ErrNilReceipt)
 		require.ErrorContains(t, err, "returns nil on retrieval")
 	})
 
-	t.Run("IncorrectTxIndex", func(t *testing.T) {
+	t.Run("OutOfOrder", func(t *testing.T) {
+		block, receiptHash, txHashes, receipts := validData()
+		sorted := slices.Clone(receipts)
+		slices.Reverse(receipts)
+		err := validateReceipts(block, receiptHash, txHashes, receipts)
+		require.NoError(t, err)
+		require.Equal(t, sorted, receipts)
+	})
+
+	t.Run("DuplicateTxIndex", func(t *testing.T) {
 		block, receiptHash, txHashes, receipts := validData()
 		receipts[0].TransactionIndex = 2
 		err := validateReceipts(block, receiptHash, txHashes, receipts)
-		require.ErrorContains(t, err, "has unexpected tx index")
+		require.ErrorContains(t, err, "got more than one receipt of tx 2")
+	})
+
+	t.Run("MissingTxIndex", func(t *testing.T) {
+		block, receiptHash, txHashes, receipts := validData()
+		receipts[3].TransactionIndex = 5
+		err := validateReceipts(block, receiptHash, txHashes, receipts)
+		require.ErrorContains(t, err, "missing receipt of tx 3")
 	})
 
 	t.Run("Missing block number", func(t *testing.T) {
@@ -569,6 +652,66 @@ func TestVerifyReceipts(t *testing.T) {
 	})
 }
 
+// FuzzValidateReceiptsOrder checks that valid receipts in any order are accepted, and sorted by tx index.
+func FuzzValidateReceiptsOrder(f *testing.F) {
+	f.Add(int64(0), uint8(1))
+	f.Add(int64(123), uint8(4))
+	f.Add(int64(69), uint8(20))
+	f.Fuzz(func(t *testing.T, seed int64, txCount uint8) {
+		rng := rand.New(rand.NewSource(seed))
+		block, receipts := randomRpcBlockAndReceipts(rng, 1+uint64(txCount)%32)
+		txHashes := receiptTxHashes(receipts)
+		bInfo, _, _ := block.Info(true, true)
+
+		shuffled := slices.Clone(receipts)
+		rng.Shuffle(len(shuffled), func(i, j int) {
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		})
+		err := validateReceipts(eth.ToBlockID(bInfo), bInfo.ReceiptHash(), txHashes, shuffled)
+		require.NoError(t, err)
+		require.Equal(t, receipts, shuffled)
+	})
+}
+
+func TestFetchReceiptsWithTxCount(t *testing.T) {
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(69)), 4)
+	txHashes := receiptTxHashes(receipts)
+	bInfo, _, _ := block.Info(true, true)
+	ctx, done := context.WithTimeout(context.Background(), 10*time.Second)
+	defer done()
+
+	t.Run("Mismatch", func(t *testing.T) {
+		mrp := new(mockReceiptsProvider)
+		_, err := FetchReceiptsWithTxCount(ctx, mrp, bInfo, txHashes[:3], len(txHashes))
+		require.ErrorIs(t, err, ErrTxCountMismatch)
+		require.ErrorContains(t, err, "got 3 transaction hashes")
+		mrp.AssertNotCalled(t, "FetchReceipts", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Match", func(t *testing.T) {
+		mrp := new(mockReceiptsProvider)
+		mrp.On("FetchReceipts", ctx, block.BlockID(), txHashes).
+			Return(types.Receipts(receipts), error(nil)).
+			Once()
+		recs, err := FetchReceiptsWithTxCount(ctx, mrp, bInfo, txHashes, len(txHashes))
+		require.NoError(t, err)
+		require.Len(t, recs, len(receipts))
+		mrp.AssertExpectations(t)
+	})
+}
+
+func TestComputeReceiptsRoot(t *testing.T) {
+	require.Equal(t, types.EmptyRootHash, ComputeReceiptsRoot(nil))
+
+	block, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	require.Equal(t, block.ReceiptHash, ComputeReceiptsRoot(receipts))
+	require.NoError(t, validateReceipts(block.BlockID(), ComputeReceiptsRoot(receipts), receiptTxHashes(receipts), receipts))
+
+	// the root commits to the order of the receipts
+	receipts[0], receipts[1] = receipts[1], receipts[0]
+	require.NotEqual(t, block.ReceiptHash, ComputeReceiptsRoot(receipts))
+}
+
 func requireEqualReceipt(t *testing.T, exp, act *types.Receipt, msgAndArgs ...any) {
 	t.Helper()
 	expJson, err := json.MarshalIndent(exp, "", "  ")
@@ -577,3 +720,70 @@ func requireEqualReceipt(t *testing.T, exp, act *types.Receipt, msgAndArgs ...an
 	require.NoError(t, err, msgAndArgs...)
 	require.Equal(t, string(expJson), string(actJson), msgAndArgs...)
 }
+
+func TestValidateContractAddresses(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	signer := types.LatestSignerForChainID(big.NewInt(10))
+	to := common.Address{0x42}
+	depositor := common.Address{0x43}
+	depositNonce := uint64(7)
+
+	txs := types.Transactions{
+		types.MustSignNewTx(key, signer, &types.DynamicFeeTx{ChainID: big.NewInt(10), Nonce: 3, To: &to}),
+		types.MustSignNewTx(key, signer, &types.DynamicFeeTx{ChainID: big.NewInt(10), Nonce: 4}),
+		types.NewTx(&types.DepositTx{From: depositor}),
+	}
+	validReceipts := func() []*types.Receipt {
+		return []*types.Receipt{
+			{ContractAddress: common.Address{0x01}}, // not a contract creation, so not checked
+			{ContractAddress: crypto.CreateAddress(from, 4)},
+			{ContractAddress: crypto.CreateAddress(depositor, depositNonce), DepositNonce: &depositNonce},
+		}
+	}
+	require.NoError(t, validateContractAddresses(txs, validReceipts()))
+
+	receipts := validReceipts()
+	receipts[1].ContractAddress = crypto.CreateAddress(from, 3)
+	require.ErrorContains(t, validateContractAddresses(txs, receipts), "receipt 1 has unexpected contract address")
+
+	receipts = validReceipts()
+	receipts[2].ContractAddress = common.Address{0x02}
+	require.ErrorContains(t, validateContractAddresses(txs, receipts), "receipt 2 has unexpected contract address")
+
+	// deposits from before the deposit nonce was recorded cannot be checked
+	receipts[2].DepositNonce = nil
+	require.NoError(t, validateContractAddresses(txs, receipts))
+}
+
+func TestOrderReceiptsByTxHash(t *testing.T) {
+	_, receipts := randomRpcBlockAndReceipts(rand.New(rand.NewSource(321)), 4)
+	txHashes := receiptTxHashes(receipts)
+
+	ordered, err := orderReceiptsByTxHash(txHashes, receipts)
+	require.NoError(t, err)
+	require.Equal(t, types.Receipts(receipts), ordered)
+
+	shuffled := types.Receipts{receipts[2], receipts[0], receipts[3], receipts[1]}
+	ordered, err = orderReceiptsByTxHash(txHashes, shuffled)
+	require.NoError(t, err)
+	require.Equal(t, types.Receipts(receipts), ordered)
+
+	// a receipt of another transaction in place of a requested one
+	other := *receipts[1]
+	other.TxHash = common.Hash{0x1}
+	_, err = orderReceiptsByTxHash(txHashes, types.Receipts{receipts[0], &other, receipts[2], receipts[3]})
+	require.ErrorIs(t, err, ErrMissingReceipt)
+	require.ErrorContains(t, err, "receipt of tx 1 ("+txHashes[1].String()+")")
+
+	// receipts that are not one per transaction are left to the validation
+	partial := types.Receipts{receipts[1], receipts[0]}
+	ordered, err = orderReceiptsByTxHash(txHashes, partial)
+	require.NoError(t, err)
+	require.Equal(t, partial, ordered)
+	withNil := types.Receipts{receipts[1], nil, receipts[0], receipts[3]}
+	ordered, err = orderReceiptsByTxHash(txHashes, withNil)
+	require.NoError(t, err)
+	require.Equal(t, withNil, ordered)
+}
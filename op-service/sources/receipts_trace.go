@@ -0,0 +1,98 @@
+package sources
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+const (
+	ReceiptsTraceSuccess = "success"
+	ReceiptsTraceError   = "error"
+)
+
+// ReceiptsTraceRecord describes a single attempt at fetching the receipts of a block.
+type ReceiptsTraceRecord struct {
+	Time     time.Time     `json:"time"`
+	Block    eth.BlockID   `json:"block"`
+	TxCount  int           `json:"txCount"`
+	Method   string        `json:"method"`
+	Duration time.Duration `json:"duration"`
+	Outcome  string        `json:"outcome"`
+	Error    string        `json:"error,omitempty"`
+	// PerTxFallback is set if the method returned no receipts, and the receipts were fetched per transaction instead.
+	PerTxFallback bool `json:"perTxFallback,omitempty"`
+	// Fallback lists the methods that remain available after the method failed.
+	Fallback string `json:"fallback,omitempty"`
+}
+
+// ReceiptsTracer records receipt fetching attempts, for offline analysis.
+type ReceiptsTracer interface {
+	TraceReceiptsFetch(rec ReceiptsTraceRecord)
+}
+
+// ReceiptsTraceFile is a ReceiptsTracer that appends records as JSON lines to a file.
+// Once the file would grow past maxSize bytes, it is rotated to a single backup file with a ".1" suffix,
+// bounding the disk usage of the trace to about twice maxSize.
+type ReceiptsTraceFile struct {
+	log     log.Logger
+	path    string
+	maxSize int64
+
+	mu sync.Mutex
+}
+
+var _ ReceiptsTracer = (*ReceiptsTraceFile)(nil)
+
+func NewReceiptsTraceFile(log log.Logger, path string, maxSize int64) *ReceiptsTraceFile {
+	return &ReceiptsTraceFile{
+		log:     log,
+		path:    path,
+		maxSize: maxSize,
+	}
+}
+
+func (t *ReceiptsTraceFile) TraceReceiptsFetch(rec ReceiptsTraceRecord) {
+	if err := t.write(rec); err != nil {
+		t.log.Warn("failed to write receipts trace record", "path", t.path, "err", err)
+	}
+}
+
+func (t *ReceiptsTraceFile) write(rec ReceiptsTraceRecord) error {
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode record: %w", err)
+	}
+	line = append(line, '\n')
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if info, err := os.Stat(t.path); err == nil {
+		if info.Size() > 0 && info.Size()+int64(len(line)) > t.maxSize {
+			if err := os.Rename(t.path, t.path+".1"); err != nil {
+				return fmt.Errorf("failed to rotate trace file: %w", err)
+			}
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	// The file is reopened for every record, so it can be moved or removed externally at any time.
+	f, err := os.OpenFile(t.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(line); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
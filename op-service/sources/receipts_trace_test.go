@@ -0,0 +1,118 @@
+package sources
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func readTraceRecords(t *testing.T, path string) []ReceiptsTraceRecord {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var out []ReceiptsTraceRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var rec ReceiptsTraceRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &rec))
+		out = append(out, rec)
+	}
+	require.NoError(t, scanner.Err())
+	return out
+}
+
+func TestRPCReceiptsFetcher_Trace(t *testing.T) {
+	rng := rand.New(rand.NewSource(123))
+	var infos []eth.BlockInfo
+	var blockReceipts []types.Receipts
+	var allReceipts []*types.Receipt
+	for i := 0; i < 2; i++ {
+		block, receipts := randomRpcBlockAndReceipts(rng, 3)
+		bInfo, _, err := block.Info(true, true)
+		require.NoError(t, err)
+		infos = append(infos, bInfo)
+		blockReceipts = append(blockReceipts, receipts)
+		allReceipts = append(allReceipts, receipts...)
+	}
+
+	var batchCalls int
+	mrpc := &simpleMockRPC{
+		callFn: func(_ context.Context, result any, method string, args ...any) error {
+			require.Equal(t, "eth_getBlockReceipts", method)
+			return errors.New("unsupported method")
+		},
+		batchCallFn: serveReceiptsBatch(allReceipts, &batchCalls),
+	}
+	logger := testlog.Logger(t, log.LevelDebug)
+	path := filepath.Join(t.TempDir(), "receipts.trace")
+	rp := NewRPCReceiptsFetcher(mrpc, logger, RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        RPCKindStandard,
+		MethodResetDuration: time.Minute,
+		Tracer:              NewReceiptsTraceFile(logger, path, 1<<20),
+	})
+
+	ctx := context.Background()
+	// the preferred method fails, and the retry falls back to per-tx receipt fetching
+	_, err := rp.FetchReceipts(ctx, infos[0], receiptTxHashes(blockReceipts[0]))
+	require.ErrorContains(t, err, "unsupported method")
+	_, err = rp.FetchReceipts(ctx, infos[0], receiptTxHashes(blockReceipts[0]))
+	require.NoError(t, err)
+	_, err = rp.FetchReceipts(ctx, infos[1], receiptTxHashes(blockReceipts[1]))
+	require.NoError(t, err)
+
+	recs := readTraceRecords(t, path)
+	require.Len(t, recs, 3)
+
+	require.Equal(t, eth.ToBlockID(infos[0]), recs[0].Block)
+	require.Equal(t, 3, recs[0].TxCount)
+	require.Equal(t, EthGetBlockReceipts.String(), recs[0].Method)
+	require.Equal(t, ReceiptsTraceError, recs[0].Outcome)
+	require.Equal(t, "unsupported method", recs[0].Error)
+	require.Equal(t, EthGetTransactionReceiptBatch.String(), recs[0].Fallback)
+
+	for i, rec := range recs[1:] {
+		require.Equal(t, eth.ToBlockID(infos[i]), rec.Block)
+		require.Equal(t, EthGetTransactionReceiptBatch.String(), rec.Method)
+		require.Equal(t, ReceiptsTraceSuccess, rec.Outcome)
+		require.Empty(t, rec.Error)
+		require.Empty(t, rec.Fallback)
+		require.False(t, rec.Time.Before(recs[i].Time), "records are in order")
+	}
+}
+
+func TestReceiptsTraceFile_Rotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "receipts.trace")
+	rec := ReceiptsTraceRecord{Method: "test", Outcome: ReceiptsTraceSuccess}
+	line, err := json.Marshal(rec)
+	require.NoError(t, err)
+	recSize := int64(len(line) + 1)
+
+	tracer := NewReceiptsTraceFile(testlog.Logger(t, log.LevelDebug), path, 3*recSize)
+	for i := 0; i < 5; i++ {
+		rec.TxCount = i
+		tracer.TraceReceiptsFetch(rec)
+	}
+
+	// the first 3 records filled up the file before it was rotated
+	backup := readTraceRecords(t, path+".1")
+	require.Len(t, backup, 3)
+	require.Equal(t, 0, backup[0].TxCount)
+	current := readTraceRecords(t, path)
+	require.Len(t, current, 2)
+	require.Equal(t, 3, current[0].TxCount)
+	require.Equal(t, 4, current[1].TxCount)
+}
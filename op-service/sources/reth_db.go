@@ -111,15 +111,15 @@ func (f *RethDBReceiptsFetcher) FetchReceipts(ctx context.Context, block eth.Blo
 	return FetchRethReceipts(f.dbInstance, &hash)
 }
 
-func NewCachingRethDBReceiptsFetcher(dbPath string, m caching.Metrics, cacheSize int) *CachingReceiptsProvider {
-	return NewCachingReceiptsProvider(NewRethDBReceiptsFetcher(dbPath), m, cacheSize)
+func NewCachingRethDBReceiptsFetcher(dbPath string, m caching.Metrics, cacheSize int, opts ...caching.Option) *CachingReceiptsProvider {
+	return NewCachingReceiptsProvider(NewRethDBReceiptsFetcher(dbPath), m, cacheSize, opts...)
 }
 
 const buildRethdb = true
 
 func newRecProviderFromConfig(client client.RPC, log log.Logger, metrics caching.Metrics, config *EthClientConfig) *CachingReceiptsProvider {
 	if dbPath := config.RethDBPath; dbPath != "" {
-		return NewCachingRethDBReceiptsFetcher(dbPath, metrics, config.ReceiptsCacheSize)
+		return NewCachingRethDBReceiptsFetcher(dbPath, metrics, config.ReceiptsCacheSize, receiptsCacheOptions(config)...)
 	}
 	return newRPCRecProviderFromConfig(client, log, metrics, config)
 }
@@ -0,0 +1,28 @@
+package sourcestest
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+// RandomBlockAndReceipts returns a random block with txCount transactions, and its receipts, which pass the validation
+// of fetched receipts: their root is the receipts root of the block, and their block and log metadata is consistent.
+func RandomBlockAndReceipts(rng *rand.Rand, txCount uint64) (eth.BlockInfo, types.Receipts) {
+	block, receipts := testutils.RandomBlock(rng, txCount)
+	return eth.BlockToInfo(block), receipts
+}
+
+// TxHashes returns the transaction hashes of the given receipts, in order,
+// which are the transaction hashes to fetch the receipts of a block with.
+func TxHashes(receipts types.Receipts) []common.Hash {
+	txHashes := make([]common.Hash, len(receipts))
+	for i, r := range receipts {
+		txHashes[i] = r.TxHash
+	}
+	return txHashes
+}
@@ -0,0 +1,73 @@
+package sourcestest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// ReceiptsResponse is a queued response of a ReceiptsProvider.
+type ReceiptsResponse struct {
+	Receipts types.Receipts
+	Err      error
+}
+
+// ReceiptsProvider is a configurable mock of sources.ReceiptsProvider, which serves the responses queued per block hash,
+// and counts the fetches of every block.
+type ReceiptsProvider struct {
+	mu        sync.Mutex
+	responses map[common.Hash][]ReceiptsResponse
+	calls     map[common.Hash]int
+}
+
+func NewReceiptsProvider() *ReceiptsProvider {
+	return &ReceiptsProvider{
+		responses: make(map[common.Hash][]ReceiptsResponse),
+		calls:     make(map[common.Hash]int),
+	}
+}
+
+// Queue queues a response to a fetch of the receipts of the given block. The responses of a block are served in the
+// order they were queued, and the last one is repeated once all were served.
+func (p *ReceiptsProvider) Queue(blockHash common.Hash, receipts types.Receipts, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.responses[blockHash] = append(p.responses[blockHash], ReceiptsResponse{Receipts: receipts, Err: err})
+}
+
+// FetchReceipts serves the next queued response of the given block, or an error if none was queued.
+func (p *ReceiptsProvider) FetchReceipts(_ context.Context, blockInfo eth.BlockInfo, _ []common.Hash) (types.Receipts, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	hash := blockInfo.Hash()
+	p.calls[hash]++
+	responses := p.responses[hash]
+	if len(responses) == 0 {
+		return nil, fmt.Errorf("no receipts queued for block %s", eth.ToBlockID(blockInfo))
+	}
+	if len(responses) > 1 {
+		p.responses[hash] = responses[1:]
+	}
+	return responses[0].Receipts, responses[0].Err
+}
+
+// Calls returns the number of times the receipts of the given block were fetched.
+func (p *ReceiptsProvider) Calls(blockHash common.Hash) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.calls[blockHash]
+}
+
+// RequireCalls asserts the receipts of the given block were fetched the expected number of times.
+func (p *ReceiptsProvider) RequireCalls(t require.TestingT, blockHash common.Hash, expected int) {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	require.Equal(t, expected, p.Calls(blockHash), "fetches of the receipts of block %s", blockHash)
+}
@@ -0,0 +1,185 @@
+package sourcestest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// MethodNotFoundError is the error of an RPC method that does not exist, which permanently disables
+// the receipt method of the RPC method in the receipts fetcher.
+type MethodNotFoundError struct {
+	Method string
+}
+
+func (e *MethodNotFoundError) ErrorCode() int { return -32601 }
+
+func (e *MethodNotFoundError) Error() string {
+	return fmt.Sprintf("the method %s does not exist/is not available", e.Method)
+}
+
+// RPC is a fake RPC client, which serves the receipts of the added blocks with every receipt fetching method:
+//   - eth_getTransactionReceipt, per transaction, also in batches
+//   - eth_getBlockReceipts, by block hash or number
+//   - alchemy_getTransactionReceipts, debug_getRawReceipts, debug_getBlockReceipts, parity_getBlockReceipts
+//     and erigon_getBlockReceiptsByBlockHash, by block hash
+//
+// Unknown blocks and transactions are served as null, like nodes do. Other methods fail with a MethodNotFoundError.
+// Every call of a method is counted, including the calls of a batch.
+type RPC struct {
+	mu       sync.Mutex
+	blocks   map[common.Hash]types.Receipts
+	numbers  map[uint64]common.Hash
+	receipts map[common.Hash]*types.Receipt
+	errs     map[string]error
+	calls    map[string]int
+}
+
+func NewRPC() *RPC {
+	return &RPC{
+		blocks:   make(map[common.Hash]types.Receipts),
+		numbers:  make(map[uint64]common.Hash),
+		receipts: make(map[common.Hash]*types.Receipt),
+		errs:     make(map[string]error),
+		calls:    make(map[string]int),
+	}
+}
+
+// AddBlock serves the receipts of the given block, see RandomBlockAndReceipts for receipts of a valid block.
+func (r *RPC) AddBlock(block eth.BlockID, receipts types.Receipts) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.blocks[block.Hash] = receipts
+	r.numbers[block.Number] = block.Hash
+	for _, receipt := range receipts {
+		r.receipts[receipt.TxHash] = receipt
+	}
+}
+
+// SetError makes every call of the given method fail with err, e.g. a MethodNotFoundError.
+// A nil err serves the method again.
+func (r *RPC) SetError(method string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err == nil {
+		delete(r.errs, method)
+	} else {
+		r.errs[method] = err
+	}
+}
+
+// Calls returns the number of calls of the given method.
+func (r *RPC) Calls(method string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls[method]
+}
+
+func (r *RPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.call(result, method, args...)
+}
+
+func (r *RPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range b {
+		b[i].Error = r.call(b[i].Result, b[i].Method, b[i].Args...)
+	}
+	return nil
+}
+
+// call serves a call of the given method, r.mu must be held.
+func (r *RPC) call(result any, method string, args ...any) error {
+	r.calls[method]++
+	if err := r.errs[method]; err != nil {
+		return err
+	}
+	if len(args) != 1 {
+		return fmt.Errorf("expected a single argument of %s, got %d", method, len(args))
+	}
+	var out any
+	switch method {
+	case "eth_getTransactionReceipt":
+		var txHash common.Hash
+		if err := convert(args[0], &txHash); err != nil {
+			return err
+		}
+		if receipt, ok := r.receipts[txHash]; ok {
+			out = receipt
+		}
+	case "eth_getBlockReceipts":
+		var blockNrOrHash rpc.BlockNumberOrHash
+		if err := convert(args[0], &blockNrOrHash); err != nil {
+			return err
+		}
+		hash, ok := blockNrOrHash.Hash()
+		if number, isNumber := blockNrOrHash.Number(); isNumber {
+			hash, ok = r.numbers[uint64(number)]
+		}
+		if ok {
+			out = r.blocks[hash]
+		}
+	case "alchemy_getTransactionReceipts":
+		var param struct {
+			BlockHash common.Hash `json:"blockHash"`
+		}
+		if err := convert(args[0], &param); err != nil {
+			return err
+		}
+		if receipts, ok := r.blocks[param.BlockHash]; ok {
+			out = struct {
+				Receipts types.Receipts `json:"receipts"`
+			}{receipts}
+		}
+	case "debug_getRawReceipts":
+		var hash common.Hash
+		if err := convert(args[0], &hash); err != nil {
+			return err
+		}
+		if receipts, ok := r.blocks[hash]; ok {
+			raw, err := eth.EncodeReceipts(receipts)
+			if err != nil {
+				return err
+			}
+			out = raw
+		}
+	case "debug_getBlockReceipts", "parity_getBlockReceipts", "erigon_getBlockReceiptsByBlockHash":
+		var hash common.Hash
+		if err := convert(args[0], &hash); err != nil {
+			return err
+		}
+		if receipts, ok := r.blocks[hash]; ok {
+			out = receipts
+		}
+	default:
+		return &MethodNotFoundError{Method: method}
+	}
+	return convert(out, result)
+}
+
+// convert converts v into out by encoding it as JSON, like it is sent over the wire.
+func convert(v any, out any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode %T: %w", v, err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to decode %s into %T: %w", data, out, err)
+	}
+	return nil
+}
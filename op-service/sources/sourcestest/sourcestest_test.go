@@ -0,0 +1,88 @@
+package sourcestest_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum-optimism/optimism/op-service/sources/sourcestest"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestRPC_ReceiptMethods(t *testing.T) {
+	block, receipts := sourcestest.RandomBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	require.Equal(t, block.ReceiptHash(), sources.ComputeReceiptsRoot(receipts))
+	txHashes := sourcestest.TxHashes(receipts)
+
+	for _, kind := range sources.RPCProviderKinds {
+		t.Run(string(kind), func(t *testing.T) {
+			rpc := sourcestest.NewRPC()
+			rpc.AddBlock(eth.ToBlockID(block), receipts)
+			for _, m := range sources.AvailableReceiptsFetchingMethods(kind).Methods() {
+				fetcher := sources.NewRPCReceiptsFetcher(rpc, testlog.Logger(t, log.LevelInfo), sources.RPCReceiptsConfig{
+					MaxBatchSize:        10,
+					ProviderKind:        kind,
+					MethodResetDuration: time.Minute,
+					MethodPreference:    []sources.ReceiptsFetchingMethod{m},
+				})
+				got, err := fetcher.FetchReceipts(context.Background(), block, txHashes)
+				require.NoError(t, err, m.String())
+				require.Equal(t, m, fetcher.AvailableMethods()&m, "%s fell back", m)
+				require.Len(t, got, len(receipts))
+				for i, r := range got {
+					require.Equal(t, receipts[i].TxHash, r.TxHash, m.String())
+				}
+			}
+		})
+	}
+}
+
+func TestRPC_Fallback(t *testing.T) {
+	block, receipts := sourcestest.RandomBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	rpc := sourcestest.NewRPC()
+	rpc.AddBlock(eth.ToBlockID(block), receipts)
+	rpc.SetError("eth_getBlockReceipts", &sourcestest.MethodNotFoundError{Method: "eth_getBlockReceipts"})
+	fetcher := sources.NewRPCReceiptsFetcher(rpc, testlog.Logger(t, log.LevelInfo), sources.RPCReceiptsConfig{
+		MaxBatchSize:        10,
+		ProviderKind:        sources.RPCKindStandard,
+		MethodResetDuration: time.Minute,
+	})
+
+	_, err := fetcher.FetchReceipts(context.Background(), block, sourcestest.TxHashes(receipts))
+	require.Error(t, err)
+	require.Equal(t, sources.EthGetBlockReceipts, fetcher.DisabledMethods())
+	got, err := fetcher.FetchReceipts(context.Background(), block, sourcestest.TxHashes(receipts))
+	require.NoError(t, err)
+	require.Len(t, got, len(receipts))
+	require.Equal(t, 1, rpc.Calls("eth_getBlockReceipts"))
+	require.Equal(t, len(receipts), rpc.Calls("eth_getTransactionReceipt"))
+}
+
+func TestReceiptsProvider(t *testing.T) {
+	block, receipts := sourcestest.RandomBlockAndReceipts(rand.New(rand.NewSource(123)), 4)
+	txHashes := sourcestest.TxHashes(receipts)
+	provider := sourcestest.NewReceiptsProvider()
+	_, err := provider.FetchReceipts(context.Background(), block, txHashes)
+	require.ErrorContains(t, err, "no receipts queued")
+
+	errUnavailable := errors.New("unavailable")
+	provider.Queue(block.Hash(), nil, errUnavailable)
+	provider.Queue(block.Hash(), receipts, nil)
+	caching := sources.NewCachingReceiptsProvider(provider, nil, 10)
+	_, err = caching.FetchReceipts(context.Background(), block, txHashes)
+	require.ErrorIs(t, err, errUnavailable)
+	for i := 0; i < 3; i++ {
+		got, err := caching.FetchReceipts(context.Background(), block, txHashes)
+		require.NoError(t, err)
+		require.Equal(t, receipts, got)
+	}
+	// the failed fetches are not cached, and the receipts are only fetched once after them
+	provider.RequireCalls(t, block.Hash(), 3)
+}
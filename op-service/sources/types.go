@@ -1,6 +1,7 @@
 package sources
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
@@ -324,9 +325,13 @@ type blockHashParameter struct {
 }
 
 // unusableMethod identifies if an error indicates that the RPC method cannot be used as expected:
-// if it's an unknown method, or if parameters were invalid.
+// if it's an unknown method, if parameters were invalid, or if it returned receipts that do not match the receipts root.
 func unusableMethod(err error) bool {
-	if rpcErr, ok := err.(rpc.Error); ok {
+	if errors.Is(err, ErrReceiptRootMismatch) {
+		return true
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
 		code := rpcErr.ErrorCode()
 		// invalid request, method not found, or invalid params
 		if code == -32600 || code == -32601 || code == -32602 {
@@ -340,3 +345,33 @@ func unusableMethod(err error) bool {
 		strings.Contains(errText, "is not available") ||
 		strings.Contains(errText, "rpc method is not whitelisted") // proxyd -32001 error code
 }
+
+// methodNotFound identifies if an error is the JSON-RPC "method not found" error (-32601),
+// which indicates that the RPC does not implement the method at all, rather than failing to serve a request with it.
+func methodNotFound(err error) bool {
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr) && rpcErr.ErrorCode() == -32601
+}
+
+// blockPruned identifies if an error indicates that the requested block data was pruned by a non-archive node,
+// so it can only be served by an archive node, and retrying with the same node will not help.
+func blockPruned(err error) bool {
+	errText := strings.ToLower(err.Error())
+	return strings.Contains(errText, "missing trie node") || // geth, pruned state
+		strings.Contains(errText, "block not found") || // erigon, reth
+		strings.Contains(errText, "historical state") || // geth, historical state is not available
+		strings.Contains(errText, "pruned") || // nethermind, reth: pruned history unavailable
+		strings.Contains(errText, "pruning") // erigon: old data not available due to pruning
+}
+
+// batchTooLarge identifies if an error indicates that a batch request exceeded the batch size limit of the provider,
+// so it may succeed when split into smaller batches.
+func batchTooLarge(err error) bool {
+	errText := strings.ToLower(err.Error())
+	return strings.Contains(errText, "batch too large") || // geth
+		strings.Contains(errText, "batch size too large") ||
+		strings.Contains(errText, "batch limit exceeded") ||
+		strings.Contains(errText, "batch size limit exceeded") ||
+		strings.Contains(errText, "max batch size") || // e.g. "exceeds max batch size", proxyd
+		strings.Contains(errText, "too many requests in batch")
+}